@@ -11,6 +11,7 @@ require (
 	github.com/stretchr/testify v1.8.4
 	github.com/twitchtv/twirp v8.1.3+incompatible
 	golang.org/x/crypto v0.12.0
+	golang.org/x/net v0.11.0
 	google.golang.org/protobuf v1.31.0
 )
 
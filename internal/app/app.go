@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"syscall"
@@ -8,11 +9,18 @@ import (
 	"log/slog"
 
 	"github.com/ysomad/answersuck/internal/config"
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/gateway"
 
+	gamemem "github.com/ysomad/answersuck/internal/memory/game"
+	roommem "github.com/ysomad/answersuck/internal/memory/room"
+
+	hostactionpg "github.com/ysomad/answersuck/internal/postgres/hostaction"
 	mediapg "github.com/ysomad/answersuck/internal/postgres/media"
 	packpg "github.com/ysomad/answersuck/internal/postgres/pack"
 	playerpg "github.com/ysomad/answersuck/internal/postgres/player"
 	questionpg "github.com/ysomad/answersuck/internal/postgres/question"
+	reportpg "github.com/ysomad/answersuck/internal/postgres/report"
 	roundpg "github.com/ysomad/answersuck/internal/postgres/round"
 	"github.com/ysomad/answersuck/internal/postgres/roundquestion"
 	roundtopicpg "github.com/ysomad/answersuck/internal/postgres/roundtopic"
@@ -20,16 +28,28 @@ import (
 	topicpg "github.com/ysomad/answersuck/internal/postgres/topic"
 
 	authsvc "github.com/ysomad/answersuck/internal/service/auth"
+	hostsvc "github.com/ysomad/answersuck/internal/service/host"
+	moderationsvc "github.com/ysomad/answersuck/internal/service/moderation"
 	"github.com/ysomad/answersuck/internal/service/pack"
+	playsvc "github.com/ysomad/answersuck/internal/service/play"
 	playersvc "github.com/ysomad/answersuck/internal/service/player"
+	previewsvc "github.com/ysomad/answersuck/internal/service/preview"
+	questionsvc "github.com/ysomad/answersuck/internal/service/question"
+	roomsvc "github.com/ysomad/answersuck/internal/service/room"
 	roundsvc "github.com/ysomad/answersuck/internal/service/round"
+	roundquestionsvc "github.com/ysomad/answersuck/internal/service/roundquestion"
+	tagsvc "github.com/ysomad/answersuck/internal/service/tag"
+	topicsvc "github.com/ysomad/answersuck/internal/service/topic"
 
 	apptwirp "github.com/ysomad/answersuck/internal/twirp"
 	authv1 "github.com/ysomad/answersuck/internal/twirp/auth/v1"
 	editorv1 "github.com/ysomad/answersuck/internal/twirp/editor/v1"
+	moderationv1 "github.com/ysomad/answersuck/internal/twirp/moderation/v1"
 	playerv1 "github.com/ysomad/answersuck/internal/twirp/player/v1"
+	roomv1 "github.com/ysomad/answersuck/internal/twirp/room/v1"
 
 	"github.com/ysomad/answersuck/internal/pkg/httpserver"
+	"github.com/ysomad/answersuck/internal/pkg/hub"
 	"github.com/ysomad/answersuck/internal/pkg/pgclient"
 	"github.com/ysomad/answersuck/internal/pkg/session"
 )
@@ -60,11 +80,18 @@ func Run(conf *config.Config, flags Flags) { //nolint:funlen // main func
 	playerPostgres := playerpg.NewRepository(pgClient)
 	playerService := playersvc.NewService(playerPostgres)
 
-	playerHandlerV1 := playerv1.NewPlayerHandler(playerService)
+	playerHandlerV1 := playerv1.NewPlayerHandler(playerService, sessionManager)
 
 	// tag
 	tagPostgres := tagpg.NewRepository(pgClient)
-	tagHandlerV1 := editorv1.NewTagHandler(tagPostgres, sessionManager)
+	tagService := tagsvc.NewService(tagPostgres, playerService)
+
+	type tagUseCase struct {
+		*tagpg.Repository
+		*tagsvc.Service
+	}
+
+	tagHandlerV1 := editorv1.NewTagHandler(&tagUseCase{tagPostgres, tagService}, sessionManager)
 
 	// auth
 	authService := authsvc.NewService(sessionManager, playerService)
@@ -74,18 +101,39 @@ func Run(conf *config.Config, flags Flags) { //nolint:funlen // main func
 	mediaPostgres := mediapg.NewRepository(pgClient)
 	mediaHandlerV1 := editorv1.NewMediaHandler(mediaPostgres, sessionManager)
 
-	// question
-	questionPostgres := questionpg.NewRepository(pgClient)
-	questionHandlerV1 := editorv1.NewQuestionHandler(questionPostgres, sessionManager)
-
 	// pack
 	packPostgres := packpg.NewRepository(pgClient)
 	packSvc := pack.NewService(packPostgres)
-	packHandlerV1 := editorv1.NewPackHandler(packPostgres, sessionManager)
+
+	type packUseCase struct {
+		*packpg.Repository
+		*pack.Service
+	}
+
+	packHandlerV1 := editorv1.NewPackHandler(&packUseCase{packPostgres, packSvc}, sessionManager)
+
+	// question
+	questionPostgres := questionpg.NewRepository(pgClient)
+	questionService := questionsvc.NewService(questionPostgres, packSvc)
+
+	type questionUseCase struct {
+		*questionpg.Repository
+		*questionsvc.Service
+	}
+
+	questionHandlerV1 := editorv1.NewQuestionHandler(
+		&questionUseCase{questionPostgres, questionService}, sessionManager)
 
 	// topic
 	topicPostgres := topicpg.NewRepository(pgClient)
-	topicHandlerV1 := editorv1.NewTopicHandler(topicPostgres, sessionManager)
+	topicService := topicsvc.NewService(topicPostgres)
+
+	type topicUseCase struct {
+		*topicpg.Repository
+		*topicsvc.Service
+	}
+
+	topicHandlerV1 := editorv1.NewTopicHandler(&topicUseCase{topicPostgres, topicService}, sessionManager)
 
 	// roundTopic
 	roundTopicPostgres := roundtopicpg.NewRepository(pgClient)
@@ -103,7 +151,63 @@ func Run(conf *config.Config, flags Flags) { //nolint:funlen // main func
 
 	// round question
 	roundQuestionPostgres := roundquestion.NewRepository(pgClient)
-	roundQuestionHandlerV1 := editorv1.NewRoundQuestionHandler(roundQuestionPostgres, sessionManager)
+	roundQuestionService := roundquestionsvc.NewService(roundQuestionPostgres, packSvc)
+
+	type roundQuestionUseCase struct {
+		*roundquestion.Repository
+		*roundquestionsvc.Service
+	}
+
+	roundQuestionHandlerV1 := editorv1.NewRoundQuestionHandler(
+		&roundQuestionUseCase{roundQuestionPostgres, roundQuestionService}, sessionManager)
+
+	// preview
+	previewService := previewsvc.NewService(roundPostgres, roundQuestionPostgres, packSvc)
+	previewHandlerV1 := editorv1.NewPreviewHandler(previewService, sessionManager)
+
+	// moderation
+	reportPostgres := reportpg.NewRepository(pgClient)
+	moderationService := moderationsvc.NewService(
+		reportPostgres, packPostgres, playerPostgres, packSvc, questionService, playerService)
+
+	reportHandlerV1 := moderationv1.NewReportHandler(moderationService, sessionManager)
+	moderationHandlerV1 := moderationv1.NewModerationHandler(moderationService, sessionManager)
+
+	// room
+	roomMemory := roommem.NewRepository()
+	roomService := roomsvc.NewService(roomMemory, packPostgres, packSvc)
+	roomHandlerV1 := roomv1.NewRoomHandler(roomService, sessionManager)
+
+	// gateway
+	roomHub := hub.New()
+
+	// host
+	// host service is set before any game is finished
+	var hostService *hostsvc.Service
+
+	gameMemory := gamemem.NewRepository(func(roomID string, events []game.Event) {
+		if err := gateway.Publish(roomHub, roomID, events); err != nil {
+			slog.Error("gateway.Publish", slog.String("room_id", roomID), slog.String("error", err.Error()))
+		}
+	}, func(roomID string) {
+		if err := hostService.FinishGame(context.Background(), roomID); err != nil {
+			slog.Error("hostService.FinishGame", slog.String("room_id", roomID), slog.String("error", err.Error()))
+		}
+
+		roomHub.Close(roomID)
+	})
+	hostActionPostgres := hostactionpg.NewRepository(pgClient)
+	hostService = hostsvc.NewService(
+		roomMemory, gameMemory, hostActionPostgres, packPostgres, roundPostgres, roundQuestionPostgres)
+	hostHandlerV1 := roomv1.NewHostHandler(hostService, sessionManager)
+
+	// player
+	playService := playsvc.NewService(gameMemory)
+	roomPlayerHandlerV1 := roomv1.NewPlayerHandler(playService, sessionManager)
+
+	seats := gateway.NewSeats(gameMemory, roomMemory, conf.Room.ReconnectGrace, conf.Room.HostReconnectGrace)
+	wsHandler := gateway.NewWebSocketHandler(roomHub, roomMemory, seats, sessionManager)
+	sseHandler := gateway.NewSSEHandler(roomHub, roomMemory, seats, sessionManager)
 
 	// http
 	mux := apptwirp.NewMux([]apptwirp.Handler{
@@ -116,6 +220,14 @@ func Run(conf *config.Config, flags Flags) { //nolint:funlen // main func
 		roundHandlerV1,
 		topicHandlerV1,
 		roundQuestionHandlerV1,
+		previewHandlerV1,
+		reportHandlerV1,
+		moderationHandlerV1,
+		roomHandlerV1,
+		hostHandlerV1,
+		roomPlayerHandlerV1,
+		wsHandler,
+		sseHandler,
 	})
 
 	srv := httpserver.New(mux, httpserver.WithPort(conf.HTTP.Port))
@@ -17,7 +17,9 @@ func mustRunMigrations(dbURL string) {
 		}
 	}()
 
-	if err := goose.Run("up", db, "./migrations"); err != nil {
+	// schema migrations are numbered before test data migration which is already applied
+	// on existing databases, so they're applied out of order
+	if err := goose.RunWithOptions("up", db, "./migrations", nil, goose.WithAllowMissing()); err != nil {
 		panic(err)
 	}
 }
@@ -8,6 +8,7 @@ type Config struct {
 	Log     Log     `yaml:"log"`
 	PG      PG      `yaml:"postgres"`
 	Session Session `yaml:"session"`
+	Room    Room    `yaml:"room"`
 }
 
 type App struct {
@@ -35,4 +36,14 @@ type (
 	Session struct {
 		LifeTime time.Duration `yaml:"lifetime" env-required:"true"`
 	}
+
+	Room struct {
+		// ReconnectGrace is a time member keeps the seat after disconnect
+		// before other members are notified.
+		ReconnectGrace time.Duration `yaml:"reconnect_grace" env-default:"30s"`
+
+		// HostReconnectGrace is a time host keeps the seat after disconnect,
+		// it's short since the game is paused only after host seat is released.
+		HostReconnectGrace time.Duration `yaml:"host_reconnect_grace" env-default:"5s"`
+	}
 )
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+type HostActionType int8
+
+const (
+	HostActionStartGame HostActionType = iota + 1
+	HostActionJudge
+	HostActionSetScore
+	HostActionPause
+	HostActionResume
+	HostActionSkip
+)
+
+// HostAction is a record of host decision in the game, host actions are never changed or deleted.
+type HostAction struct {
+	ID     int64
+	RoomID string
+	Host   string
+	Type   HostActionType
+
+	// Player affected by the action, empty if action affects the whole game.
+	Player string
+
+	// Correct is set for judged answers.
+	Correct bool
+
+	// Score is a new score of the player for score changes.
+	Score int32
+
+	Reason     string
+	CreateTime time.Time
+}
@@ -0,0 +1,21 @@
+package entity
+
+// Language is a language of pack, topic or question content.
+type Language int8
+
+const (
+	LangRussian Language = iota + 1
+	LangEnglish
+)
+
+// DefaultLanguage is used when language of content is not specified.
+const DefaultLanguage = LangRussian
+
+func (l Language) Valid() bool {
+	switch l {
+	case LangRussian, LangEnglish:
+		return true
+	}
+
+	return false
+}
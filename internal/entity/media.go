@@ -16,12 +16,46 @@ const (
 )
 
 type Media struct {
-	URL        string
-	Type       MediaType
+	URL  string
+	Type MediaType
+
+	// Duration of audio or video, zero if unknown.
+	Duration time.Duration
+
 	Uploader   string
 	CreateTime time.Time
 }
 
+// MediaClip is a fragment of audio or video media.
+// Zero End means the fragment lasts until the end of media.
+type MediaClip struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+var ErrInvalidMediaClip = errors.New("invalid media clip")
+
+func (c MediaClip) IsZero() bool {
+	return c.Start == 0 && c.End == 0
+}
+
+func (c MediaClip) Validate() error {
+	if c.Start < 0 || c.End < 0 || (c.End != 0 && c.End <= c.Start) {
+		return ErrInvalidMediaClip
+	}
+
+	return nil
+}
+
+// Fits reports whether clip fits into media of duration d, unknown zero duration fits any clip.
+func (c MediaClip) Fits(d time.Duration) bool {
+	if d == 0 {
+		return true
+	}
+
+	return c.Start < d && c.End <= d
+}
+
 var (
 	errInvalidMediaURL      = errors.New("invalid media url")
 	errUnsupportedMediaType = errors.New("unsupported media type")
@@ -1,13 +1,42 @@
 package entity
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+type PackVisibility int8
+
+const (
+	// PackVisibilityPublic pack is listed in catalog and readable by anyone after publishing.
+	PackVisibilityPublic PackVisibility = iota + 1
+
+	// PackVisibilityUnlisted pack is not listed in catalog and readable by anyone with share token.
+	PackVisibilityUnlisted
+
+	// PackVisibilityPrivate pack is not listed in catalog and readable only by
+	// authenticated players with share token.
+	PackVisibilityPrivate
+)
+
+func (v PackVisibility) Valid() bool {
+	switch v {
+	case PackVisibilityPublic, PackVisibilityUnlisted, PackVisibilityPrivate:
+		return true
+	}
+
+	return false
+}
 
 type Pack struct {
 	ID         int32
 	Name       string
 	Author     string
 	Published  bool
+	Hidden     bool
 	CoverURL   string
+	Language   Language
+	Visibility PackVisibility
 	CreateTime time.Time
 }
 
@@ -15,3 +44,95 @@ type PackWithTags struct {
 	Pack
 	Tags []string
 }
+
+// PackFilter filters packs in catalog, zero fields are ignored.
+type PackFilter struct {
+	// Query is a full-text search query by pack name.
+	Query    string
+	Language Language
+}
+
+// PackShareToken grants read access to unlisted or private pack until revoked.
+type PackShareToken struct {
+	Token      string
+	PackID     int32
+	CreateTime time.Time
+}
+
+// PackRating is a rating of the pack from 1 to 5 given by the player,
+// player has one rating per pack which is overwritten by the next one.
+type PackRating struct {
+	PackID     int32
+	Player     string
+	Rating     int16
+	CreateTime time.Time
+}
+
+const (
+	PackRatingMin = 1
+	PackRatingMax = 5
+)
+
+type PackStats struct {
+	RoundCount    int16
+	TopicCount    int16
+	QuestionCount int16
+	VideoCount    int16
+	AudioCount    int16
+	ImageCount    int16
+
+	// PlayCount is amount of games played with the pack.
+	PlayCount int32
+}
+
+type PackWithStats struct {
+	Pack
+	Stats PackStats
+}
+
+const packMaxRounds = 6
+
+var (
+	ErrPackRoundCount    = errors.New("pack must contain from 1 to 6 rounds")
+	ErrPackTopicCount    = errors.New("each round must contain from 1 to 10 topics")
+	ErrPackQuestionCount = errors.New("each topic must contain from 1 to 10 questions")
+)
+
+// PackLayout is a number of questions in each topic of each round of the pack.
+type PackLayout [][]int
+
+// Validate returns error if pack with the layout cannot be published.
+func (l PackLayout) Validate() error {
+	if len(l) == 0 || len(l) > packMaxRounds {
+		return ErrPackRoundCount
+	}
+
+	for _, topics := range l {
+		if len(topics) == 0 || len(topics) > MaxRoundTopics {
+			return ErrPackTopicCount
+		}
+
+		for _, questions := range topics {
+			if questions == 0 || questions > MaxTopicQuestions {
+				return ErrPackQuestionCount
+			}
+		}
+	}
+
+	return nil
+}
+
+// Stats returns round, topic and question counts of the layout.
+func (l PackLayout) Stats() PackStats {
+	s := PackStats{RoundCount: int16(len(l))}
+
+	for _, topics := range l {
+		s.TopicCount += int16(len(topics))
+
+		for _, questions := range topics {
+			s.QuestionCount += int16(questions)
+		}
+	}
+
+	return s
+}
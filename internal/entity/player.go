@@ -25,7 +25,22 @@ type Player struct {
 	Email         string
 	DisplayName   string
 	EmailVerified bool
+	Admin         bool
 	PasswordHash  string
 	CreatedAt     time.Time
 	UpdateTime    time.Time
 }
+
+// AuthorProfile is a public profile of the player with published public packs of the player.
+type AuthorProfile struct {
+	Nickname    string
+	DisplayName string
+	JoinTime    time.Time
+	Packs       []PackWithStats
+
+	// PlayCount is amount of games played with packs of the author.
+	PlayCount int64
+
+	// AverageRating is average rating of packs of the author, zero if packs are not rated.
+	AverageRating float32
+}
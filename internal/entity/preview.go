@@ -0,0 +1,177 @@
+package entity
+
+import "errors"
+
+type PreviewStage int8
+
+const (
+	// PreviewStageRoundStart shows name and topics of the round.
+	PreviewStageRoundStart PreviewStage = iota + 1
+
+	// PreviewStageGrid shows question grid of the round and waits for question selection.
+	PreviewStageGrid
+
+	// PreviewStageTransfer shows transfer prompt of secret or super secret question.
+	PreviewStageTransfer
+
+	// PreviewStageQuestion reveals question.
+	PreviewStageQuestion
+
+	// PreviewStageAnswer reveals answer and host comment.
+	PreviewStageAnswer
+
+	// PreviewStageFinished means all rounds are played.
+	PreviewStageFinished
+)
+
+// PreviewRound is a round with its questions ordered by topic and question positions.
+type PreviewRound struct {
+	Round
+	Questions []RoundQuestionDetailed
+}
+
+var (
+	ErrPreviewFinished         = errors.New("preview is finished")
+	ErrPreviewQuestionRequired = errors.New("question must be selected from the grid")
+	ErrPreviewNotGrid          = errors.New("question can be selected only from the grid")
+	ErrPreviewQuestionNotFound = errors.New("question not found in the grid or already played")
+)
+
+// Preview walks pack through the same sequence as a real game for single player without timers.
+type Preview struct {
+	PackID int32
+	Rounds []PreviewRound
+	Stage  PreviewStage
+
+	// RoundIdx is index of current round in Rounds.
+	RoundIdx int
+
+	// QuestionIdx is index of selected question in questions of current round.
+	QuestionIdx int
+
+	// Played contains ids of played round questions.
+	Played map[int32]struct{}
+}
+
+func NewPreview(packID int32, rounds []PreviewRound) *Preview {
+	p := &Preview{
+		PackID: packID,
+		Rounds: rounds,
+		Stage:  PreviewStageRoundStart,
+		Played: make(map[int32]struct{}),
+	}
+
+	if len(rounds) == 0 {
+		p.Stage = PreviewStageFinished
+	}
+
+	return p
+}
+
+// Round returns current round or nil if preview is finished.
+func (p *Preview) Round() *PreviewRound {
+	if p.Stage == PreviewStageFinished {
+		return nil
+	}
+
+	return &p.Rounds[p.RoundIdx]
+}
+
+// Question returns selected question or nil if question is not selected.
+func (p *Preview) Question() *RoundQuestionDetailed {
+	switch p.Stage {
+	case PreviewStageTransfer, PreviewStageQuestion, PreviewStageAnswer:
+		return &p.Rounds[p.RoundIdx].Questions[p.QuestionIdx]
+	}
+
+	return nil
+}
+
+// IsPlayed reports whether round question was played.
+func (p *Preview) IsPlayed(roundQuestionID int32) bool {
+	_, ok := p.Played[roundQuestionID]
+	return ok
+}
+
+// Next moves preview to the next stage, question must be selected with Select on grid stage.
+func (p *Preview) Next() error {
+	switch p.Stage {
+	case PreviewStageRoundStart:
+		if len(p.Rounds[p.RoundIdx].Questions) == 0 {
+			p.nextRound()
+			return nil
+		}
+
+		p.Stage = PreviewStageGrid
+	case PreviewStageGrid:
+		return ErrPreviewQuestionRequired
+	case PreviewStageTransfer:
+		p.Stage = PreviewStageQuestion
+	case PreviewStageQuestion:
+		p.Stage = PreviewStageAnswer
+	case PreviewStageAnswer:
+		round := p.Rounds[p.RoundIdx]
+		p.Played[round.Questions[p.QuestionIdx].ID] = struct{}{}
+
+		for _, q := range round.Questions {
+			if !p.IsPlayed(q.ID) {
+				p.Stage = PreviewStageGrid
+				return nil
+			}
+		}
+
+		p.nextRound()
+	case PreviewStageFinished:
+		return ErrPreviewFinished
+	}
+
+	return nil
+}
+
+// Select selects not played question from the grid of current round.
+func (p *Preview) Select(roundQuestionID int32) error {
+	if p.Stage != PreviewStageGrid {
+		return ErrPreviewNotGrid
+	}
+
+	for i, q := range p.Rounds[p.RoundIdx].Questions {
+		if q.ID != roundQuestionID || p.IsPlayed(q.ID) {
+			continue
+		}
+
+		p.QuestionIdx = i
+		p.Stage = PreviewStageQuestion
+
+		if q.Type == QTypeSecret || q.Type == QTypeSuperSecret {
+			p.Stage = PreviewStageTransfer
+		}
+
+		return nil
+	}
+
+	return ErrPreviewQuestionNotFound
+}
+
+func (p *Preview) nextRound() {
+	p.QuestionIdx = 0
+
+	if p.RoundIdx == len(p.Rounds)-1 {
+		p.Stage = PreviewStageFinished
+		return
+	}
+
+	p.RoundIdx++
+	p.Stage = PreviewStageRoundStart
+}
+
+// Clone returns copy of the preview, rounds are shared since they are not changed by preview.
+func (p *Preview) Clone() *Preview {
+	c := *p
+	c.Played = make(map[int32]struct{}, len(p.Played))
+
+	for id := range p.Played {
+		c.Played[id] = struct{}{}
+	}
+
+	return &c
+}
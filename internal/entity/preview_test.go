@@ -0,0 +1,222 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func previewQuestion(id int32, t QuestionType) RoundQuestionDetailed {
+	return RoundQuestionDetailed{RoundQuestion: RoundQuestion{ID: id, Type: t}}
+}
+
+func testPreviewRounds() []PreviewRound {
+	return []PreviewRound{
+		{
+			Round: Round{ID: 1, Position: 1},
+			Questions: []RoundQuestionDetailed{
+				previewQuestion(1, QTypeStandard),
+				previewQuestion(2, QTypeSecret),
+			},
+		},
+		{
+			Round: Round{ID: 2, Position: 2},
+		},
+		{
+			Round:     Round{ID: 3, Position: 3},
+			Questions: []RoundQuestionDetailed{previewQuestion(3, QTypeSuperSecret)},
+		},
+	}
+}
+
+// previewStep is either Next or Select of the question if question is set.
+type previewStep struct {
+	question int32
+	wantErr  error
+}
+
+func next() previewStep { return previewStep{} }
+
+func sel(id int32) previewStep { return previewStep{question: id} }
+
+func TestPreview(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		rounds       []PreviewRound
+		steps        []previewStep
+		wantStage    PreviewStage
+		wantRound    int
+		wantQuestion int32
+		wantPlayed   []int32
+	}{
+		{
+			name:      "new preview starts first round",
+			rounds:    testPreviewRounds(),
+			wantStage: PreviewStageRoundStart,
+		},
+		{
+			name:      "new preview without rounds is finished",
+			wantStage: PreviewStageFinished,
+		},
+		{
+			name:      "grid",
+			rounds:    testPreviewRounds(),
+			steps:     []previewStep{next()},
+			wantStage: PreviewStageGrid,
+		},
+		{
+			name:         "standard question",
+			rounds:       testPreviewRounds(),
+			steps:        []previewStep{next(), sel(1)},
+			wantStage:    PreviewStageQuestion,
+			wantQuestion: 1,
+		},
+		{
+			name:         "secret question is transferred first",
+			rounds:       testPreviewRounds(),
+			steps:        []previewStep{next(), sel(2)},
+			wantStage:    PreviewStageTransfer,
+			wantQuestion: 2,
+		},
+		{
+			name:         "secret question after transfer",
+			rounds:       testPreviewRounds(),
+			steps:        []previewStep{next(), sel(2), next()},
+			wantStage:    PreviewStageQuestion,
+			wantQuestion: 2,
+		},
+		{
+			name:         "answer",
+			rounds:       testPreviewRounds(),
+			steps:        []previewStep{next(), sel(1), next()},
+			wantStage:    PreviewStageAnswer,
+			wantQuestion: 1,
+		},
+		{
+			name:       "back to grid after answer",
+			rounds:     testPreviewRounds(),
+			steps:      []previewStep{next(), sel(1), next(), next()},
+			wantStage:  PreviewStageGrid,
+			wantPlayed: []int32{1},
+		},
+		{
+			name:   "round without questions is skipped",
+			rounds: testPreviewRounds(),
+			steps: []previewStep{
+				next(), sel(1), next(), next(),
+				sel(2), next(), next(), next(),
+				next(),
+			},
+			wantStage:  PreviewStageRoundStart,
+			wantRound:  2,
+			wantPlayed: []int32{1, 2},
+		},
+		{
+			name:   "finished after last round",
+			rounds: testPreviewRounds(),
+			steps: []previewStep{
+				next(), sel(1), next(), next(),
+				sel(2), next(), next(), next(),
+				next(),
+				next(), sel(3), next(), next(), next(),
+				{wantErr: ErrPreviewFinished},
+			},
+			wantStage:  PreviewStageFinished,
+			wantRound:  2,
+			wantPlayed: []int32{1, 2, 3},
+		},
+		{
+			name:      "next on grid requires question",
+			rounds:    testPreviewRounds(),
+			steps:     []previewStep{next(), {wantErr: ErrPreviewQuestionRequired}},
+			wantStage: PreviewStageGrid,
+		},
+		{
+			name:      "select not on grid",
+			rounds:    testPreviewRounds(),
+			steps:     []previewStep{{question: 1, wantErr: ErrPreviewNotGrid}},
+			wantStage: PreviewStageRoundStart,
+		},
+		{
+			name:         "select while question is shown",
+			rounds:       testPreviewRounds(),
+			steps:        []previewStep{next(), sel(1), {question: 2, wantErr: ErrPreviewNotGrid}},
+			wantStage:    PreviewStageQuestion,
+			wantQuestion: 1,
+		},
+		{
+			name:      "select question of another round",
+			rounds:    testPreviewRounds(),
+			steps:     []previewStep{next(), {question: 3, wantErr: ErrPreviewQuestionNotFound}},
+			wantStage: PreviewStageGrid,
+		},
+		{
+			name:       "select played question",
+			rounds:     testPreviewRounds(),
+			steps:      []previewStep{next(), sel(1), next(), next(), {question: 1, wantErr: ErrPreviewQuestionNotFound}},
+			wantStage:  PreviewStageGrid,
+			wantPlayed: []int32{1},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewPreview(1, tt.rounds)
+
+			for i, s := range tt.steps {
+				var err error
+
+				if s.question != 0 {
+					err = p.Select(s.question)
+				} else {
+					err = p.Next()
+				}
+
+				require.ErrorIs(t, err, s.wantErr, "step %d", i)
+			}
+
+			assert.Equal(t, tt.wantStage, p.Stage)
+
+			if tt.wantStage == PreviewStageFinished {
+				assert.Nil(t, p.Round())
+			} else {
+				assert.Equal(t, tt.rounds[tt.wantRound].ID, p.Round().ID)
+			}
+
+			if tt.wantQuestion != 0 {
+				require.NotNil(t, p.Question())
+				assert.Equal(t, tt.wantQuestion, p.Question().ID)
+			} else {
+				assert.Nil(t, p.Question())
+			}
+
+			for _, id := range tt.wantPlayed {
+				assert.True(t, p.IsPlayed(id), id)
+			}
+
+			assert.Len(t, p.Played, len(tt.wantPlayed))
+		})
+	}
+}
+
+func TestPreview_Clone(t *testing.T) {
+	t.Parallel()
+
+	p := NewPreview(1, testPreviewRounds())
+	require.NoError(t, p.Next())
+	require.NoError(t, p.Select(1))
+
+	c := p.Clone()
+
+	require.NoError(t, p.Next())
+	require.NoError(t, p.Next())
+
+	assert.Equal(t, PreviewStageQuestion, c.Stage)
+	assert.False(t, c.IsPlayed(1))
+	assert.True(t, p.IsPlayed(1))
+}
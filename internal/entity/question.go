@@ -1,11 +1,72 @@
 package entity
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// Maximum parts in question content.
+const MaxQuestionParts = 10
+
+type QuestionPartType int8
+
+const (
+	QPartTypeText QuestionPartType = iota + 1
+	QPartTypeImage
+	QPartTypeAudio
+	QPartTypeVideo
+)
+
+// MediaType returns type of media for media part or 0 for text part.
+func (t QuestionPartType) MediaType() MediaType {
+	switch t {
+	case QPartTypeImage:
+		return MediaTypeImage
+	case QPartTypeAudio:
+		return MediaTypeAudio
+	case QPartTypeVideo:
+		return MediaTypeVideo
+	}
+
+	return 0
+}
+
+// NewQuestionPartType returns question part type for media type.
+func NewQuestionPartType(t MediaType) QuestionPartType {
+	switch t {
+	case MediaTypeImage:
+		return QPartTypeImage
+	case MediaTypeAudio:
+		return QPartTypeAudio
+	case MediaTypeVideo:
+		return QPartTypeVideo
+	}
+
+	return 0
+}
+
+// QuestionPart is a part of question content, parts are shown to players in order.
+// Text part has only text, media part has only media url.
+// Zero duration means the part is shown until the next part is requested.
+type QuestionPart struct {
+	Type      QuestionPartType
+	Text      string
+	MediaURL  string
+	MediaClip MediaClip
+	Duration  time.Duration
+}
 
 type Answer struct {
-	ID       int32
-	Text     string
-	MediaURL string
+	ID        int32
+	Text      string
+	MediaURL  string
+	MediaClip MediaClip
+
+	// Alternatives are accepted answer variants besides Text.
+	Alternatives []string
+
+	// WrongVariants are explicitly rejected near-miss answers.
+	WrongVariants []string
 }
 
 type Question struct {
@@ -14,5 +75,128 @@ type Question struct {
 	Answer     Answer
 	Author     string
 	MediaURL   string
+	MediaClip  MediaClip
+	Language   Language
 	CreateTime time.Time
+
+	// Parts is ordered question content, Text and MediaURL are kept
+	// for clients which can't play parts.
+	Parts []QuestionPart
+
+	// UsageCount is amount of round questions which use the question.
+	UsageCount int32
+}
+
+var ErrInvalidQuestionPart = errors.New("invalid question part")
+
+func (q *Question) Validate() error {
+	if err := validateMediaClip(q.MediaURL, q.MediaClip); err != nil {
+		return err
+	}
+
+	if err := validateMediaClip(q.Answer.MediaURL, q.Answer.MediaClip); err != nil {
+		return err
+	}
+
+	if len(q.Parts) > MaxQuestionParts {
+		return ErrInvalidQuestionPart
+	}
+
+	for _, p := range q.Parts {
+		switch p.Type {
+		case QPartTypeText:
+			if p.Text == "" || p.MediaURL != "" {
+				return ErrInvalidQuestionPart
+			}
+		case QPartTypeImage, QPartTypeAudio, QPartTypeVideo:
+			if p.MediaURL == "" || p.Text != "" {
+				return ErrInvalidQuestionPart
+			}
+		default:
+			return ErrInvalidQuestionPart
+		}
+
+		if p.Duration < 0 {
+			return ErrInvalidQuestionPart
+		}
+
+		if err := validateMediaClip(p.MediaURL, p.MediaClip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMediaClip returns ErrInvalidMediaClip if clip is invalid or set without media.
+func validateMediaClip(mediaURL string, clip MediaClip) error {
+	if clip.IsZero() {
+		return nil
+	}
+
+	if mediaURL == "" {
+		return ErrInvalidMediaClip
+	}
+
+	return clip.Validate()
+}
+
+// HideAnswer removes answer from question which is read by player who is not allowed to see it.
+func (q *Question) HideAnswer() {
+	q.Answer = Answer{}
+}
+
+// AnswerHidden reports whether answer was removed from question by HideAnswer.
+func (q *Question) AnswerHidden() bool {
+	return q.Answer.ID == 0
+}
+
+// CompatMediaURL returns media url for clients which can't play parts,
+// it's the first media part url if question has no media url.
+func (q *Question) CompatMediaURL() string {
+	if q.MediaURL != "" {
+		return q.MediaURL
+	}
+
+	for _, p := range q.Parts {
+		if p.MediaURL != "" {
+			return p.MediaURL
+		}
+	}
+
+	return ""
+}
+
+// CompatMediaClip returns clip of media returned by CompatMediaURL.
+func (q *Question) CompatMediaClip() MediaClip {
+	if q.MediaURL != "" {
+		return q.MediaClip
+	}
+
+	for _, p := range q.Parts {
+		if p.MediaURL != "" {
+			return p.MediaClip
+		}
+	}
+
+	return MediaClip{}
+}
+
+// SimilarQuestion is existing question which text and answer are similar to another one.
+type SimilarQuestion struct {
+	ID         int32
+	Text       string
+	Similarity float32
+}
+
+// QuestionUsage is a place in pack where question is used.
+type QuestionUsage struct {
+	RoundQuestionID int32
+	PackID          int32
+	PackName        string
+	PackAuthor      string
+	RoundID         int32
+	RoundName       string
+	TopicID         int32
+	TopicTitle      string
 }
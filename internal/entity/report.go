@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+type ReportStatus int8
+
+const (
+	ReportStatusOpen ReportStatus = iota + 1
+	ReportStatusResolved
+	ReportStatusDismissed
+)
+
+var (
+	ErrReportTargetRequired = errors.New("either pack or question must be reported")
+	ErrReportAlreadyClosed  = errors.New("report is already resolved or dismissed")
+)
+
+// Report is a complaint of a player about pack or question content,
+// exactly one of PackID and QuestionID is set when report is created.
+// Reported content is kept in PackName or QuestionText, so it's reviewable
+// after the pack or question is deleted and its ID is reset to zero.
+type Report struct {
+	ID           int32
+	PackID       int32
+	QuestionID   int32
+	PackName     string
+	QuestionText string
+	Reason       string
+	Reporter     string
+	Status       ReportStatus
+	ResolvedBy   string
+	CreateTime   time.Time
+	ResolveTime  time.Time
+}
+
+func (r *Report) Validate() error {
+	if (r.PackID == 0) == (r.QuestionID == 0) {
+		return ErrReportTargetRequired
+	}
+
+	return nil
+}
+
+// Close closes open report with resolved or dismissed status.
+func (r *Report) Close(status ReportStatus, moderator string, t time.Time) error {
+	if r.Status != ReportStatusOpen {
+		return ErrReportAlreadyClosed
+	}
+
+	r.Status = status
+	r.ResolvedBy = moderator
+	r.ResolveTime = t
+
+	return nil
+}
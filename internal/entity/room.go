@@ -0,0 +1,179 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+type RoomRole int8
+
+const (
+	RoomRolePlayer RoomRole = iota + 1
+	RoomRoleHost
+
+	// RoomRoleSpectator watches the game without playing, spectators can join at any time.
+	RoomRoleSpectator
+)
+
+const (
+	roomMinPlayers = 2
+	roomMaxPlayers = 12
+
+	roomMaxChoiceTimeout   = 120 * time.Second
+	roomMaxTransferTimeout = 60 * time.Second
+	roomMaxHostTimeout     = 300 * time.Second
+)
+
+var (
+	ErrRoomMaxPlayers      = errors.New("room must be for 2 to 12 players")
+	ErrRoomChoiceTimeout   = errors.New("question choice timeout must be from 1 to 120 seconds")
+	ErrRoomTransferTimeout = errors.New("secret question transfer timeout must be from 1 to 60 seconds")
+	ErrRoomHostTimeout     = errors.New("host decision timeout must be from 1 to 300 seconds")
+
+	ErrRoomFull      = errors.New("room is full")
+	ErrRoomHostTaken = errors.New("host role is already taken")
+	ErrRoomStarted   = errors.New("game is already started, room can be joined only as spectator")
+)
+
+// RoomSettings are game settings of the room.
+type RoomSettings struct {
+	// MaxPlayers is maximum number of players in the room, host and spectators are not counted.
+	MaxPlayers int8
+
+	// FalseStarts forbids to press the button until question is read.
+	FalseStarts bool
+
+	ChoiceTimeout   time.Duration
+	TransferTimeout time.Duration
+	HostTimeout     time.Duration
+}
+
+// DefaultRoomSettings returns settings of the room which are used if not specified.
+func DefaultRoomSettings() RoomSettings {
+	return RoomSettings{
+		MaxPlayers:      6,
+		FalseStarts:     true,
+		ChoiceTimeout:   30 * time.Second,
+		TransferTimeout: 15 * time.Second,
+		HostTimeout:     30 * time.Second,
+	}
+}
+
+func (s RoomSettings) Validate() error {
+	if s.MaxPlayers < roomMinPlayers || s.MaxPlayers > roomMaxPlayers {
+		return ErrRoomMaxPlayers
+	}
+
+	if s.ChoiceTimeout < time.Second || s.ChoiceTimeout > roomMaxChoiceTimeout {
+		return ErrRoomChoiceTimeout
+	}
+
+	if s.TransferTimeout < time.Second || s.TransferTimeout > roomMaxTransferTimeout {
+		return ErrRoomTransferTimeout
+	}
+
+	if s.HostTimeout < time.Second || s.HostTimeout > roomMaxHostTimeout {
+		return ErrRoomHostTimeout
+	}
+
+	return nil
+}
+
+type RoomMember struct {
+	Nickname string
+	Role     RoomRole
+	JoinTime time.Time
+}
+
+type Room struct {
+	ID           string
+	Name         string
+	PasswordHash string
+	PackID       int32
+	Owner        string
+	Settings     RoomSettings
+	Members      []RoomMember
+	Started      bool
+	CreateTime   time.Time
+}
+
+func (r *Room) HasPassword() bool {
+	return r.PasswordHash != ""
+}
+
+// Host returns nickname of the host, empty string if host is not chosen.
+func (r *Room) Host() string {
+	for _, m := range r.Members {
+		if m.Role == RoomRoleHost {
+			return m.Nickname
+		}
+	}
+
+	return ""
+}
+
+func (r *Room) Member(nickname string) (RoomMember, bool) {
+	for _, m := range r.Members {
+		if m.Nickname == nickname {
+			return m, true
+		}
+	}
+
+	return RoomMember{}, false
+}
+
+// Join adds player to the room with provided role, after game start room can be joined
+// only as spectator since players of the game are fixed. Player already in the room keeps its role.
+func (r *Room) Join(nickname string, role RoomRole, t time.Time) error {
+	if _, ok := r.Member(nickname); ok {
+		return nil
+	}
+
+	switch role {
+	case RoomRolePlayer:
+		if r.Started {
+			return ErrRoomStarted
+		}
+
+		if r.playerCount() >= int(r.Settings.MaxPlayers) {
+			return ErrRoomFull
+		}
+	case RoomRoleHost:
+		if r.Started {
+			return ErrRoomStarted
+		}
+
+		if r.Host() != "" {
+			return ErrRoomHostTaken
+		}
+	}
+
+	r.Members = append(r.Members, RoomMember{
+		Nickname: nickname,
+		Role:     role,
+		JoinTime: t,
+	})
+
+	return nil
+}
+
+func (r *Room) playerCount() int {
+	n := 0
+
+	for _, m := range r.Members {
+		if m.Role == RoomRolePlayer {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Clone returns deep copy of the room.
+func (r *Room) Clone() *Room {
+	c := *r
+	c.Members = slices.Clone(r.Members)
+
+	return &c
+}
@@ -5,6 +5,9 @@ import (
 	"time"
 )
 
+// Maximum questions in one round topic.
+const MaxTopicQuestions = 10
+
 type QuestionType int8
 
 const (
@@ -43,9 +46,45 @@ type RoundQuestion struct {
 	AnswerTime   time.Duration
 	HostComment  string
 	SecretTopic  string
-	SecretCost   int32
+	SecretCost   int32 // 0 if player who got secret question chooses its cost
 	Keepable     bool
 	TransferType QuestionTransferType
+
+	// SecretCostRange is a range player who got secret question chooses its cost from,
+	// if it's empty minimal or maximal cost of the round is chosen.
+	SecretCostRange SecretCostRange
+}
+
+// SecretCostRange is a range [Min;Max] with Step, only Min or Max can be chosen if Step is 0.
+type SecretCostRange struct {
+	Min  int32
+	Max  int32
+	Step int32
+}
+
+func (r SecretCostRange) Empty() bool {
+	return r == SecretCostRange{}
+}
+
+func (r SecretCostRange) valid() bool {
+	if r.Empty() {
+		return true
+	}
+
+	if r.Min <= 0 || r.Max <= r.Min || r.Step < 0 {
+		return false
+	}
+
+	return r.Step == 0 || (r.Max-r.Min)%r.Step == 0
+}
+
+// validSecretCost reports whether secret cost is either fixed or chosen from the range.
+func (q *RoundQuestion) validSecretCost() bool {
+	if q.SecretCost < 0 || !q.SecretCostRange.valid() {
+		return false
+	}
+
+	return q.SecretCost == 0 || q.SecretCostRange.Empty()
 }
 
 var (
@@ -56,11 +95,11 @@ var (
 func (q *RoundQuestion) Validate() error {
 	switch q.Type {
 	case QTypeSecret:
-		if q.SecretCost == 0 || q.SecretTopic == "" || q.Keepable {
+		if !q.validSecretCost() || q.SecretTopic == "" || q.Keepable {
 			return ErrInvalidSecretQuestion
 		}
 	case QTypeSuperSecret:
-		if q.SecretCost == 0 || q.SecretTopic == "" || !q.TransferType.valid() {
+		if !q.validSecretCost() || q.SecretTopic == "" || !q.TransferType.valid() {
 			return ErrInvalidSuperSecretQuestion
 		}
 	}
@@ -71,10 +110,29 @@ func (q *RoundQuestion) Validate() error {
 type RoundQuestionDetailed struct {
 	RoundQuestion
 
-	Question         string
-	QuestionMediaURL string
+	Topic string
+
+	Question          string
+	QuestionMediaURL  string
+	QuestionMediaClip MediaClip
+
+	AnswerID        int32
+	Answer          string
+	AnswerMediaURL  string
+	AnswerMediaClip MediaClip
+}
+
+// HideAnswer removes answer and host comment from round question which is read
+// by player who is not allowed to see them.
+func (q *RoundQuestionDetailed) HideAnswer() {
+	q.AnswerID = 0
+	q.Answer = ""
+	q.AnswerMediaURL = ""
+	q.AnswerMediaClip = MediaClip{}
+	q.HostComment = ""
+}
 
-	AnswerID       int32
-	Answer         string
-	AnswerMediaURL string
+// AnswerHidden reports whether answer was removed from round question by HideAnswer.
+func (q *RoundQuestionDetailed) AnswerHidden() bool {
+	return q.AnswerID == 0
 }
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundQuestion_Validate(t *testing.T) {
+	t.Parallel()
+
+	superSecret := func(cost int32, r SecretCostRange) *RoundQuestion {
+		return &RoundQuestion{
+			Type:            QTypeSuperSecret,
+			SecretTopic:     "topic",
+			SecretCost:      cost,
+			TransferType:    QTransferTypeBefore,
+			SecretCostRange: r,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		q       *RoundQuestion
+		wantErr error
+	}{
+		{
+			name: "standard",
+			q:    &RoundQuestion{Type: QTypeStandard},
+		},
+		{
+			name: "secret with fixed cost",
+			q:    &RoundQuestion{Type: QTypeSecret, SecretTopic: "topic", SecretCost: 500},
+		},
+		{
+			name: "secret with cost of the round",
+			q:    &RoundQuestion{Type: QTypeSecret, SecretTopic: "topic"},
+		},
+		{
+			name:    "secret without topic",
+			q:       &RoundQuestion{Type: QTypeSecret, SecretCost: 500},
+			wantErr: ErrInvalidSecretQuestion,
+		},
+		{
+			name:    "secret with negative cost",
+			q:       &RoundQuestion{Type: QTypeSecret, SecretTopic: "topic", SecretCost: -1},
+			wantErr: ErrInvalidSecretQuestion,
+		},
+		{
+			name: "super secret with fixed cost",
+			q:    superSecret(1000, SecretCostRange{}),
+		},
+		{
+			name: "super secret with cost of the round",
+			q:    superSecret(0, SecretCostRange{}),
+		},
+		{
+			name: "super secret with cost range",
+			q:    superSecret(0, SecretCostRange{Min: 100, Max: 1000, Step: 150}),
+		},
+		{
+			name: "super secret with cost range ends",
+			q:    superSecret(0, SecretCostRange{Min: 100, Max: 1000}),
+		},
+		{
+			name:    "super secret with fixed cost and cost range",
+			q:       superSecret(500, SecretCostRange{Min: 100, Max: 1000}),
+			wantErr: ErrInvalidSuperSecretQuestion,
+		},
+		{
+			name:    "super secret with empty cost range",
+			q:       superSecret(0, SecretCostRange{Min: 1000, Max: 1000}),
+			wantErr: ErrInvalidSuperSecretQuestion,
+		},
+		{
+			name:    "super secret with cost range without min",
+			q:       superSecret(0, SecretCostRange{Max: 1000, Step: 100}),
+			wantErr: ErrInvalidSuperSecretQuestion,
+		},
+		{
+			name:    "super secret with step not dividing cost range",
+			q:       superSecret(0, SecretCostRange{Min: 100, Max: 1000, Step: 200}),
+			wantErr: ErrInvalidSuperSecretQuestion,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, tt.q.Validate(), tt.wantErr)
+		})
+	}
+}
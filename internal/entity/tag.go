@@ -8,4 +8,7 @@ type Tag struct {
 	Name       string
 	Author     string
 	CreateTime time.Time
+
+	// UsageCount is amount of packs tagged with the tag.
+	UsageCount int32
 }
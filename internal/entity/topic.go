@@ -6,5 +6,6 @@ type Topic struct {
 	ID         int32
 	Title      string
 	Author     string
+	Language   Language
 	CreateTime time.Time
 }
@@ -0,0 +1,93 @@
+package game
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/game/auction"
+)
+
+func (g *Game) startAuction(now time.Time) []Event {
+	bidders := make([]auction.Bidder, len(g.cfg.Players))
+
+	for i, p := range g.cfg.Players {
+		bidders[i] = auction.Bidder{Nickname: p, Score: g.scores[p]}
+	}
+
+	g.auction = auction.New(auction.Config{
+		Cost:    g.cost,
+		Timeout: g.cfg.BidTimeout,
+	}, g.opener, bidders, now)
+
+	return g.setStage(StageBidding, g.auction.Turn(), g.auction.Deadline())
+}
+
+func (g *Game) bid(c Bid, now time.Time) ([]Event, error) {
+	if g.stage != StageBidding {
+		return nil, ErrUnexpectedCommand
+	}
+
+	var err error
+
+	if c.AllIn {
+		err = g.auction.AllIn(c.Player, now)
+	} else {
+		err = g.auction.Bid(c.Player, c.Amount, now)
+	}
+
+	if err != nil {
+		return nil, bidError(err)
+	}
+
+	_, amount, allIn := g.auction.Leader()
+	events := []Event{BidPlaced{Player: c.Player, Amount: amount, AllIn: allIn}}
+
+	return append(events, g.nextBidder(now)...), nil
+}
+
+func bidError(err error) error {
+	switch {
+	case errors.Is(err, auction.ErrNotYourTurn):
+		return ErrNotYourTurn
+	case errors.Is(err, auction.ErrBidTooHigh):
+		return ErrBidTooHigh
+	case errors.Is(err, auction.ErrAllInRequired):
+		return ErrAllInRequired
+	case errors.Is(err, auction.ErrBidTooLow):
+		return ErrInvalidBid
+	}
+
+	return ErrUnexpectedCommand
+}
+
+func (g *Game) passBid(c Pass, now time.Time) ([]Event, error) {
+	if err := g.auction.Pass(c.Player, now); err != nil {
+		return nil, bidError(err)
+	}
+
+	return append([]Event{BidPassed{Player: c.Player}}, g.nextBidder(now)...), nil
+}
+
+func (g *Game) bidTimeout(now time.Time) []Event {
+	p, ok := g.auction.Tick(now)
+	if !ok {
+		g.deadline = g.auction.Deadline()
+		return nil
+	}
+
+	return append([]Event{BidPassed{Player: p}}, g.nextBidder(now)...)
+}
+
+// nextBidder gives turn to next player or finishes the auction.
+func (g *Game) nextBidder(now time.Time) []Event {
+	winner, amount, finished := g.auction.Result()
+	if !finished {
+		return g.setStage(StageBidding, g.auction.Turn(), g.auction.Deadline())
+	}
+
+	g.answerer = winner
+	g.cost = amount
+	g.auction = nil
+
+	return append([]Event{AuctionWon{Player: winner, Amount: amount}}, g.showQuestion(now)...)
+}
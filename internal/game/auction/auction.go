@@ -0,0 +1,253 @@
+// Package auction implements bidding on auction question.
+//
+// Players bid in turn: the player who opened the question first and others in ascending
+// order of their scores. Minimal bid is the question cost and every bid must be greater
+// than the current one. Player may go all-in by betting his whole score, all-in beats
+// any normal bid and can be outbid only by greater all-in. Player who passed is out
+// of the auction. The auction is won by the leader when everyone else passed,
+// if everyone passed without bids the opener plays the question for its cost.
+package auction
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"time"
+)
+
+var (
+	ErrFinished      = errors.New("auction is finished")
+	ErrNotYourTurn   = errors.New("it's not your turn to bid")
+	ErrBidTooLow     = errors.New("bid must be greater than current bid and not less than question cost")
+	ErrBidTooHigh    = errors.New("bid can't be greater than player score")
+	ErrAllInRequired = errors.New("all-in can be outbid only by all-in")
+)
+
+// Bidder is a player taking part in the auction.
+type Bidder struct {
+	Nickname string
+	Score    int32
+}
+
+type Config struct {
+	// Cost of the question is a minimal bid.
+	Cost int32
+
+	// Timeout is a time given to player to bid, player passes on timeout.
+	Timeout time.Duration
+}
+
+type Auction struct {
+	cfg    Config
+	opener string
+
+	// order of bidding, turn is an index of bidder in it.
+	order    []Bidder
+	turn     int
+	deadline time.Time
+
+	passed map[string]struct{}
+
+	leader string
+	bid    int32
+	allIn  bool
+
+	finished bool
+	winner   string
+}
+
+// New starts the auction, bidders must be in seating order.
+func New(cfg Config, opener string, bidders []Bidder, now time.Time) *Auction {
+	order := make([]Bidder, 0, len(bidders))
+	others := make([]Bidder, 0, len(bidders))
+
+	for _, b := range bidders {
+		if b.Nickname == opener {
+			order = append(order, b)
+			continue
+		}
+
+		others = append(others, b)
+	}
+
+	// stable sort keeps seating order of players with equal scores
+	slices.SortStableFunc(others, func(a, b Bidder) int {
+		switch {
+		case a.Score < b.Score:
+			return -1
+		case a.Score > b.Score:
+			return 1
+		}
+
+		return 0
+	})
+
+	return &Auction{
+		cfg:      cfg,
+		opener:   opener,
+		order:    append(order, others...),
+		deadline: now.Add(cfg.Timeout),
+		passed:   make(map[string]struct{}, len(bidders)),
+	}
+}
+
+// Turn returns player who must bid, empty if auction is finished.
+// Clone returns copy of the auction which can be changed independently.
+func (a *Auction) Clone() *Auction {
+	c := *a
+	c.passed = maps.Clone(a.passed)
+
+	return &c
+}
+
+func (a *Auction) Turn() string {
+	if a.finished {
+		return ""
+	}
+
+	return a.order[a.turn].Nickname
+}
+
+// Deadline returns time when current player passes automatically, zero if auction is finished.
+func (a *Auction) Deadline() time.Time {
+	if a.finished {
+		return time.Time{}
+	}
+
+	return a.deadline
+}
+
+// Delay moves deadline of current turn, it's used when game is paused.
+func (a *Auction) Delay(d time.Duration) {
+	a.deadline = a.deadline.Add(d)
+}
+
+// Leader returns player with the highest bid, empty if nobody bid yet.
+func (a *Auction) Leader() (player string, bid int32, allIn bool) {
+	return a.leader, a.bid, a.allIn
+}
+
+// Result returns player who won the auction and his bid.
+func (a *Auction) Result() (winner string, bid int32, finished bool) {
+	return a.winner, a.bid, a.finished
+}
+
+// Bid places a bid of the player, bid equal to player score is all-in.
+func (a *Auction) Bid(player string, amount int32, now time.Time) error {
+	b, err := a.bidder(player)
+	if err != nil {
+		return err
+	}
+
+	if amount > b.Score {
+		return ErrBidTooHigh
+	}
+
+	if amount == b.Score {
+		return a.AllIn(player, now)
+	}
+
+	if a.allIn {
+		return ErrAllInRequired
+	}
+
+	if amount < a.cfg.Cost || amount <= a.bid {
+		return ErrBidTooLow
+	}
+
+	a.lead(player, amount, false, now)
+
+	return nil
+}
+
+// AllIn places a bid of the whole score of the player.
+func (a *Auction) AllIn(player string, now time.Time) error {
+	b, err := a.bidder(player)
+	if err != nil {
+		return err
+	}
+
+	if b.Score < a.cfg.Cost || b.Score <= a.bid {
+		return ErrBidTooLow
+	}
+
+	a.lead(player, b.Score, true, now)
+
+	return nil
+}
+
+// Pass takes the player out of the auction.
+func (a *Auction) Pass(player string, now time.Time) error {
+	if _, err := a.bidder(player); err != nil {
+		return err
+	}
+
+	a.pass(now)
+
+	return nil
+}
+
+// Tick passes current player if his time is over, returns the player who passed.
+func (a *Auction) Tick(now time.Time) (string, bool) {
+	if a.finished || now.Before(a.deadline) {
+		return "", false
+	}
+
+	player := a.Turn()
+	a.pass(now)
+
+	return player, true
+}
+
+// bidder returns current bidder if it's turn of the player.
+func (a *Auction) bidder(player string) (Bidder, error) {
+	if a.finished {
+		return Bidder{}, ErrFinished
+	}
+
+	b := a.order[a.turn]
+	if b.Nickname != player {
+		return Bidder{}, ErrNotYourTurn
+	}
+
+	return b, nil
+}
+
+func (a *Auction) lead(player string, amount int32, allIn bool, now time.Time) {
+	a.leader = player
+	a.bid = amount
+	a.allIn = allIn
+	a.next(now)
+}
+
+func (a *Auction) pass(now time.Time) {
+	a.passed[a.order[a.turn].Nickname] = struct{}{}
+	a.next(now)
+}
+
+// next gives turn to next player who is not out of the auction and isn't leading,
+// or finishes the auction if there is no such player.
+func (a *Auction) next(now time.Time) {
+	for i := 1; i <= len(a.order); i++ {
+		idx := (a.turn + i) % len(a.order)
+		p := a.order[idx].Nickname
+
+		if _, ok := a.passed[p]; ok || p == a.leader {
+			continue
+		}
+
+		a.turn = idx
+		a.deadline = now.Add(a.cfg.Timeout)
+
+		return
+	}
+
+	a.finished = true
+	a.winner = a.leader
+
+	// everyone passed without bids
+	if a.winner == "" {
+		a.winner = a.opener
+		a.bid = a.cfg.Cost
+	}
+}
@@ -0,0 +1,357 @@
+package auction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var start = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const timeout = 10 * time.Second
+
+type op int8
+
+const (
+	opBid op = iota + 1
+	opAllIn
+	opPass
+	opTick
+)
+
+type step struct {
+	op      op
+	player  string
+	amount  int32
+	wantErr error
+}
+
+func bid(p string, amount int32) step { return step{op: opBid, player: p, amount: amount} }
+func allIn(p string) step             { return step{op: opAllIn, player: p} }
+func pass(p string) step              { return step{op: opPass, player: p} }
+func tick() step                      { return step{op: opTick} }
+
+func fail(s step, err error) step {
+	s.wantErr = err
+	return s
+}
+
+func bidder(p string, score int32) Bidder { return Bidder{Nickname: p, Score: score} }
+func bidders(bb ...Bidder) []Bidder       { return bb }
+
+// order returns nicknames of bidders in bidding order.
+func order(a *Auction) []string {
+	res := make([]string, len(a.order))
+	for i, b := range a.order {
+		res[i] = b.Nickname
+	}
+
+	return res
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		opener  string
+		bidders []Bidder
+		want    []string
+	}{
+		{
+			name:    "opener first, others by ascending score",
+			opener:  "alice",
+			bidders: bidders(bidder("alice", 500), bidder("bob", 1000), bidder("carol", 200), bidder("dave", -100)),
+			want:    []string{"alice", "dave", "carol", "bob"},
+		},
+		{
+			name:    "equal scores in seating order",
+			opener:  "carol",
+			bidders: bidders(bidder("alice", 300), bidder("bob", 300), bidder("carol", 0), bidder("dave", 300)),
+			want:    []string{"carol", "alice", "bob", "dave"},
+		},
+		{
+			name:    "single player",
+			opener:  "alice",
+			bidders: bidders(bidder("alice", 500)),
+			want:    []string{"alice"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New(Config{Cost: 100, Timeout: timeout}, tt.opener, tt.bidders, start)
+			assert.Equal(t, tt.want, order(a))
+			assert.Equal(t, tt.opener, a.Turn())
+			assert.Equal(t, start.Add(timeout), a.Deadline())
+		})
+	}
+}
+
+func TestAuction(t *testing.T) {
+	// bidding order is alice, carol, bob, dave
+	defaultBidders := bidders(
+		bidder("alice", 500),
+		bidder("bob", 1000),
+		bidder("carol", 200),
+		bidder("dave", 1000),
+	)
+
+	tests := []struct {
+		name         string
+		cost         int32
+		bidders      []Bidder
+		steps        []step
+		wantTurn     string
+		wantLeader   string
+		wantBid      int32
+		wantAllIn    bool
+		wantWinner   string
+		wantFinished bool
+	}{
+		{
+			name:     "first bid",
+			steps:    []step{bid("alice", 100)},
+			wantTurn: "carol", wantLeader: "alice", wantBid: 100,
+		},
+		{
+			name:     "bid not in turn",
+			steps:    []step{fail(bid("bob", 100), ErrNotYourTurn)},
+			wantTurn: "alice",
+		},
+		{
+			name:     "bid below cost",
+			steps:    []step{fail(bid("alice", 99), ErrBidTooLow)},
+			wantTurn: "alice",
+		},
+		{
+			name:     "bid equal to current bid",
+			steps:    []step{bid("alice", 150), fail(bid("carol", 150), ErrBidTooLow)},
+			wantTurn: "carol", wantLeader: "alice", wantBid: 150,
+		},
+		{
+			name:     "bid greater than score",
+			steps:    []step{fail(bid("alice", 501), ErrBidTooHigh)},
+			wantTurn: "alice",
+		},
+		{
+			name:     "player with score below cost can only pass",
+			steps:    []step{bid("alice", 200), fail(bid("carol", 100), ErrBidTooLow), fail(allIn("carol"), ErrBidTooLow), pass("carol")},
+			wantTurn: "bob", wantLeader: "alice", wantBid: 200,
+		},
+		{
+			name:     "leader is skipped",
+			steps:    []step{bid("alice", 100), pass("carol"), bid("bob", 200), pass("dave")},
+			wantTurn: "alice", wantLeader: "bob", wantBid: 200,
+		},
+		{
+			name:         "leader wins when everyone else passed",
+			steps:        []step{bid("alice", 100), pass("carol"), bid("bob", 200), pass("dave"), pass("alice")},
+			wantLeader:   "bob",
+			wantBid:      200,
+			wantWinner:   "bob",
+			wantFinished: true,
+		},
+		{
+			name:         "outbid leader",
+			steps:        []step{bid("alice", 100), bid("carol", 150), bid("bob", 300), pass("dave"), bid("alice", 400), pass("carol"), pass("bob")},
+			wantLeader:   "alice",
+			wantBid:      400,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:         "everyone passes",
+			steps:        []step{pass("alice"), pass("carol"), pass("bob"), pass("dave")},
+			wantBid:      100,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:         "everyone passes after opener with score below cost",
+			cost:         1000,
+			steps:        []step{fail(allIn("alice"), ErrBidTooLow), pass("alice"), pass("carol"), pass("bob"), pass("dave")},
+			wantBid:      1000,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:         "last player may bid after everyone passed",
+			steps:        []step{pass("alice"), pass("carol"), pass("bob"), bid("dave", 100)},
+			wantLeader:   "dave",
+			wantBid:      100,
+			wantWinner:   "dave",
+			wantFinished: true,
+		},
+		{
+			name:     "all-in",
+			steps:    []step{allIn("alice")},
+			wantTurn: "carol", wantLeader: "alice", wantBid: 500, wantAllIn: true,
+		},
+		{
+			name:     "bid of whole score is all-in",
+			steps:    []step{bid("alice", 500)},
+			wantTurn: "carol", wantLeader: "alice", wantBid: 500, wantAllIn: true,
+		},
+		{
+			name:     "all-in beats normal bid",
+			steps:    []step{bid("alice", 300), fail(bid("carol", 200), ErrBidTooLow), pass("carol"), allIn("bob"), fail(bid("dave", 999), ErrAllInRequired)},
+			wantTurn: "dave", wantLeader: "bob", wantBid: 1000, wantAllIn: true,
+		},
+		{
+			name:     "all-in below current bid",
+			steps:    []step{pass("alice"), pass("carol"), bid("bob", 600)},
+			wantTurn: "dave", wantLeader: "bob", wantBid: 600,
+		},
+		{
+			name:     "all-in below current bid rejected",
+			steps:    []step{bid("alice", 300), fail(allIn("carol"), ErrBidTooLow)},
+			wantTurn: "carol", wantLeader: "alice", wantBid: 300,
+		},
+		{
+			name:         "all-in equal to current bid rejected",
+			bidders:      bidders(bidder("alice", 1000), bidder("bob", 500)),
+			steps:        []step{bid("alice", 500), fail(allIn("bob"), ErrBidTooLow), pass("bob")},
+			wantLeader:   "alice",
+			wantBid:      500,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:     "all-in tie",
+			bidders:  bidders(bidder("alice", 500), bidder("bob", 500), bidder("carol", 500)),
+			steps:    []step{allIn("alice"), fail(allIn("bob"), ErrBidTooLow), fail(bid("bob", 500), ErrBidTooLow)},
+			wantTurn: "bob", wantLeader: "alice", wantBid: 500, wantAllIn: true,
+		},
+		{
+			name:         "all-in tie everyone passes",
+			bidders:      bidders(bidder("alice", 500), bidder("bob", 500), bidder("carol", 500)),
+			steps:        []step{allIn("alice"), pass("bob"), pass("carol")},
+			wantLeader:   "alice",
+			wantBid:      500,
+			wantAllIn:    true,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:         "all-in outbid by greater all-in",
+			bidders:      bidders(bidder("alice", 500), bidder("bob", 800), bidder("carol", 200), bidder("dave", 1000)),
+			steps:        []step{allIn("alice"), pass("carol"), allIn("bob"), allIn("dave"), fail(allIn("alice"), ErrBidTooLow), pass("alice"), pass("bob")},
+			wantLeader:   "dave",
+			wantBid:      1000,
+			wantAllIn:    true,
+			wantWinner:   "dave",
+			wantFinished: true,
+		},
+		{
+			name:         "all-in outbid by greater all-in of equal score is rejected",
+			steps:        []step{pass("alice"), pass("carol"), allIn("bob"), fail(allIn("dave"), ErrBidTooLow), pass("dave")},
+			wantLeader:   "bob",
+			wantBid:      1000,
+			wantAllIn:    true,
+			wantWinner:   "bob",
+			wantFinished: true,
+		},
+		{
+			name:         "all-in of negative score player",
+			bidders:      bidders(bidder("alice", -100), bidder("bob", 1000)),
+			steps:        []step{fail(allIn("alice"), ErrBidTooLow), fail(bid("alice", 100), ErrBidTooHigh), pass("alice"), pass("bob")},
+			wantBid:      100,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:         "single player bids",
+			bidders:      bidders(bidder("alice", 500)),
+			steps:        []step{bid("alice", 200)},
+			wantLeader:   "alice",
+			wantBid:      200,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:     "timeout passes current player",
+			steps:    []step{bid("alice", 100), tick()},
+			wantTurn: "bob", wantLeader: "alice", wantBid: 100,
+		},
+		{
+			name:         "everyone timed out",
+			steps:        []step{tick(), tick(), tick(), tick()},
+			wantBid:      100,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+		{
+			name:         "bid after auction finished",
+			steps:        []step{bid("alice", 100), pass("carol"), pass("bob"), pass("dave"), fail(bid("alice", 200), ErrFinished), fail(pass("alice"), ErrFinished)},
+			wantLeader:   "alice",
+			wantBid:      100,
+			wantWinner:   "alice",
+			wantFinished: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost := tt.cost
+			if cost == 0 {
+				cost = 100
+			}
+
+			bb := tt.bidders
+			if bb == nil {
+				bb = defaultBidders
+			}
+
+			now := start
+			a := New(Config{Cost: cost, Timeout: timeout}, bb[0].Nickname, bb, now)
+
+			for i, s := range tt.steps {
+				now = now.Add(time.Second)
+
+				var err error
+
+				switch s.op {
+				case opBid:
+					err = a.Bid(s.player, s.amount, now)
+				case opAllIn:
+					err = a.AllIn(s.player, now)
+				case opPass:
+					err = a.Pass(s.player, now)
+				case opTick:
+					now = a.Deadline()
+					_, ok := a.Tick(now)
+					assert.True(t, ok, "step %d", i)
+				}
+
+				assert.ErrorIs(t, err, s.wantErr, "step %d", i)
+			}
+
+			leader, bid, allIn := a.Leader()
+			assert.Equal(t, tt.wantTurn, a.Turn())
+			assert.Equal(t, tt.wantLeader, leader)
+			assert.Equal(t, tt.wantBid, bid)
+			assert.Equal(t, tt.wantAllIn, allIn)
+
+			winner, _, finished := a.Result()
+			assert.Equal(t, tt.wantWinner, winner)
+			assert.Equal(t, tt.wantFinished, finished)
+		})
+	}
+}
+
+func TestAuction_Tick(t *testing.T) {
+	a := New(Config{Cost: 100, Timeout: timeout}, "alice", bidders(bidder("alice", 500), bidder("bob", 500)), start)
+
+	_, ok := a.Tick(start.Add(timeout - time.Nanosecond))
+	assert.False(t, ok)
+
+	// deadline is moved while game is paused
+	a.Delay(time.Minute)
+
+	_, ok = a.Tick(start.Add(timeout))
+	assert.False(t, ok)
+
+	p, ok := a.Tick(start.Add(timeout + time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, "alice", p)
+	assert.Equal(t, "bob", a.Turn())
+	assert.Equal(t, start.Add(timeout+time.Minute+timeout), a.Deadline())
+}
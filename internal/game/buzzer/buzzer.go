@@ -0,0 +1,182 @@
+// Package buzzer implements arbitration of button presses on standard questions.
+//
+// Buzzer is armed while question is being read, presses of armed buzzer are false starts
+// and lock the player out for a while. After buzzer is opened, presses are collected
+// for a tolerance window after the first one and the earliest press wins. Press time
+// is compensated by half of round-trip time reported by the client, so players with slow
+// connection are not punished for it.
+package buzzer
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"time"
+)
+
+var (
+	ErrClosed         = errors.New("buzzer is closed")
+	ErrFalseStart     = errors.New("button is pressed before question is read")
+	ErrLockedOut      = errors.New("player is locked out after false start")
+	ErrAlreadyPressed = errors.New("player already pressed the button")
+	ErrTooLate        = errors.New("button is pressed after tolerance window")
+)
+
+const (
+	DefaultLockout   = time.Second
+	DefaultTolerance = 150 * time.Millisecond
+	DefaultMaxRTT    = 500 * time.Millisecond
+)
+
+type state int8
+
+const (
+	stateClosed state = iota
+	stateArmed
+	stateOpen
+)
+
+type Config struct {
+	// Lockout is a time player can't press the button after false start.
+	Lockout time.Duration
+
+	// Tolerance is a time presses are collected after the first one before the winner is picked.
+	Tolerance time.Duration
+
+	// MaxRTT limits round-trip time reported by clients.
+	MaxRTT time.Duration
+}
+
+type press struct {
+	player string
+
+	// at is compensated press time.
+	at time.Time
+}
+
+type Buzzer struct {
+	cfg    Config
+	state  state
+	openAt time.Time
+
+	// presses in order of receiving.
+	presses  []press
+	deadline time.Time
+
+	lockedUntil map[string]time.Time
+}
+
+func New(cfg Config) *Buzzer {
+	return &Buzzer{
+		cfg:         cfg,
+		lockedUntil: make(map[string]time.Time),
+	}
+}
+
+// Clone returns copy of the buzzer which can be changed independently.
+func (b *Buzzer) Clone() *Buzzer {
+	c := *b
+	c.presses = slices.Clone(b.presses)
+	c.lockedUntil = maps.Clone(b.lockedUntil)
+
+	return &c
+}
+
+// Arm makes presses false starts until buzzer is opened.
+func (b *Buzzer) Arm() {
+	b.reset(stateArmed)
+}
+
+// Open opens buzzer for presses, presses collected before are discarded.
+func (b *Buzzer) Open(now time.Time) {
+	b.reset(stateOpen)
+	b.openAt = now
+}
+
+// Close closes buzzer, presses collected before are discarded.
+func (b *Buzzer) Close() {
+	b.reset(stateClosed)
+}
+
+func (b *Buzzer) reset(s state) {
+	b.state = s
+	b.openAt = time.Time{}
+	b.presses = nil
+	b.deadline = time.Time{}
+}
+
+// Deadline returns time when Resolve must be called, zero if nobody pressed the button.
+func (b *Buzzer) Deadline() time.Time {
+	return b.deadline
+}
+
+// Pressed reports whether the player pressed the button since buzzer was opened.
+func (b *Buzzer) Pressed(player string) bool {
+	for _, p := range b.presses {
+		if p.player == player {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Press registers press of the button by the player, rtt is round-trip time reported by his client.
+func (b *Buzzer) Press(player string, rtt time.Duration, now time.Time) error {
+	switch b.state {
+	case stateClosed:
+		return ErrClosed
+	case stateArmed:
+		b.lockedUntil[player] = now.Add(b.cfg.Lockout)
+		return ErrFalseStart
+	}
+
+	if now.Before(b.lockedUntil[player]) {
+		return ErrLockedOut
+	}
+
+	if b.Pressed(player) {
+		return ErrAlreadyPressed
+	}
+
+	// winner is picked already, it's just not resolved yet
+	if len(b.presses) > 0 && !now.Before(b.deadline) {
+		return ErrTooLate
+	}
+
+	rtt = min(max(rtt, 0), b.cfg.MaxRTT)
+
+	at := now.Add(-rtt / 2)
+	if at.Before(b.openAt) {
+		at = b.openAt
+	}
+
+	if len(b.presses) == 0 {
+		b.deadline = now.Add(b.cfg.Tolerance)
+	}
+
+	b.presses = append(b.presses, press{player: player, at: at})
+
+	return nil
+}
+
+// Resolve returns player who pressed the button first if tolerance window is over
+// and closes the buzzer.
+func (b *Buzzer) Resolve(now time.Time) (string, bool) {
+	if len(b.presses) == 0 || now.Before(b.deadline) {
+		return "", false
+	}
+
+	// presses are in order of receiving, so the first received wins on equal time
+	winner := b.presses[0]
+
+	for _, p := range b.presses[1:] {
+		if p.at.Before(winner.at) {
+			winner = p
+		}
+	}
+
+	b.Close()
+
+	return winner.player, true
+}
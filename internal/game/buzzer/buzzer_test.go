@@ -0,0 +1,229 @@
+package buzzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var start = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func ms(n int) time.Duration { return time.Duration(n) * time.Millisecond }
+
+type pressArgs struct {
+	player string
+	rtt    time.Duration
+	at     time.Duration
+}
+
+func TestBuzzer_Press(t *testing.T) {
+	cfg := Config{Lockout: time.Second, Tolerance: ms(100), MaxRTT: ms(400)}
+
+	tests := []struct {
+		name       string
+		armed      bool
+		openAt     time.Duration
+		presses    []pressArgs
+		wantErrs   []error
+		resolveAt  time.Duration
+		wantWinner string
+		wantOK     bool
+	}{
+		{
+			name:   "single press",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+			},
+			wantErrs:   []error{nil},
+			resolveAt:  ms(600),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+		{
+			name:   "not resolved within tolerance",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+			},
+			wantErrs:  []error{nil},
+			resolveAt: ms(599),
+			wantOK:    false,
+		},
+		{
+			name:      "nobody pressed",
+			openAt:    0,
+			resolveAt: ms(5000),
+			wantOK:    false,
+		},
+		{
+			name:   "first received wins",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+				{player: "bob", at: ms(550)},
+			},
+			wantErrs:   []error{nil, nil},
+			resolveAt:  ms(600),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+		{
+			name:   "latency compensated",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+				{player: "bob", rtt: ms(200), at: ms(550)},
+			},
+			wantErrs:   []error{nil, nil},
+			resolveAt:  ms(600),
+			wantWinner: "bob",
+			wantOK:     true,
+		},
+		{
+			name:   "equal compensated time wins first received",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", rtt: ms(100), at: ms(500)},
+				{player: "bob", rtt: ms(200), at: ms(550)},
+			},
+			wantErrs:   []error{nil, nil},
+			resolveAt:  ms(600),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+		{
+			name:   "rtt is limited",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", rtt: ms(400), at: ms(500)},
+				{player: "bob", rtt: ms(10000), at: ms(590)},
+			},
+			wantErrs:   []error{nil, nil},
+			resolveAt:  ms(600),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+		{
+			name:   "press can't be compensated before buzzer is opened",
+			openAt: ms(1000),
+			presses: []pressArgs{
+				{player: "alice", rtt: ms(400), at: ms(1000)},
+				{player: "bob", rtt: ms(400), at: ms(1050)},
+			},
+			wantErrs:   []error{nil, nil},
+			resolveAt:  ms(1100),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+		{
+			name:   "press after tolerance",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+				{player: "bob", rtt: ms(400), at: ms(600)},
+			},
+			wantErrs:   []error{nil, ErrTooLate},
+			resolveAt:  ms(700),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+		{
+			name:   "pressed twice",
+			openAt: 0,
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+				{player: "alice", at: ms(550)},
+			},
+			wantErrs:   []error{nil, ErrAlreadyPressed},
+			resolveAt:  ms(600),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+		{
+			name:   "false start",
+			armed:  true,
+			openAt: ms(1000),
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+			},
+			wantErrs:  []error{ErrFalseStart},
+			resolveAt: ms(5000),
+			wantOK:    false,
+		},
+		{
+			name:   "locked out after false start",
+			armed:  true,
+			openAt: ms(1000),
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+				{player: "alice", at: ms(1200)},
+				{player: "bob", at: ms(1300)},
+			},
+			wantErrs:   []error{ErrFalseStart, ErrLockedOut, nil},
+			resolveAt:  ms(1400),
+			wantWinner: "bob",
+			wantOK:     true,
+		},
+		{
+			name:   "lockout is over",
+			armed:  true,
+			openAt: ms(1000),
+			presses: []pressArgs{
+				{player: "alice", at: ms(500)},
+				{player: "alice", at: ms(1500)},
+			},
+			wantErrs:   []error{ErrFalseStart, nil},
+			resolveAt:  ms(1600),
+			wantWinner: "alice",
+			wantOK:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New(cfg)
+
+			if tt.armed {
+				b.Arm()
+			} else {
+				b.Open(start.Add(tt.openAt))
+			}
+
+			for i, p := range tt.presses {
+				now := start.Add(p.at)
+
+				if tt.armed && !now.Before(start.Add(tt.openAt)) && b.state == stateArmed {
+					b.Open(start.Add(tt.openAt))
+				}
+
+				assert.ErrorIs(t, b.Press(p.player, p.rtt, now), tt.wantErrs[i], "press %d", i)
+			}
+
+			got, ok := b.Resolve(start.Add(tt.resolveAt))
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantWinner, got)
+		})
+	}
+}
+
+func TestBuzzer_Closed(t *testing.T) {
+	b := New(Config{Tolerance: ms(100)})
+	assert.ErrorIs(t, b.Press("alice", 0, start), ErrClosed)
+
+	b.Open(start)
+	assert.NoError(t, b.Press("alice", 0, start))
+	assert.True(t, b.Pressed("alice"))
+
+	// reopening discards presses, so player may press again
+	b.Open(start.Add(ms(50)))
+	assert.True(t, b.Deadline().IsZero())
+	assert.False(t, b.Pressed("alice"))
+	assert.NoError(t, b.Press("alice", 0, start.Add(ms(60))))
+	assert.Equal(t, start.Add(ms(160)), b.Deadline())
+
+	got, ok := b.Resolve(start.Add(ms(160)))
+	assert.True(t, ok)
+	assert.Equal(t, "alice", got)
+	assert.ErrorIs(t, b.Press("bob", 0, start.Add(ms(170))), ErrClosed)
+}
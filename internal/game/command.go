@@ -0,0 +1,100 @@
+package game
+
+import "time"
+
+// Command is an action of the player or host, Player is nickname of the issuer.
+type Command interface {
+	issuer() string
+}
+
+// SelectQuestion selects question from the grid, only chooser can select questions.
+type SelectQuestion struct {
+	Player          string
+	RoundQuestionID int32
+}
+
+// Buzz presses the button to answer the question.
+type Buzz struct {
+	Player string
+
+	// RTT is round-trip time measured by the client, it's used for latency compensation.
+	RTT time.Duration
+}
+
+// Answer gives answer to the question, only answering player can answer.
+type Answer struct {
+	Player string
+	Text   string
+}
+
+// Judge judges answer of the answering player, only host can judge answers.
+type Judge struct {
+	Player  string
+	Correct bool
+}
+
+// Pass refuses to buzz on the question or to bid on auction question.
+type Pass struct {
+	Player string
+}
+
+// Bid makes bid on auction question, Amount is ignored if player goes all-in.
+type Bid struct {
+	Player string
+	Amount int32
+	AllIn  bool
+}
+
+// Transfer transfers secret or super secret question to another player,
+// super secret question can be transferred to the opener itself if it's keepable.
+type Transfer struct {
+	Player string
+	To     string
+}
+
+// ChooseCost chooses cost of secret question from its range,
+// only player who got the question can choose its cost.
+type ChooseCost struct {
+	Player string
+	Cost   int32
+}
+
+// Skip skips current question and reveals its answer, only host can skip questions.
+type Skip struct {
+	Player string
+}
+
+// SetScore sets score of the player, only host can change scores.
+// Scores can be changed while game is paused.
+type SetScore struct {
+	Player string
+	Target string
+	Score  int32
+}
+
+// Pause pauses or resumes the game, only host can pause the game.
+// Timers are frozen while game is paused.
+type Pause struct {
+	Player string
+	Paused bool
+}
+
+// Presence marks the player or host as connected or disconnected. Game is paused while host
+// is disconnected and resumed when host is back unless it was paused by host.
+type Presence struct {
+	Player string
+	Online bool
+}
+
+func (c SelectQuestion) issuer() string { return c.Player }
+func (c Buzz) issuer() string           { return c.Player }
+func (c Answer) issuer() string         { return c.Player }
+func (c Judge) issuer() string          { return c.Player }
+func (c Pass) issuer() string           { return c.Player }
+func (c Bid) issuer() string            { return c.Player }
+func (c Transfer) issuer() string       { return c.Player }
+func (c ChooseCost) issuer() string     { return c.Player }
+func (c Skip) issuer() string           { return c.Player }
+func (c SetScore) issuer() string       { return c.Player }
+func (c Pause) issuer() string          { return c.Player }
+func (c Presence) issuer() string       { return c.Player }
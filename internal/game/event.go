@@ -0,0 +1,217 @@
+package game
+
+import (
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game/secret"
+)
+
+type EventKind string
+
+const (
+	EventStageChanged        EventKind = "stage_changed"
+	EventRoundStarted        EventKind = "round_started"
+	EventQuestionSelected    EventKind = "question_selected"
+	EventHostAnswer          EventKind = "host_answer"
+	EventQuestionTransferred EventKind = "question_transferred"
+	EventSecretShown         EventKind = "secret_shown"
+	EventSecretCostChosen    EventKind = "secret_cost_chosen"
+	EventBidPlaced           EventKind = "bid_placed"
+	EventBidPassed           EventKind = "bid_passed"
+	EventAuctionWon          EventKind = "auction_won"
+	EventQuestionShown       EventKind = "question_shown"
+	EventPlayerBuzzed        EventKind = "player_buzzed"
+	EventPlayerPassed        EventKind = "player_passed"
+	EventAnswerGiven         EventKind = "answer_given"
+	EventAnswerJudged        EventKind = "answer_judged"
+	EventAnswerRevealed      EventKind = "answer_revealed"
+	EventQuestionSkipped     EventKind = "question_skipped"
+	EventScoreChanged        EventKind = "score_changed"
+	EventGamePaused          EventKind = "game_paused"
+	EventPresenceChanged     EventKind = "presence_changed"
+	EventSnapshot            EventKind = "snapshot"
+	EventGameFinished        EventKind = "game_finished"
+)
+
+// Event is emitted by the game on its state change, events are delivered to everyone in the room
+// except PrivateEvent which is delivered only to its recipients.
+type Event interface {
+	Kind() EventKind
+}
+
+type PrivateEvent interface {
+	Event
+	Recipients() []string
+}
+
+// StageChanged is emitted on every stage transition.
+type StageChanged struct {
+	Stage Stage `json:"stage"`
+
+	// Player who must act on the stage, empty if anyone can act.
+	Player string `json:"player,omitempty"`
+
+	// Deadline of the stage, zero if the stage has no time limit.
+	Deadline time.Time `json:"deadline"`
+}
+
+type GridQuestion struct {
+	RoundQuestionID int32 `json:"round_question_id"`
+	Cost            int32 `json:"cost"`
+	Played          bool  `json:"played,omitempty"`
+}
+
+type GridTopic struct {
+	ID        int32          `json:"id"`
+	Title     string         `json:"title"`
+	Questions []GridQuestion `json:"questions"`
+}
+
+type RoundStarted struct {
+	Index  int         `json:"index"`
+	Name   string      `json:"name"`
+	Topics []GridTopic `json:"topics"`
+}
+
+// QuestionSelected contains nominal topic and cost of the question,
+// own topic and cost of secret questions are revealed on transfer.
+type QuestionSelected struct {
+	RoundQuestionID int32               `json:"round_question_id"`
+	Type            entity.QuestionType `json:"type"`
+	Topic           string              `json:"topic"`
+	Cost            int32               `json:"cost"`
+	Player          string              `json:"player"`
+}
+
+// HostAnswer is sent to host as soon as question is selected.
+type HostAnswer struct {
+	Host        string           `json:"-"`
+	Answer      string           `json:"answer"`
+	MediaURL    string           `json:"media_url,omitempty"`
+	MediaClip   entity.MediaClip `json:"media_clip"`
+	HostComment string           `json:"host_comment,omitempty"`
+}
+
+type QuestionTransferred struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// SecretShown is sent to every player and host on each step of secret question transfer,
+// topic and cost are empty if player isn't allowed to see them.
+type SecretShown struct {
+	Player string `json:"-"`
+	Hidden bool   `json:"hidden"`
+	Topic  string `json:"topic,omitempty"`
+
+	// Cost is 0 until player who got the question chooses it from Costs.
+	Cost  int32         `json:"cost,omitempty"`
+	Costs *secret.Costs `json:"costs,omitempty"`
+}
+
+type SecretCostChosen struct {
+	Player string `json:"player"`
+}
+
+type BidPlaced struct {
+	Player string `json:"player"`
+	Amount int32  `json:"amount"`
+	AllIn  bool   `json:"all_in"`
+}
+
+type BidPassed struct {
+	Player string `json:"player"`
+}
+
+type AuctionWon struct {
+	Player string `json:"player"`
+	Amount int32  `json:"amount"`
+}
+
+type QuestionShown struct {
+	Text      string           `json:"text"`
+	MediaURL  string           `json:"media_url,omitempty"`
+	MediaClip entity.MediaClip `json:"media_clip"`
+}
+
+type PlayerBuzzed struct {
+	Player string `json:"player"`
+}
+
+type PlayerPassed struct {
+	Player string `json:"player"`
+}
+
+type AnswerGiven struct {
+	Player string `json:"player"`
+	Text   string `json:"text"`
+}
+
+type AnswerJudged struct {
+	Player  string `json:"player"`
+	Correct bool   `json:"correct"`
+	Delta   int32  `json:"delta"`
+	Score   int32  `json:"score"`
+}
+
+type AnswerRevealed struct {
+	Answer    string           `json:"answer"`
+	MediaURL  string           `json:"media_url,omitempty"`
+	MediaClip entity.MediaClip `json:"media_clip"`
+}
+
+type QuestionSkipped struct{}
+
+// ScoreChanged is emitted when host changes score of the player.
+type ScoreChanged struct {
+	Player string `json:"player"`
+	Delta  int32  `json:"delta"`
+	Score  int32  `json:"score"`
+}
+
+type GamePaused struct {
+	Paused bool `json:"paused"`
+}
+
+// PresenceChanged is emitted when player or host disconnects or comes back.
+type PresenceChanged struct {
+	Player string `json:"player"`
+	Online bool   `json:"online"`
+}
+
+type Score struct {
+	Player string `json:"player"`
+	Score  int32  `json:"score"`
+}
+
+type GameFinished struct {
+	Scores []Score `json:"scores"`
+}
+
+func (StageChanged) Kind() EventKind        { return EventStageChanged }
+func (RoundStarted) Kind() EventKind        { return EventRoundStarted }
+func (QuestionSelected) Kind() EventKind    { return EventQuestionSelected }
+func (HostAnswer) Kind() EventKind          { return EventHostAnswer }
+func (QuestionTransferred) Kind() EventKind { return EventQuestionTransferred }
+func (SecretShown) Kind() EventKind         { return EventSecretShown }
+func (SecretCostChosen) Kind() EventKind    { return EventSecretCostChosen }
+func (BidPlaced) Kind() EventKind           { return EventBidPlaced }
+func (BidPassed) Kind() EventKind           { return EventBidPassed }
+func (AuctionWon) Kind() EventKind          { return EventAuctionWon }
+func (QuestionShown) Kind() EventKind       { return EventQuestionShown }
+func (PlayerBuzzed) Kind() EventKind        { return EventPlayerBuzzed }
+func (PlayerPassed) Kind() EventKind        { return EventPlayerPassed }
+func (AnswerGiven) Kind() EventKind         { return EventAnswerGiven }
+func (AnswerJudged) Kind() EventKind        { return EventAnswerJudged }
+func (AnswerRevealed) Kind() EventKind      { return EventAnswerRevealed }
+func (QuestionSkipped) Kind() EventKind     { return EventQuestionSkipped }
+func (ScoreChanged) Kind() EventKind        { return EventScoreChanged }
+func (GamePaused) Kind() EventKind          { return EventGamePaused }
+func (PresenceChanged) Kind() EventKind     { return EventPresenceChanged }
+func (Snapshot) Kind() EventKind            { return EventSnapshot }
+func (GameFinished) Kind() EventKind        { return EventGameFinished }
+
+func (e HostAnswer) Recipients() []string  { return []string{e.Host} }
+func (e SecretShown) Recipients() []string { return []string{e.Player} }
+func (e Snapshot) Recipients() []string    { return []string{e.Player} }
@@ -0,0 +1,171 @@
+package final
+
+import (
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+type EventKind string
+
+const (
+	EventPhaseChanged    EventKind = "final_phase_changed"
+	EventTopicsShown     EventKind = "final_topics_shown"
+	EventTopicEliminated EventKind = "final_topic_eliminated"
+	EventQuestionChosen  EventKind = "final_question_chosen"
+	EventHostAnswer      EventKind = "final_host_answer"
+	EventWagerPlaced     EventKind = "final_wager_placed"
+	EventWagerAccepted   EventKind = "final_wager_accepted"
+	EventQuestionShown   EventKind = "final_question_shown"
+	EventAnswerSubmitted EventKind = "final_answer_submitted"
+	EventAnswerWritten   EventKind = "final_answer_written"
+	EventAnswerMarked    EventKind = "final_answer_marked"
+	EventAnswersJudged   EventKind = "final_answers_judged"
+	EventTieBreak        EventKind = "final_tie_break"
+	EventFinished        EventKind = "final_finished"
+)
+
+// Event is emitted by the final round on its state change, events are delivered to everyone
+// in the room except PrivateEvent which is delivered only to its recipients.
+type Event interface {
+	Kind() EventKind
+}
+
+type PrivateEvent interface {
+	Event
+	Recipients() []string
+}
+
+type PhaseChanged struct {
+	Phase Phase `json:"phase"`
+
+	// Player who must act in the phase, empty if every participant acts.
+	Player string `json:"player,omitempty"`
+
+	Deadline time.Time `json:"deadline"`
+}
+
+type Topic struct {
+	ID    int32  `json:"id"`
+	Title string `json:"title"`
+}
+
+type TopicsShown struct {
+	Topics []Topic `json:"topics"`
+
+	// Order in which players eliminate topics.
+	Order []string `json:"order"`
+}
+
+type TopicEliminated struct {
+	Player  string `json:"player"`
+	TopicID int32  `json:"topic_id"`
+}
+
+// QuestionChosen is emitted when question is chosen from remaining topic or for tie-break,
+// only Players take part in it.
+type QuestionChosen struct {
+	RoundQuestionID int32    `json:"round_question_id"`
+	Topic           string   `json:"topic"`
+	Cost            int32    `json:"cost"`
+	Players         []string `json:"players"`
+}
+
+// HostAnswer is sent to host as soon as question is chosen.
+type HostAnswer struct {
+	Host        string           `json:"-"`
+	Answer      string           `json:"answer"`
+	MediaURL    string           `json:"media_url,omitempty"`
+	MediaClip   entity.MediaClip `json:"media_clip"`
+	HostComment string           `json:"host_comment,omitempty"`
+}
+
+// WagerPlaced notifies everyone that player made a wager without revealing it.
+type WagerPlaced struct {
+	Player string `json:"player"`
+}
+
+// WagerAccepted is sent to host and player who made the wager.
+type WagerAccepted struct {
+	Host   string `json:"-"`
+	Player string `json:"player"`
+	Amount int32  `json:"amount"`
+}
+
+type QuestionShown struct {
+	Text      string           `json:"text"`
+	MediaURL  string           `json:"media_url,omitempty"`
+	MediaClip entity.MediaClip `json:"media_clip"`
+}
+
+// AnswerSubmitted notifies everyone that player answered without revealing the answer.
+type AnswerSubmitted struct {
+	Player string `json:"player"`
+}
+
+// AnswerWritten is sent to host and player who wrote the answer.
+type AnswerWritten struct {
+	Host   string `json:"-"`
+	Player string `json:"player"`
+	Text   string `json:"text"`
+}
+
+// AnswerMarked is sent to host to confirm the mark.
+type AnswerMarked struct {
+	Host    string `json:"-"`
+	Player  string `json:"player"`
+	Correct bool   `json:"correct"`
+}
+
+// Result of the player on final question, answer which wasn't marked in time is neither
+// correct nor wrong and doesn't change the score.
+type Result struct {
+	Player  string `json:"player"`
+	Marked  bool   `json:"marked"`
+	Correct bool   `json:"correct"`
+	Wager   int32  `json:"wager"`
+	Delta   int32  `json:"delta"`
+	Score   int32  `json:"score"`
+}
+
+type AnswersJudged struct {
+	Results []Result `json:"results"`
+}
+
+// TieBreak is emitted when several players share the highest score,
+// they play one more question.
+type TieBreak struct {
+	Players []string `json:"players"`
+}
+
+type Score struct {
+	Player string `json:"player"`
+	Score  int32  `json:"score"`
+}
+
+// Finished contains final scores in seating order, there are several winners
+// if tie wasn't broken because there are no questions left.
+type Finished struct {
+	Scores  []Score  `json:"scores"`
+	Winners []string `json:"winners"`
+}
+
+func (PhaseChanged) Kind() EventKind    { return EventPhaseChanged }
+func (TopicsShown) Kind() EventKind     { return EventTopicsShown }
+func (TopicEliminated) Kind() EventKind { return EventTopicEliminated }
+func (QuestionChosen) Kind() EventKind  { return EventQuestionChosen }
+func (HostAnswer) Kind() EventKind      { return EventHostAnswer }
+func (WagerPlaced) Kind() EventKind     { return EventWagerPlaced }
+func (WagerAccepted) Kind() EventKind   { return EventWagerAccepted }
+func (QuestionShown) Kind() EventKind   { return EventQuestionShown }
+func (AnswerSubmitted) Kind() EventKind { return EventAnswerSubmitted }
+func (AnswerWritten) Kind() EventKind   { return EventAnswerWritten }
+func (AnswerMarked) Kind() EventKind    { return EventAnswerMarked }
+func (AnswersJudged) Kind() EventKind   { return EventAnswersJudged }
+func (TieBreak) Kind() EventKind        { return EventTieBreak }
+func (Finished) Kind() EventKind        { return EventFinished }
+
+func (e HostAnswer) Recipients() []string    { return []string{e.Host} }
+func (e WagerAccepted) Recipients() []string { return []string{e.Host, e.Player} }
+func (e AnswerWritten) Recipients() []string { return []string{e.Host, e.Player} }
+func (e AnswerMarked) Recipients() []string  { return []string{e.Host} }
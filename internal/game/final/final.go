@@ -0,0 +1,596 @@
+// Package final implements final round of the game as a deterministic state machine.
+//
+// Players eliminate topics in turn starting from the one with the highest score until
+// single topic remains. Question of the remaining topic is chosen automatically, every
+// player makes a wager and writes an answer which is shown only to the host. Host marks
+// correct answers, player who answered correctly gets the wager plus question cost
+// and loses the wager otherwise. If several players share the highest score they play
+// questions which weren't played yet until the tie is broken or questions are over.
+//
+// Events are addressed per player so wagers and written answers never reach other players.
+package final
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+var (
+	ErrNoPlayers         = errors.New("final round must have at least one player")
+	ErrNoHost            = errors.New("final round must have a host")
+	ErrNoQuestions       = errors.New("final round has no questions")
+	ErrFinished          = errors.New("final round is finished")
+	ErrUnexpectedCommand = errors.New("command is not expected in current phase")
+	ErrNotHost           = errors.New("only host can do this")
+	ErrNotYourTurn       = errors.New("it's not your turn")
+	ErrNotParticipant    = errors.New("player doesn't take part in current question")
+	ErrTopicNotFound     = errors.New("topic not found or already eliminated")
+	ErrInvalidWager      = errors.New("wager must be from 0 to player score")
+	ErrAlreadyWagered    = errors.New("player already made a wager")
+	ErrAlreadyAnswered   = errors.New("player already answered")
+	ErrAlreadyMarked     = errors.New("answer is already marked")
+)
+
+const (
+	defaultEliminationTimeout = 15 * time.Second
+	defaultWagerTimeout       = 30 * time.Second
+	defaultAnswerTime         = 45 * time.Second
+	defaultHostTimeout        = 60 * time.Second
+)
+
+type Phase int8
+
+const (
+	// PhaseElimination waits for player to eliminate a topic.
+	PhaseElimination Phase = iota + 1
+
+	// PhaseWagering waits for wagers of participants, wager is 0 if it wasn't made in time.
+	PhaseWagering
+
+	// PhaseAnswering waits for written answers of participants.
+	PhaseAnswering
+
+	// PhaseJudging waits for host to mark answers.
+	PhaseJudging
+
+	// PhaseFinished means winners are determined.
+	PhaseFinished
+)
+
+var phaseNames = map[Phase]string{
+	PhaseElimination: "elimination",
+	PhaseWagering:    "wagering",
+	PhaseAnswering:   "answering",
+	PhaseJudging:     "judging",
+	PhaseFinished:    "finished",
+}
+
+func (p Phase) String() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+func (p Phase) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+type Player struct {
+	Nickname string
+	Score    int32
+}
+
+type Config struct {
+	Host string
+
+	// Players in seating order with their scores before the final round.
+	Players []Player
+
+	// Questions of the final round ordered by topic and question positions.
+	Questions []entity.RoundQuestionDetailed
+
+	// EliminationTimeout is a time given to player to eliminate a topic,
+	// first remaining topic is eliminated on timeout.
+	EliminationTimeout time.Duration
+
+	// WagerTimeout is a time given to participants to make wagers.
+	WagerTimeout time.Duration
+
+	// AnswerTime is a time given to participants to write answers.
+	AnswerTime time.Duration
+
+	// HostTimeout is a time given to host to mark answers.
+	HostTimeout time.Duration
+}
+
+func (c Config) withDefaults() Config {
+	if c.EliminationTimeout == 0 {
+		c.EliminationTimeout = defaultEliminationTimeout
+	}
+
+	if c.WagerTimeout == 0 {
+		c.WagerTimeout = defaultWagerTimeout
+	}
+
+	if c.AnswerTime == 0 {
+		c.AnswerTime = defaultAnswerTime
+	}
+
+	if c.HostTimeout == 0 {
+		c.HostTimeout = defaultHostTimeout
+	}
+
+	return c
+}
+
+type topic struct {
+	Topic
+	questions []*entity.RoundQuestionDetailed
+}
+
+type Final struct {
+	cfg Config
+
+	// players in seating order.
+	players []string
+	scores  map[string]int32
+
+	phase    Phase
+	deadline time.Time
+
+	topics     []topic
+	eliminated map[int32]struct{}
+
+	// order of topic elimination, turn is an index of player in it.
+	order []string
+	turn  int
+
+	played   map[int32]struct{}
+	question *entity.RoundQuestionDetailed
+
+	// participants of current question in seating order.
+	participants []string
+
+	wagers  map[string]int32
+	answers map[string]string
+	marks   map[string]bool
+}
+
+// New starts the final round with elimination of topics.
+func New(cfg Config, now time.Time) (*Final, []Event, error) {
+	if len(cfg.Players) == 0 {
+		return nil, nil, ErrNoPlayers
+	}
+
+	if cfg.Host == "" {
+		return nil, nil, ErrNoHost
+	}
+
+	if len(cfg.Questions) == 0 {
+		return nil, nil, ErrNoQuestions
+	}
+
+	f := &Final{
+		cfg:        cfg.withDefaults(),
+		players:    make([]string, len(cfg.Players)),
+		scores:     make(map[string]int32, len(cfg.Players)),
+		eliminated: make(map[int32]struct{}),
+		played:     make(map[int32]struct{}),
+	}
+
+	for i, p := range cfg.Players {
+		f.players[i] = p.Nickname
+		f.scores[p.Nickname] = p.Score
+	}
+
+	for i := range f.cfg.Questions {
+		q := &f.cfg.Questions[i]
+
+		if len(f.topics) == 0 || f.topics[len(f.topics)-1].ID != q.TopicID {
+			f.topics = append(f.topics, topic{Topic: Topic{ID: q.TopicID, Title: q.Topic}})
+		}
+
+		t := &f.topics[len(f.topics)-1]
+		t.questions = append(t.questions, q)
+	}
+
+	// stable sort keeps seating order of players with equal scores
+	f.order = slices.Clone(f.players)
+	slices.SortStableFunc(f.order, func(a, b string) int {
+		switch {
+		case f.scores[a] > f.scores[b]:
+			return -1
+		case f.scores[a] < f.scores[b]:
+			return 1
+		}
+
+		return 0
+	})
+
+	topics := make([]Topic, len(f.topics))
+	for i, t := range f.topics {
+		topics[i] = t.Topic
+	}
+
+	events := []Event{TopicsShown{Topics: topics, Order: slices.Clone(f.order)}}
+
+	return f, append(events, f.nextElimination(now)...), nil
+}
+
+func (f *Final) Phase() Phase         { return f.phase }
+func (f *Final) Score(p string) int32 { return f.scores[p] }
+
+// Deadline returns time when Tick must be called, zero if final round is finished.
+func (f *Final) Deadline() time.Time {
+	return f.deadline
+}
+
+// Delay moves deadline of current phase, it's used when game is paused.
+func (f *Final) Delay(d time.Duration) {
+	if !f.deadline.IsZero() {
+		f.deadline = f.deadline.Add(d)
+	}
+}
+
+// Turn returns player who must act in current phase, empty if every participant acts.
+func (f *Final) Turn() string {
+	switch f.phase {
+	case PhaseElimination:
+		return f.order[f.turn]
+	case PhaseJudging:
+		return f.cfg.Host
+	}
+
+	return ""
+}
+
+// Participants returns players taking part in current question.
+func (f *Final) Participants() []string {
+	return slices.Clone(f.participants)
+}
+
+// Scores returns scores of players in seating order.
+func (f *Final) Scores() []Score {
+	res := make([]Score, len(f.players))
+
+	for i, p := range f.players {
+		res[i] = Score{Player: p, Score: f.scores[p]}
+	}
+
+	return res
+}
+
+// Tick applies timeouts expired by now.
+func (f *Final) Tick(now time.Time) []Event {
+	var events []Event
+
+	for !f.deadline.IsZero() && !now.Before(f.deadline) {
+		events = append(events, f.timeout(now)...)
+	}
+
+	return events
+}
+
+func (f *Final) timeout(now time.Time) []Event {
+	switch f.phase {
+	case PhaseElimination:
+		for _, t := range f.topics {
+			if !f.isEliminated(t.ID) {
+				return f.eliminate(t.ID, now)
+			}
+		}
+	case PhaseWagering:
+		for _, p := range f.participants {
+			if _, ok := f.wagers[p]; !ok {
+				f.wagers[p] = 0
+			}
+		}
+
+		return f.showQuestion(now)
+	case PhaseAnswering:
+		return f.setPhase(PhaseJudging, f.cfg.Host, now.Add(f.cfg.HostTimeout))
+	case PhaseJudging:
+		return f.judge(now)
+	}
+
+	f.deadline = time.Time{}
+
+	return nil
+}
+
+// Eliminate removes the topic from the final round.
+func (f *Final) Eliminate(player string, topicID int32, now time.Time) ([]Event, error) {
+	if err := f.expect(PhaseElimination); err != nil {
+		return nil, err
+	}
+
+	if player != f.order[f.turn] {
+		return nil, ErrNotYourTurn
+	}
+
+	if !slices.ContainsFunc(f.topics, func(t topic) bool { return t.ID == topicID }) || f.isEliminated(topicID) {
+		return nil, ErrTopicNotFound
+	}
+
+	return f.eliminate(topicID, now), nil
+}
+
+// Wager sets wager of the participant, it can't be changed.
+func (f *Final) Wager(player string, amount int32, now time.Time) ([]Event, error) {
+	if err := f.expect(PhaseWagering); err != nil {
+		return nil, err
+	}
+
+	if !f.isParticipant(player) {
+		return nil, ErrNotParticipant
+	}
+
+	if _, ok := f.wagers[player]; ok {
+		return nil, ErrAlreadyWagered
+	}
+
+	if amount < 0 || amount > max(f.scores[player], 0) {
+		return nil, ErrInvalidWager
+	}
+
+	f.wagers[player] = amount
+
+	events := []Event{
+		WagerPlaced{Player: player},
+		WagerAccepted{Host: f.cfg.Host, Player: player, Amount: amount},
+	}
+
+	// question is shown as soon as everyone made a wager
+	if len(f.wagers) == len(f.participants) {
+		return append(events, f.showQuestion(now)...), nil
+	}
+
+	return events, nil
+}
+
+// Answer sets written answer of the participant, it can't be changed.
+func (f *Final) Answer(player, text string, now time.Time) ([]Event, error) {
+	if err := f.expect(PhaseAnswering); err != nil {
+		return nil, err
+	}
+
+	if !f.isParticipant(player) {
+		return nil, ErrNotParticipant
+	}
+
+	if _, ok := f.answers[player]; ok {
+		return nil, ErrAlreadyAnswered
+	}
+
+	f.answers[player] = text
+
+	events := []Event{
+		AnswerSubmitted{Player: player},
+		AnswerWritten{Host: f.cfg.Host, Player: player, Text: text},
+	}
+
+	if len(f.answers) == len(f.participants) {
+		return append(events, f.setPhase(PhaseJudging, f.cfg.Host, now.Add(f.cfg.HostTimeout))...), nil
+	}
+
+	return events, nil
+}
+
+// Mark marks answer of the participant as correct or wrong, answers are judged
+// as soon as every answer is marked.
+func (f *Final) Mark(host, player string, correct bool, now time.Time) ([]Event, error) {
+	if err := f.expect(PhaseJudging); err != nil {
+		return nil, err
+	}
+
+	if host != f.cfg.Host {
+		return nil, ErrNotHost
+	}
+
+	if !f.isParticipant(player) {
+		return nil, ErrNotParticipant
+	}
+
+	if _, ok := f.marks[player]; ok {
+		return nil, ErrAlreadyMarked
+	}
+
+	f.marks[player] = correct
+
+	events := []Event{AnswerMarked{Host: f.cfg.Host, Player: player, Correct: correct}}
+
+	if len(f.marks) == len(f.participants) {
+		return append(events, f.judge(now)...), nil
+	}
+
+	return events, nil
+}
+
+func (f *Final) expect(p Phase) error {
+	if f.phase == PhaseFinished {
+		return ErrFinished
+	}
+
+	if f.phase != p {
+		return ErrUnexpectedCommand
+	}
+
+	return nil
+}
+
+func (f *Final) setPhase(p Phase, player string, deadline time.Time) []Event {
+	f.phase = p
+	f.deadline = deadline
+
+	return []Event{PhaseChanged{Phase: p, Player: player, Deadline: deadline}}
+}
+
+func (f *Final) isEliminated(topicID int32) bool {
+	_, ok := f.eliminated[topicID]
+	return ok
+}
+
+func (f *Final) isParticipant(p string) bool {
+	return slices.Contains(f.participants, p)
+}
+
+func (f *Final) eliminate(topicID int32, now time.Time) []Event {
+	f.eliminated[topicID] = struct{}{}
+	events := []Event{TopicEliminated{Player: f.order[f.turn], TopicID: topicID}}
+	f.turn = (f.turn + 1) % len(f.order)
+
+	return append(events, f.nextElimination(now)...)
+}
+
+// nextElimination gives turn to next player or chooses question of the last remaining topic.
+func (f *Final) nextElimination(now time.Time) []Event {
+	var remaining []topic
+
+	for _, t := range f.topics {
+		if !f.isEliminated(t.ID) {
+			remaining = append(remaining, t)
+		}
+	}
+
+	if len(remaining) > 1 {
+		return f.setPhase(PhaseElimination, f.order[f.turn], now.Add(f.cfg.EliminationTimeout))
+	}
+
+	return f.chooseQuestion(remaining[0].questions[0], f.players, now)
+}
+
+func (f *Final) chooseQuestion(q *entity.RoundQuestionDetailed, participants []string, now time.Time) []Event {
+	f.played[q.ID] = struct{}{}
+	f.question = q
+	f.participants = participants
+	f.wagers = make(map[string]int32, len(participants))
+	f.answers = make(map[string]string, len(participants))
+	f.marks = make(map[string]bool, len(participants))
+
+	events := []Event{
+		QuestionChosen{
+			RoundQuestionID: q.ID,
+			Topic:           q.Topic,
+			Cost:            q.Cost,
+			Players:         slices.Clone(participants),
+		},
+		HostAnswer{
+			Host:        f.cfg.Host,
+			Answer:      q.Answer,
+			MediaURL:    q.AnswerMediaURL,
+			MediaClip:   q.AnswerMediaClip,
+			HostComment: q.HostComment,
+		},
+	}
+
+	return append(events, f.setPhase(PhaseWagering, "", now.Add(f.cfg.WagerTimeout))...)
+}
+
+func (f *Final) showQuestion(now time.Time) []Event {
+	events := []Event{QuestionShown{
+		Text:      f.question.Question,
+		MediaURL:  f.question.QuestionMediaURL,
+		MediaClip: f.question.QuestionMediaClip,
+	}}
+
+	return append(events, f.setPhase(PhaseAnswering, "", now.Add(f.cfg.AnswerTime))...)
+}
+
+// judge applies marks to scores and starts tie-break or finishes the final round.
+func (f *Final) judge(now time.Time) []Event {
+	results := make([]Result, len(f.participants))
+
+	for i, p := range f.participants {
+		correct, marked := f.marks[p]
+		wager := f.wagers[p]
+
+		var delta int32
+
+		switch {
+		case marked && correct:
+			delta = wager + f.question.Cost
+		case marked:
+			delta = -wager
+		}
+
+		f.scores[p] += delta
+
+		results[i] = Result{
+			Player:  p,
+			Marked:  marked,
+			Correct: correct,
+			Wager:   wager,
+			Delta:   delta,
+			Score:   f.scores[p],
+		}
+	}
+
+	events := []Event{AnswersJudged{Results: results}}
+	leaders := f.leaders()
+
+	if len(leaders) > 1 {
+		if q, ok := f.unplayedQuestion(); ok {
+			events = append(events, TieBreak{Players: slices.Clone(leaders)})
+			return append(events, f.chooseQuestion(q, leaders, now)...)
+		}
+	}
+
+	f.question = nil
+	f.participants = nil
+	events = append(events, Finished{Scores: f.Scores(), Winners: leaders})
+
+	return append(events, f.setPhase(PhaseFinished, "", time.Time{})...)
+}
+
+// leaders returns players with the highest score in seating order.
+func (f *Final) leaders() []string {
+	var (
+		res []string
+		top int32
+	)
+
+	for _, p := range f.players {
+		switch s := f.scores[p]; {
+		case len(res) == 0 || s > top:
+			res = []string{p}
+			top = s
+		case s == top:
+			res = append(res, p)
+		}
+	}
+
+	return res
+}
+
+// unplayedQuestion returns first question which wasn't played yet,
+// questions of the remaining topic come first.
+func (f *Final) unplayedQuestion() (*entity.RoundQuestionDetailed, bool) {
+	topics := slices.Clone(f.topics)
+	slices.SortStableFunc(topics, func(a, b topic) int {
+		ea, eb := f.isEliminated(a.ID), f.isEliminated(b.ID)
+
+		switch {
+		case !ea && eb:
+			return -1
+		case ea && !eb:
+			return 1
+		}
+
+		return 0
+	})
+
+	for _, t := range topics {
+		for _, q := range t.questions {
+			if _, ok := f.played[q.ID]; !ok {
+				return q, true
+			}
+		}
+	}
+
+	return nil, false
+}
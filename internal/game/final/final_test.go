@@ -0,0 +1,581 @@
+package final
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+const (
+	host  = "host"
+	alice = "alice"
+	bob   = "bob"
+	carol = "carol"
+)
+
+const (
+	topic1 int32 = iota + 1
+	topic2
+	topic3
+	topic4
+)
+
+var start = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newQuestion(id, topicID int32, cost int32) entity.RoundQuestionDetailed {
+	return entity.RoundQuestionDetailed{
+		RoundQuestion: entity.RoundQuestion{
+			ID:      id,
+			TopicID: topicID,
+			Type:    entity.QTypeStandard,
+			Cost:    cost,
+		},
+		Topic:    "topic",
+		Question: "question",
+		Answer:   "answer",
+	}
+}
+
+// testQuestions returns 4 topics, last topic has 2 questions.
+func testQuestions() []entity.RoundQuestionDetailed {
+	return []entity.RoundQuestionDetailed{
+		newQuestion(11, topic1, 100),
+		newQuestion(21, topic2, 100),
+		newQuestion(31, topic3, 100),
+		newQuestion(41, topic4, 100),
+		newQuestion(42, topic4, 100),
+	}
+}
+
+func players(scores ...int32) []Player {
+	nicknames := []string{alice, bob, carol}
+	res := make([]Player, len(scores))
+
+	for i, s := range scores {
+		res[i] = Player{Nickname: nicknames[i], Score: s}
+	}
+
+	return res
+}
+
+type op int8
+
+const (
+	opEliminate op = iota + 1
+	opWager
+	opAnswer
+	opMark
+	opTick
+)
+
+type step struct {
+	op      op
+	player  string
+	target  string
+	topicID int32
+	amount  int32
+	text    string
+	correct bool
+	wantErr error
+}
+
+func eliminate(p string, id int32) step { return step{op: opEliminate, player: p, topicID: id} }
+func wager(p string, amount int32) step { return step{op: opWager, player: p, amount: amount} }
+func answer(p, text string) step        { return step{op: opAnswer, player: p, text: text} }
+func tick() step                        { return step{op: opTick} }
+
+func mark(p string, correct bool) step {
+	return step{op: opMark, player: host, target: p, correct: correct}
+}
+
+func fail(s step, err error) step {
+	s.wantErr = err
+	return s
+}
+
+func steps(ss ...[]step) []step {
+	var res []step
+	for _, s := range ss {
+		res = append(res, s...)
+	}
+
+	return res
+}
+
+// eliminateAll eliminates topics 1-3 by players in given order.
+func eliminateAll(order ...string) []step {
+	return []step{
+		eliminate(order[0], topic1),
+		eliminate(order[1], topic2),
+		eliminate(order[2], topic3),
+	}
+}
+
+// play plays question by players with given wagers and marks.
+func play(wagers map[string]int32, correct map[string]bool) []step {
+	var res []step
+
+	for _, p := range []string{alice, bob, carol} {
+		if w, ok := wagers[p]; ok {
+			res = append(res, wager(p, w))
+		}
+	}
+
+	for _, p := range []string{alice, bob, carol} {
+		if _, ok := wagers[p]; ok {
+			res = append(res, answer(p, "answer of "+p))
+		}
+	}
+
+	for _, p := range []string{alice, bob, carol} {
+		if c, ok := correct[p]; ok {
+			res = append(res, mark(p, c))
+		}
+	}
+
+	return res
+}
+
+func run(t *testing.T, f *Final, ss []step) []Event {
+	t.Helper()
+
+	var all []Event
+	now := start
+
+	for i, s := range ss {
+		var (
+			events []Event
+			err    error
+		)
+
+		if s.op == opTick {
+			if d := f.Deadline(); !d.IsZero() {
+				now = d
+			}
+
+			all = append(all, f.Tick(now)...)
+
+			continue
+		}
+
+		now = now.Add(time.Second)
+
+		switch s.op {
+		case opEliminate:
+			events, err = f.Eliminate(s.player, s.topicID, now)
+		case opWager:
+			events, err = f.Wager(s.player, s.amount, now)
+		case opAnswer:
+			events, err = f.Answer(s.player, s.text, now)
+		case opMark:
+			events, err = f.Mark(s.player, s.target, s.correct, now)
+		}
+
+		require.ErrorIs(t, err, s.wantErr, "step %d", i)
+		all = append(all, events...)
+	}
+
+	return all
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       Config
+		wantOrder []string
+		wantPhase Phase
+		wantErr   error
+	}{
+		{
+			name:      "players eliminate from highest score",
+			cfg:       Config{Host: host, Players: players(100, 300, 200), Questions: testQuestions()},
+			wantOrder: []string{bob, carol, alice},
+			wantPhase: PhaseElimination,
+		},
+		{
+			name:      "equal scores in seating order",
+			cfg:       Config{Host: host, Players: players(100, 300, 100), Questions: testQuestions()},
+			wantOrder: []string{bob, alice, carol},
+			wantPhase: PhaseElimination,
+		},
+		{
+			name: "single topic",
+			cfg: Config{
+				Host:      host,
+				Players:   players(100, 300),
+				Questions: []entity.RoundQuestionDetailed{newQuestion(11, topic1, 100)},
+			},
+			wantOrder: []string{bob, alice},
+			wantPhase: PhaseWagering,
+		},
+		{
+			name:    "no players",
+			cfg:     Config{Host: host, Questions: testQuestions()},
+			wantErr: ErrNoPlayers,
+		},
+		{
+			name:    "no host",
+			cfg:     Config{Players: players(100), Questions: testQuestions()},
+			wantErr: ErrNoHost,
+		},
+		{
+			name:    "no questions",
+			cfg:     Config{Host: host, Players: players(100)},
+			wantErr: ErrNoQuestions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, events, err := New(tt.cfg, start)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantOrder, f.order)
+			assert.Equal(t, tt.wantPhase, f.Phase())
+			require.NotEmpty(t, events)
+			assert.Equal(t, tt.wantOrder, events[0].(TopicsShown).Order)
+		})
+	}
+}
+
+func TestFinal(t *testing.T) {
+	tests := []struct {
+		name    string
+		players []Player
+		steps   []step
+
+		wantPhase  Phase
+		wantScores map[string]int32
+		// wantParticipants are checked only if phase isn't finished
+		wantParticipants []string
+		// wantWinners are checked only if phase is finished
+		wantWinners []string
+	}{
+		{
+			name:    "elimination",
+			players: players(100, 300, 200),
+			steps: []step{
+				fail(eliminate(alice, topic1), ErrNotYourTurn),
+				eliminate(bob, topic1),
+				fail(eliminate(carol, topic1), ErrTopicNotFound),
+				fail(eliminate(carol, 100), ErrTopicNotFound),
+				eliminate(carol, topic2),
+				fail(wager(alice, 100), ErrUnexpectedCommand),
+				eliminate(alice, topic3),
+			},
+			wantPhase:        PhaseWagering,
+			wantScores:       map[string]int32{alice: 100, bob: 300, carol: 200},
+			wantParticipants: []string{alice, bob, carol},
+		},
+		{
+			name:    "elimination turns repeat",
+			players: players(100, 300),
+			steps: []step{
+				eliminate(bob, topic1),
+				eliminate(alice, topic2),
+				fail(eliminate(alice, topic3), ErrNotYourTurn),
+				eliminate(bob, topic3),
+			},
+			wantPhase:        PhaseWagering,
+			wantScores:       map[string]int32{alice: 100, bob: 300},
+			wantParticipants: []string{alice, bob},
+		},
+		{
+			name:    "elimination timeout",
+			players: players(100, 300, 200),
+			steps: []step{
+				tick(),
+				tick(),
+				tick(),
+			},
+			wantPhase:        PhaseWagering,
+			wantScores:       map[string]int32{alice: 100, bob: 300, carol: 200},
+			wantParticipants: []string{alice, bob, carol},
+		},
+		{
+			name:    "invalid wagers",
+			players: players(100, 300, -200),
+			steps: steps(eliminateAll(bob, alice, carol), []step{
+				fail(wager(alice, 200), ErrInvalidWager),
+				fail(wager(alice, -1), ErrInvalidWager),
+				fail(wager(carol, 100), ErrInvalidWager),
+				fail(wager(host, 0), ErrNotParticipant),
+				fail(answer(alice, "answer"), ErrUnexpectedCommand),
+				wager(alice, 100),
+				fail(wager(alice, 50), ErrAlreadyWagered),
+				wager(carol, 0),
+			}),
+			wantPhase:        PhaseWagering,
+			wantScores:       map[string]int32{alice: 100, bob: 300, carol: -200},
+			wantParticipants: []string{alice, bob, carol},
+		},
+		{
+			name:    "answers",
+			players: players(100, 300, 200),
+			steps: steps(eliminateAll(bob, carol, alice), []step{
+				wager(alice, 100),
+				wager(bob, 300),
+				wager(carol, 0),
+				fail(wager(carol, 0), ErrUnexpectedCommand),
+				answer(alice, "answer"),
+				fail(answer(alice, "other answer"), ErrAlreadyAnswered),
+				fail(answer(host, "answer"), ErrNotParticipant),
+				fail(mark(alice, true), ErrUnexpectedCommand),
+				answer(bob, "answer"),
+				answer(carol, "answer"),
+				fail(step{op: opMark, player: alice, target: bob, correct: true}, ErrNotHost),
+				mark(alice, true),
+				fail(mark(alice, false), ErrAlreadyMarked),
+			}),
+			wantPhase:        PhaseJudging,
+			wantScores:       map[string]int32{alice: 100, bob: 300, carol: 200},
+			wantParticipants: []string{alice, bob, carol},
+		},
+		{
+			name:    "no tie",
+			players: players(100, 300, 200),
+			steps: steps(eliminateAll(bob, carol, alice), play(
+				map[string]int32{alice: 100, bob: 300, carol: 200},
+				map[string]bool{alice: true, bob: false, carol: true},
+			)),
+			wantPhase:   PhaseFinished,
+			wantScores:  map[string]int32{alice: 300, bob: 0, carol: 500},
+			wantWinners: []string{carol},
+		},
+		{
+			name:    "command after finish",
+			players: players(100, 300, 200),
+			steps: steps(eliminateAll(bob, carol, alice), play(
+				map[string]int32{alice: 0, bob: 0, carol: 0},
+				map[string]bool{alice: false, bob: false, carol: false},
+			), []step{
+				fail(wager(alice, 0), ErrFinished),
+				fail(mark(alice, true), ErrFinished),
+			}),
+			wantPhase:   PhaseFinished,
+			wantScores:  map[string]int32{alice: 100, bob: 300, carol: 200},
+			wantWinners: []string{bob},
+		},
+		{
+			name:    "timeouts",
+			players: players(100, 300, 200),
+			steps: steps(eliminateAll(bob, carol, alice), []step{
+				wager(alice, 100),
+				tick(),
+				answer(alice, "answer"),
+				answer(carol, "answer"),
+				tick(),
+				mark(alice, false),
+				mark(bob, false),
+				tick(),
+			}),
+			// carol wasn't marked in time, bob wagered 0 on timeout
+			wantPhase:   PhaseFinished,
+			wantScores:  map[string]int32{alice: 0, bob: 300, carol: 200},
+			wantWinners: []string{bob},
+		},
+		{
+			name:    "two-way tie broken",
+			players: players(100, 300, 300),
+			steps: steps(eliminateAll(bob, carol, alice), play(
+				map[string]int32{alice: 100, bob: 0, carol: 0},
+				map[string]bool{alice: false, bob: true, carol: true},
+			), []step{
+				fail(wager(alice, 0), ErrNotParticipant),
+			}, play(
+				map[string]int32{bob: 400, carol: 400},
+				map[string]bool{bob: true, carol: false},
+			)),
+			wantPhase:   PhaseFinished,
+			wantScores:  map[string]int32{alice: 0, bob: 900, carol: 0},
+			wantWinners: []string{bob},
+		},
+		{
+			name:    "three-way tie",
+			players: players(300, 300, 300),
+			steps: steps(eliminateAll(alice, bob, carol), play(
+				map[string]int32{alice: 0, bob: 0, carol: 0},
+				map[string]bool{alice: false, bob: false, carol: false},
+			)),
+			wantPhase:        PhaseWagering,
+			wantScores:       map[string]int32{alice: 300, bob: 300, carol: 300},
+			wantParticipants: []string{alice, bob, carol},
+		},
+		{
+			name:    "three-way tie narrowed to two-way tie and broken",
+			players: players(300, 300, 300),
+			steps: steps(eliminateAll(alice, bob, carol), play(
+				map[string]int32{alice: 0, bob: 0, carol: 0},
+				map[string]bool{alice: false, bob: false, carol: false},
+			), play(
+				map[string]int32{alice: 100, bob: 100, carol: 100},
+				map[string]bool{alice: true, bob: true, carol: false},
+			), []step{
+				fail(wager(carol, 0), ErrNotParticipant),
+			}, play(
+				map[string]int32{alice: 0, bob: 500},
+				map[string]bool{alice: true, bob: false},
+			)),
+			wantPhase:   PhaseFinished,
+			wantScores:  map[string]int32{alice: 600, bob: 0, carol: 200},
+			wantWinners: []string{alice},
+		},
+		{
+			name:    "tie not broken when questions are over",
+			players: players(300, 300, 300),
+			steps: steps(eliminateAll(alice, bob, carol), play(
+				map[string]int32{alice: 0, bob: 0, carol: 0},
+				map[string]bool{alice: true, bob: true, carol: false},
+			), play(
+				map[string]int32{alice: 0, bob: 0},
+				map[string]bool{alice: true, bob: true},
+			), play(
+				map[string]int32{alice: 0, bob: 0},
+				map[string]bool{alice: false, bob: false},
+			), play(
+				map[string]int32{alice: 0, bob: 0},
+				map[string]bool{alice: false, bob: false},
+			), play(
+				map[string]int32{alice: 0, bob: 0},
+				map[string]bool{alice: false, bob: false},
+			)),
+			wantPhase:   PhaseFinished,
+			wantScores:  map[string]int32{alice: 500, bob: 500, carol: 300},
+			wantWinners: []string{alice, bob},
+		},
+		{
+			name:    "tie-break lets other player win",
+			players: players(500, 500, 400),
+			steps: steps(eliminateAll(alice, bob, carol), play(
+				map[string]int32{alice: 0, bob: 0, carol: 0},
+				map[string]bool{alice: false, bob: false, carol: false},
+			), play(
+				map[string]int32{alice: 500, bob: 200},
+				map[string]bool{alice: false, bob: false},
+			)),
+			wantPhase:   PhaseFinished,
+			wantScores:  map[string]int32{alice: 0, bob: 300, carol: 400},
+			wantWinners: []string{carol},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, err := New(Config{Host: host, Players: tt.players, Questions: testQuestions()}, start)
+			require.NoError(t, err)
+
+			events := run(t, f, tt.steps)
+
+			assert.Equal(t, tt.wantPhase, f.Phase())
+
+			for p, score := range tt.wantScores {
+				assert.Equal(t, score, f.Score(p), p)
+			}
+
+			if tt.wantPhase != PhaseFinished {
+				assert.Equal(t, tt.wantParticipants, f.Participants())
+				return
+			}
+
+			require.NotEmpty(t, events)
+			assert.True(t, f.Deadline().IsZero())
+
+			finished, ok := events[len(events)-2].(Finished)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantWinners, finished.Winners)
+		})
+	}
+}
+
+func TestFinal_TieBreakQuestions(t *testing.T) {
+	f, _, err := New(Config{Host: host, Players: players(300, 300), Questions: testQuestions()}, start)
+	require.NoError(t, err)
+
+	events := run(t, f, steps(
+		[]step{eliminate(alice, topic4), eliminate(bob, topic1), eliminate(alice, topic3)},
+		play(map[string]int32{alice: 0, bob: 0}, map[string]bool{alice: true, bob: true}),
+		play(map[string]int32{alice: 0, bob: 0}, map[string]bool{alice: true, bob: true}),
+		play(map[string]int32{alice: 0, bob: 0}, map[string]bool{alice: true, bob: true}),
+		play(map[string]int32{alice: 0, bob: 0}, map[string]bool{alice: true, bob: true}),
+		play(map[string]int32{alice: 0, bob: 0}, map[string]bool{alice: true, bob: true}),
+	))
+
+	var chosen []int32
+
+	for _, e := range events {
+		if q, ok := e.(QuestionChosen); ok {
+			chosen = append(chosen, q.RoundQuestionID)
+		}
+	}
+
+	// question of the remaining topic first, then not played questions in topic order
+	assert.Equal(t, []int32{21, 11, 31, 41, 42}, chosen)
+	assert.Equal(t, PhaseFinished, f.Phase())
+}
+
+func TestFinal_PrivateEvents(t *testing.T) {
+	f, events, err := New(Config{Host: host, Players: players(100, 300, 200), Questions: testQuestions()}, start)
+	require.NoError(t, err)
+
+	events = append(events, run(t, f, steps(eliminateAll(bob, carol, alice), []step{
+		wager(alice, 77),
+		wager(bob, 88),
+		wager(carol, 99),
+		answer(alice, "secret of alice"),
+		answer(bob, "secret of bob"),
+		answer(carol, "secret of carol"),
+		mark(alice, true),
+		mark(bob, false),
+		mark(carol, false),
+	}))...)
+
+	var written int
+
+	for _, e := range events {
+		pe, ok := e.(PrivateEvent)
+		if !ok {
+			b, err := json.Marshal(e)
+			require.NoError(t, err)
+
+			assert.NotContains(t, string(b), "secret of", e.Kind())
+			assert.NotContains(t, string(b), `"amount"`, e.Kind())
+
+			continue
+		}
+
+		switch e := e.(type) {
+		case AnswerWritten:
+			written++
+			assert.ElementsMatch(t, []string{host, e.Player}, pe.Recipients())
+			assert.Equal(t, "secret of "+e.Player, e.Text)
+		case WagerAccepted:
+			assert.ElementsMatch(t, []string{host, e.Player}, pe.Recipients())
+		default:
+			assert.Equal(t, []string{host}, pe.Recipients(), e.Kind())
+		}
+	}
+
+	assert.Equal(t, 3, written)
+}
+
+func TestFinal_Delay(t *testing.T) {
+	f, _, err := New(Config{
+		Host:               host,
+		Players:            players(100),
+		Questions:          testQuestions(),
+		EliminationTimeout: 10 * time.Second,
+	}, start)
+	require.NoError(t, err)
+
+	f.Delay(time.Minute)
+	assert.Equal(t, start.Add(10*time.Second+time.Minute), f.Deadline())
+	assert.Empty(t, f.Tick(start.Add(10*time.Second)))
+	assert.NotEmpty(t, f.Tick(f.Deadline()))
+}
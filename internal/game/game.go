@@ -0,0 +1,836 @@
+// Package game implements game flow of a room as a deterministic state machine.
+//
+// Game is driven by player commands and by the clock: every method accepts current time
+// and returns events describing state changes, game itself never reads the clock,
+// starts goroutines or does any I/O. Caller is responsible for serializing calls
+// and for calling Tick when Deadline is reached.
+package game
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game/auction"
+	"github.com/ysomad/answersuck/internal/game/buzzer"
+	"github.com/ysomad/answersuck/internal/game/secret"
+)
+
+var (
+	ErrNoPlayers         = errors.New("game must have at least one player")
+	ErrNoHost            = errors.New("game must have a host")
+	ErrEmptyPack         = errors.New("pack has no questions")
+	ErrGameFinished      = errors.New("game is finished")
+	ErrGamePaused        = errors.New("game is paused")
+	ErrUnexpectedCommand = errors.New("command is not expected on current stage")
+	ErrUnknownPlayer     = errors.New("player is not in the game")
+	ErrNotHost           = errors.New("only host can do this")
+	ErrNotYourTurn       = errors.New("it's not your turn")
+	ErrQuestionNotFound  = errors.New("question not found in current round")
+	ErrQuestionPlayed    = errors.New("question is already played")
+	ErrAlreadyAnswered   = errors.New("player already answered or passed the question")
+	ErrBuzzTooEarly      = errors.New("button can't be pressed until question is read")
+	ErrBuzzLockedOut     = errors.New("button can't be pressed for a while after false start")
+	ErrAlreadyBuzzed     = errors.New("player already pressed the button")
+	ErrInvalidBid        = errors.New("bid must be greater than current bid and not less than question cost")
+	ErrBidTooHigh        = errors.New("bid can't be greater than player score")
+	ErrAllInRequired     = errors.New("all-in can be outbid only by all-in")
+	ErrInvalidTransfer   = errors.New("question can't be transferred to this player")
+	ErrInvalidCost       = errors.New("cost of secret question is out of range")
+	ErrAlreadyPaused     = errors.New("game is already paused")
+	ErrNotPaused         = errors.New("game is not paused")
+)
+
+const (
+	defaultBuzzTimeout   = 10 * time.Second
+	defaultBidTimeout    = 15 * time.Second
+	defaultAnswerTime    = 15 * time.Second
+	defaultRevealTime    = 5 * time.Second
+	defaultIntroTime     = 5 * time.Second
+	minReadTime          = 2 * time.Second
+	readTimePerCharacter = 60 * time.Millisecond
+)
+
+type Config struct {
+	Host string
+
+	// Players in seating order, first player chooses first question if FirstChooser is empty.
+	Players []string
+
+	FirstChooser string
+
+	Settings entity.RoomSettings
+
+	// BuzzTimeout is a time given to players to press the button after question is read.
+	BuzzTimeout time.Duration
+
+	// BidTimeout is a time given to player to bid on auction question, player passes on timeout.
+	BidTimeout time.Duration
+
+	// RevealTime is a time answer is shown before next question is chosen.
+	RevealTime time.Duration
+
+	// IntroTime is a time name and topics of the round are shown before first question is chosen.
+	IntroTime time.Duration
+
+	// FalseStartLockout is a time player can't press the button after pressing it too early.
+	FalseStartLockout time.Duration
+
+	// BuzzTolerance is a time button presses are collected after the first one,
+	// the earliest of them by latency compensated time wins.
+	BuzzTolerance time.Duration
+
+	// MaxRTT limits round-trip time reported by clients for latency compensation.
+	MaxRTT time.Duration
+}
+
+func (c Config) withDefaults() Config {
+	if c.BuzzTimeout == 0 {
+		c.BuzzTimeout = defaultBuzzTimeout
+	}
+
+	if c.BidTimeout == 0 {
+		c.BidTimeout = defaultBidTimeout
+	}
+
+	if c.RevealTime == 0 {
+		c.RevealTime = defaultRevealTime
+	}
+
+	if c.IntroTime == 0 {
+		c.IntroTime = defaultIntroTime
+	}
+
+	if c.FalseStartLockout == 0 {
+		c.FalseStartLockout = buzzer.DefaultLockout
+	}
+
+	if c.BuzzTolerance == 0 {
+		c.BuzzTolerance = buzzer.DefaultTolerance
+	}
+
+	if c.MaxRTT == 0 {
+		c.MaxRTT = buzzer.DefaultMaxRTT
+	}
+
+	return c
+}
+
+type Game struct {
+	cfg  Config
+	pack *Pack
+
+	scores map[string]int32
+
+	stage    Stage
+	deadline time.Time
+
+	paused bool
+
+	// autoPaused is true if game is paused because host disconnected.
+	autoPaused bool
+
+	// offline contains players and host who are disconnected.
+	offline map[string]struct{}
+
+	// remaining is a time left until deadline when game was paused.
+	remaining time.Duration
+
+	roundIdx int
+	played   map[int32]struct{}
+	chooser  string
+
+	question *entity.RoundQuestionDetailed
+	opener   string
+	answerer string
+	cost     int32
+
+	// answerText is the answer given by answerer to current question.
+	answerText string
+
+	// answered contains players who answered or passed current question.
+	answered map[string]struct{}
+
+	// buzzer is used only on standard questions.
+	buzzer *buzzer.Buzzer
+
+	auction *auction.Auction
+
+	// secret is used only on secret and super secret questions until they have player and cost.
+	secret *secret.Transfer
+}
+
+// New starts the game with intro of the first round.
+func New(p *Pack, cfg Config, now time.Time) (*Game, []Event, error) {
+	if len(cfg.Players) == 0 {
+		return nil, nil, ErrNoPlayers
+	}
+
+	if cfg.Host == "" {
+		return nil, nil, ErrNoHost
+	}
+
+	if len(p.Rounds) == 0 {
+		return nil, nil, ErrEmptyPack
+	}
+
+	// game with zero timeouts would move to the next stage on every tick
+	if err := cfg.Settings.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	chooser := cfg.FirstChooser
+	if chooser == "" {
+		chooser = cfg.Players[0]
+	}
+
+	if !slices.Contains(cfg.Players, chooser) {
+		return nil, nil, ErrUnknownPlayer
+	}
+
+	g := &Game{
+		cfg:     cfg.withDefaults(),
+		pack:    p,
+		scores:  make(map[string]int32, len(cfg.Players)),
+		played:  make(map[int32]struct{}),
+		offline: make(map[string]struct{}),
+		chooser: chooser,
+	}
+
+	g.cfg.Players = slices.Clone(cfg.Players)
+
+	for _, p := range g.cfg.Players {
+		g.scores[p] = 0
+	}
+
+	return g, g.startRound(0, now), nil
+}
+
+func (g *Game) Stage() Stage         { return g.stage }
+func (g *Game) Paused() bool         { return g.paused }
+func (g *Game) Chooser() string      { return g.chooser }
+func (g *Game) Answerer() string     { return g.answerer }
+func (g *Game) Score(p string) int32 { return g.scores[p] }
+func (g *Game) Host() string         { return g.cfg.Host }
+
+// Clone returns copy of the game which can be changed independently,
+// pack and config are shared since game never changes them.
+func (g *Game) Clone() *Game {
+	c := *g
+	c.scores = maps.Clone(g.scores)
+	c.offline = maps.Clone(g.offline)
+	c.played = maps.Clone(g.played)
+	c.answered = maps.Clone(g.answered)
+
+	if g.buzzer != nil {
+		c.buzzer = g.buzzer.Clone()
+	}
+
+	if g.auction != nil {
+		c.auction = g.auction.Clone()
+	}
+
+	if g.secret != nil {
+		c.secret = g.secret.Clone()
+	}
+
+	return &c
+}
+
+// Deadline returns time when Tick must be called, zero if game has no running timer.
+func (g *Game) Deadline() time.Time {
+	if g.paused {
+		return time.Time{}
+	}
+
+	return g.deadline
+}
+
+// Scores returns scores of players in seating order.
+func (g *Game) Scores() []Score {
+	res := make([]Score, len(g.cfg.Players))
+
+	for i, p := range g.cfg.Players {
+		res[i] = Score{Player: p, Score: g.scores[p]}
+	}
+
+	return res
+}
+
+// Handle applies command of the player to the game.
+func (g *Game) Handle(cmd Command, now time.Time) ([]Event, error) {
+	if g.stage == StageFinished {
+		return nil, ErrGameFinished
+	}
+
+	if !g.isHost(cmd.issuer()) && !g.isPlayer(cmd.issuer()) {
+		return nil, ErrUnknownPlayer
+	}
+
+	switch c := cmd.(type) {
+	case Pause:
+		return g.pause(c, now)
+	case SetScore:
+		return g.setScore(c)
+	case Presence:
+		return g.presence(c, now), nil
+	}
+
+	if g.paused {
+		return nil, ErrGamePaused
+	}
+
+	switch c := cmd.(type) {
+	case SelectQuestion:
+		return g.selectQuestion(c, now)
+	case Transfer:
+		return g.transfer(c, now)
+	case ChooseCost:
+		return g.chooseCost(c, now)
+	case Bid:
+		return g.bid(c, now)
+	case Buzz:
+		return g.buzz(c, now)
+	case Answer:
+		return g.answer(c, now)
+	case Judge:
+		return g.judge(c, now)
+	case Pass:
+		return g.pass(c, now)
+	case Skip:
+		return g.skip(c, now)
+	}
+
+	return nil, ErrUnexpectedCommand
+}
+
+// Tick applies timeouts expired by now.
+func (g *Game) Tick(now time.Time) []Event {
+	var events []Event
+
+	for !g.paused && !g.deadline.IsZero() && !now.Before(g.deadline) {
+		events = append(events, g.timeout(now)...)
+	}
+
+	return events
+}
+
+func (g *Game) timeout(now time.Time) []Event {
+	if g.buzzer != nil {
+		if p, ok := g.buzzer.Resolve(now); ok {
+			g.answerer = p
+			return append([]Event{PlayerBuzzed{Player: p}}, g.startAnswering(now)...)
+		}
+	}
+
+	switch g.stage {
+	case StageRoundIntro:
+		return g.startChoosing(now)
+	case StageChoosing:
+		// first not played question is selected for the chooser
+		for _, q := range g.round().Questions {
+			if !g.isPlayed(q.ID) {
+				events, _ := g.selectQuestion(SelectQuestion{Player: g.chooser, RoundQuestionID: q.ID}, now)
+				return events
+			}
+		}
+	case StageTransfer, StageSecretCost:
+		return g.transferTimeout(now)
+	case StageBidding:
+		return g.bidTimeout(now)
+	case StageReading:
+		return g.finishReading(now)
+	case StageBuzzing:
+		return g.reveal(now)
+	case StageAnswering:
+		return g.setStage(StageJudging, g.cfg.Host, now.Add(g.cfg.Settings.HostTimeout))
+	case StageJudging:
+		// answer which wasn't judged in time neither rewarded nor penalized
+		g.answered[g.answerer] = struct{}{}
+		return g.afterWrongAnswer(now)
+	case StageReveal:
+		return g.next(now)
+	}
+
+	g.deadline = time.Time{}
+
+	return nil
+}
+
+func (g *Game) pause(c Pause, now time.Time) ([]Event, error) {
+	if !g.isHost(c.Player) {
+		return nil, ErrNotHost
+	}
+
+	if c.Paused == g.paused {
+		if g.paused {
+			return nil, ErrAlreadyPaused
+		}
+
+		return nil, ErrNotPaused
+	}
+
+	g.autoPaused = false
+
+	return g.setPaused(c.Paused, now), nil
+}
+
+func (g *Game) setPaused(paused bool, now time.Time) []Event {
+	g.paused = paused
+
+	if g.paused {
+		if !g.deadline.IsZero() {
+			g.remaining = g.deadline.Sub(now)
+		}
+	} else if !g.deadline.IsZero() {
+		deadline := now.Add(g.remaining)
+
+		if g.auction != nil {
+			g.auction.Delay(deadline.Sub(g.deadline))
+		}
+
+		if g.secret != nil {
+			g.secret.Delay(deadline.Sub(g.deadline))
+		}
+
+		g.deadline = deadline
+		g.remaining = 0
+	}
+
+	events := []Event{GamePaused{Paused: g.paused}}
+
+	if !g.paused {
+		events = append(events, StageChanged{Stage: g.stage, Player: g.actor(), Deadline: g.deadline})
+	}
+
+	return events
+}
+
+func (g *Game) presence(c Presence, now time.Time) []Event {
+	if _, offline := g.offline[c.Player]; offline != c.Online {
+		return nil
+	}
+
+	if c.Online {
+		delete(g.offline, c.Player)
+	} else {
+		g.offline[c.Player] = struct{}{}
+	}
+
+	events := []Event{PresenceChanged{Player: c.Player, Online: c.Online}}
+
+	if !g.isHost(c.Player) {
+		return events
+	}
+
+	switch {
+	case !c.Online && !g.paused:
+		g.autoPaused = true
+		return append(events, g.setPaused(true, now)...)
+	case c.Online && g.autoPaused:
+		g.autoPaused = false
+		return append(events, g.setPaused(false, now)...)
+	}
+
+	return events
+}
+
+func (g *Game) setScore(c SetScore) ([]Event, error) {
+	if !g.isHost(c.Player) {
+		return nil, ErrNotHost
+	}
+
+	if !g.isPlayer(c.Target) {
+		return nil, ErrUnknownPlayer
+	}
+
+	delta := c.Score - g.scores[c.Target]
+	g.scores[c.Target] = c.Score
+
+	return []Event{ScoreChanged{Player: c.Target, Delta: delta, Score: c.Score}}, nil
+}
+
+func (g *Game) skip(c Skip, now time.Time) ([]Event, error) {
+	if !g.isHost(c.Player) {
+		return nil, ErrNotHost
+	}
+
+	if g.question == nil || g.stage == StageReveal {
+		return nil, ErrUnexpectedCommand
+	}
+
+	return append([]Event{QuestionSkipped{}}, g.reveal(now)...), nil
+}
+
+func (g *Game) round() *Round {
+	return &g.pack.Rounds[g.roundIdx]
+}
+
+func (g *Game) isHost(p string) bool {
+	return p == g.cfg.Host
+}
+
+func (g *Game) isPlayer(p string) bool {
+	_, ok := g.scores[p]
+	return ok
+}
+
+func (g *Game) isPlayed(roundQuestionID int32) bool {
+	_, ok := g.played[roundQuestionID]
+	return ok
+}
+
+// actor returns player who must act on current stage.
+func (g *Game) actor() string {
+	switch g.stage {
+	case StageChoosing:
+		return g.chooser
+	case StageTransfer, StageSecretCost:
+		return g.secret.Turn()
+	case StageBidding:
+		return g.auction.Turn()
+	case StageAnswering:
+		return g.answerer
+	case StageJudging:
+		return g.cfg.Host
+	}
+
+	return ""
+}
+
+func (g *Game) setStage(s Stage, player string, deadline time.Time) []Event {
+	g.stage = s
+	g.deadline = deadline
+
+	return []Event{StageChanged{Stage: s, Player: player, Deadline: deadline}}
+}
+
+func (g *Game) startRound(idx int, now time.Time) []Event {
+	g.roundIdx = idx
+	events := []Event{RoundStarted{Index: idx, Name: g.round().Name, Topics: g.grid()}}
+
+	return append(events, g.setStage(StageRoundIntro, "", now.Add(g.cfg.IntroTime))...)
+}
+
+// grid returns topics and questions of current round.
+func (g *Game) grid() []GridTopic {
+	topics := make([]GridTopic, 0)
+
+	for _, q := range g.round().Questions {
+		if len(topics) == 0 || topics[len(topics)-1].ID != q.TopicID {
+			topics = append(topics, GridTopic{ID: q.TopicID, Title: q.Topic})
+		}
+
+		t := &topics[len(topics)-1]
+		t.Questions = append(t.Questions, GridQuestion{
+			RoundQuestionID: q.ID,
+			Cost:            q.Cost,
+			Played:          g.isPlayed(q.ID),
+		})
+	}
+
+	return topics
+}
+
+func (g *Game) startChoosing(now time.Time) []Event {
+	g.question = nil
+	g.opener = ""
+	g.answerer = ""
+	g.answerText = ""
+	g.cost = 0
+	g.answered = nil
+	g.buzzer = nil
+	g.auction = nil
+	g.secret = nil
+
+	return g.setStage(StageChoosing, g.chooser, now.Add(g.cfg.Settings.ChoiceTimeout))
+}
+
+func (g *Game) selectQuestion(c SelectQuestion, now time.Time) ([]Event, error) {
+	if g.stage != StageChoosing {
+		return nil, ErrUnexpectedCommand
+	}
+
+	if c.Player != g.chooser {
+		return nil, ErrNotYourTurn
+	}
+
+	q, ok := g.round().question(c.RoundQuestionID)
+	if !ok {
+		return nil, ErrQuestionNotFound
+	}
+
+	if g.isPlayed(q.ID) {
+		return nil, ErrQuestionPlayed
+	}
+
+	g.played[q.ID] = struct{}{}
+	g.question = q
+	g.opener = c.Player
+	g.answered = make(map[string]struct{})
+	g.cost = q.Cost
+
+	events := []Event{
+		QuestionSelected{
+			RoundQuestionID: q.ID,
+			Type:            q.Type,
+			Topic:           q.Topic,
+			Cost:            q.Cost,
+			Player:          c.Player,
+		},
+		HostAnswer{
+			Host:        g.cfg.Host,
+			Answer:      q.Answer,
+			MediaURL:    q.AnswerMediaURL,
+			MediaClip:   q.AnswerMediaClip,
+			HostComment: q.HostComment,
+		},
+	}
+
+	switch q.Type {
+	case entity.QTypeSecret, entity.QTypeSuperSecret:
+		return append(events, g.startTransfer(now)...), nil
+	case entity.QTypeAuction:
+		return append(events, g.startAuction(now)...), nil
+	case entity.QTypeSafe:
+		g.answerer = g.opener
+	}
+
+	return append(events, g.showQuestion(now)...), nil
+}
+
+func (g *Game) showQuestion(now time.Time) []Event {
+	if g.question.Type == entity.QTypeStandard {
+		g.buzzer = buzzer.New(buzzer.Config{
+			Lockout:   g.cfg.FalseStartLockout,
+			Tolerance: g.cfg.BuzzTolerance,
+			MaxRTT:    g.cfg.MaxRTT,
+		})
+
+		// without false starts players may press the button while question is being read
+		if g.cfg.Settings.FalseStarts {
+			g.buzzer.Arm()
+		} else {
+			g.buzzer.Open(now)
+		}
+	}
+
+	events := []Event{QuestionShown{
+		Text:      g.question.Question,
+		MediaURL:  g.question.QuestionMediaURL,
+		MediaClip: g.question.QuestionMediaClip,
+	}}
+
+	return append(events, g.setStage(StageReading, "", now.Add(readTime(g.question)))...)
+}
+
+// readTime returns time needed to read the question.
+func readTime(q *entity.RoundQuestionDetailed) time.Duration {
+	d := time.Duration(utf8.RuneCountInString(q.Question)) * readTimePerCharacter
+	if d < minReadTime {
+		return minReadTime
+	}
+
+	return d
+}
+
+func (g *Game) finishReading(now time.Time) []Event {
+	if g.answerer != "" {
+		return g.startAnswering(now)
+	}
+
+	return g.openBuzzer(now)
+}
+
+// openBuzzer allows players who haven't answered yet to press the button.
+func (g *Game) openBuzzer(now time.Time) []Event {
+	if len(g.answered) == len(g.cfg.Players) {
+		return g.reveal(now)
+	}
+
+	g.buzzer.Open(now)
+
+	return g.setStage(StageBuzzing, "", now.Add(g.cfg.BuzzTimeout))
+}
+
+func (g *Game) buzz(c Buzz, now time.Time) ([]Event, error) {
+	if !g.isPlayer(c.Player) {
+		return nil, ErrNotYourTurn
+	}
+
+	// only standard question is answered by the player who pressed the button first
+	if g.buzzer == nil || g.answerer != "" {
+		return nil, ErrUnexpectedCommand
+	}
+
+	if _, ok := g.answered[c.Player]; ok {
+		return nil, ErrAlreadyAnswered
+	}
+
+	if err := g.buzzer.Press(c.Player, c.RTT, now); err != nil {
+		switch {
+		case errors.Is(err, buzzer.ErrFalseStart):
+			return nil, ErrBuzzTooEarly
+		case errors.Is(err, buzzer.ErrLockedOut):
+			return nil, ErrBuzzLockedOut
+		case errors.Is(err, buzzer.ErrAlreadyPressed):
+			return nil, ErrAlreadyBuzzed
+		}
+
+		return nil, ErrUnexpectedCommand
+	}
+
+	// winner is picked by Tick when other players had a chance to press the button too
+	g.deadline = g.buzzer.Deadline()
+
+	return nil, nil
+}
+
+func (g *Game) startAnswering(now time.Time) []Event {
+	answerTime := g.question.AnswerTime
+	if answerTime <= 0 {
+		answerTime = defaultAnswerTime
+	}
+
+	return g.setStage(StageAnswering, g.answerer, now.Add(answerTime))
+}
+
+func (g *Game) answer(c Answer, now time.Time) ([]Event, error) {
+	if g.stage != StageAnswering {
+		return nil, ErrUnexpectedCommand
+	}
+
+	if c.Player != g.answerer {
+		return nil, ErrNotYourTurn
+	}
+
+	g.answerText = c.Text
+	events := []Event{AnswerGiven{Player: c.Player, Text: c.Text}}
+
+	return append(events, g.setStage(StageJudging, g.cfg.Host, now.Add(g.cfg.Settings.HostTimeout))...), nil
+}
+
+func (g *Game) judge(c Judge, now time.Time) ([]Event, error) {
+	if !g.isHost(c.Player) {
+		return nil, ErrNotHost
+	}
+
+	// host may judge oral answer without waiting for typed one
+	if g.stage != StageJudging && g.stage != StageAnswering {
+		return nil, ErrUnexpectedCommand
+	}
+
+	delta := g.scoreDelta(c.Correct)
+	g.scores[g.answerer] += delta
+	g.answered[g.answerer] = struct{}{}
+
+	events := []Event{AnswerJudged{
+		Player:  g.answerer,
+		Correct: c.Correct,
+		Delta:   delta,
+		Score:   g.scores[g.answerer],
+	}}
+
+	if c.Correct {
+		g.chooser = g.answerer
+		return append(events, g.reveal(now)...), nil
+	}
+
+	return append(events, g.afterWrongAnswer(now)...), nil
+}
+
+// scoreDelta returns score change of answering player.
+func (g *Game) scoreDelta(correct bool) int32 {
+	if g.question.Type == entity.QTypeSafe {
+		if correct {
+			return 2 * g.cost
+		}
+
+		return 0
+	}
+
+	if correct {
+		return g.cost
+	}
+
+	return -g.cost
+}
+
+func (g *Game) afterWrongAnswer(now time.Time) []Event {
+	// only standard question may be answered by other players
+	if g.question.Type != entity.QTypeStandard {
+		return g.reveal(now)
+	}
+
+	g.answerer = ""
+	g.answerText = ""
+
+	return g.openBuzzer(now)
+}
+
+func (g *Game) pass(c Pass, now time.Time) ([]Event, error) {
+	if g.stage == StageBidding {
+		return g.passBid(c, now)
+	}
+
+	if g.stage != StageBuzzing && g.stage != StageReading {
+		return nil, ErrUnexpectedCommand
+	}
+
+	if g.answerer != "" || !g.isPlayer(c.Player) {
+		return nil, ErrNotYourTurn
+	}
+
+	if _, ok := g.answered[c.Player]; ok {
+		return nil, ErrAlreadyAnswered
+	}
+
+	// player who pressed the button is resolved as answerer, so his press can't be passed
+	if g.buzzer != nil && g.buzzer.Pressed(c.Player) {
+		return nil, ErrAlreadyBuzzed
+	}
+
+	g.answered[c.Player] = struct{}{}
+	events := []Event{PlayerPassed{Player: c.Player}}
+
+	// question is revealed as soon as everyone passed
+	if len(g.answered) == len(g.cfg.Players) {
+		return append(events, g.reveal(now)...), nil
+	}
+
+	return events, nil
+}
+
+func (g *Game) reveal(now time.Time) []Event {
+	// pending presses must not be resolved after question is closed
+	g.buzzer = nil
+
+	events := []Event{AnswerRevealed{
+		Answer:    g.question.Answer,
+		MediaURL:  g.question.AnswerMediaURL,
+		MediaClip: g.question.AnswerMediaClip,
+	}}
+
+	return append(events, g.setStage(StageReveal, "", now.Add(g.cfg.RevealTime))...)
+}
+
+// next starts choosing of next question, next round or finishes the game.
+func (g *Game) next(now time.Time) []Event {
+	for _, q := range g.round().Questions {
+		if !g.isPlayed(q.ID) {
+			return g.startChoosing(now)
+		}
+	}
+
+	if g.roundIdx+1 < len(g.pack.Rounds) {
+		return g.startRound(g.roundIdx+1, now)
+	}
+
+	g.question = nil
+	events := []Event{GameFinished{Scores: g.Scores()}}
+
+	return append(events, g.setStage(StageFinished, "", time.Time{})...)
+}
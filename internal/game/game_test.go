@@ -0,0 +1,1538 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game/secret"
+)
+
+const (
+	host  = "host"
+	alice = "alice"
+	bob   = "bob"
+	carol = "carol"
+)
+
+const (
+	qStandard    int32 = 1
+	qSafe        int32 = 2
+	qSecret      int32 = 3
+	qAuction     int32 = 4
+	qSuperSecret int32 = 5
+)
+
+func newQuestion(id, topicID int32, topic string, t entity.QuestionType, cost int32) entity.RoundQuestionDetailed {
+	return entity.RoundQuestionDetailed{
+		RoundQuestion: entity.RoundQuestion{
+			ID:      id,
+			TopicID: topicID,
+			Type:    t,
+			Cost:    cost,
+		},
+		Topic:    topic,
+		Question: "question",
+		Answer:   "answer",
+	}
+}
+
+// secretCostRange makes player who got secret question choose minimal or maximal cost of the round.
+func secretCostRange(p *Pack) {
+	for i, q := range p.Rounds[0].Questions {
+		if q.ID == qSecret {
+			p.Rounds[0].Questions[i].SecretCost = 0
+		}
+	}
+}
+
+func testPack() *Pack {
+	secret := newQuestion(qSecret, 2, "topic 2", entity.QTypeSecret, 300)
+	secret.SecretTopic = "secret topic"
+	secret.SecretCost = 500
+
+	superSecret := newQuestion(qSuperSecret, 3, "topic 3", entity.QTypeSuperSecret, 100)
+	superSecret.SecretTopic = "super secret topic"
+	superSecret.SecretCost = 1000
+	superSecret.Keepable = true
+
+	return &Pack{
+		ID: 1,
+		Rounds: []Round{
+			{
+				Round: entity.Round{ID: 1, Name: "round 1", Position: 1},
+				Questions: []entity.RoundQuestionDetailed{
+					newQuestion(qStandard, 1, "topic 1", entity.QTypeStandard, 100),
+					newQuestion(qSafe, 1, "topic 1", entity.QTypeSafe, 200),
+					secret,
+					newQuestion(qAuction, 2, "topic 2", entity.QTypeAuction, 200),
+				},
+			},
+			{
+				Round:     entity.Round{ID: 2, Name: "round 2", Position: 2},
+				Questions: []entity.RoundQuestionDetailed{superSecret},
+			},
+		},
+	}
+}
+
+func testConfig() Config {
+	return Config{
+		Host:     host,
+		Players:  []string{alice, bob, carol},
+		Settings: entity.DefaultRoomSettings(),
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pack    func(*Pack)
+		cfg     func(*Config)
+		wantErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:    "no players",
+			cfg:     func(c *Config) { c.Players = nil },
+			wantErr: ErrNoPlayers,
+		},
+		{
+			name:    "no host",
+			cfg:     func(c *Config) { c.Host = "" },
+			wantErr: ErrNoHost,
+		},
+		{
+			name:    "empty pack",
+			pack:    func(p *Pack) { p.Rounds = nil },
+			wantErr: ErrEmptyPack,
+		},
+		{
+			name:    "unknown first chooser",
+			cfg:     func(c *Config) { c.FirstChooser = "dave" },
+			wantErr: ErrUnknownPlayer,
+		},
+		{
+			name:    "zero settings",
+			cfg:     func(c *Config) { c.Settings = entity.RoomSettings{} },
+			wantErr: entity.ErrRoomMaxPlayers,
+		},
+		{
+			name:    "zero choice timeout",
+			cfg:     func(c *Config) { c.Settings.ChoiceTimeout = 0 },
+			wantErr: entity.ErrRoomChoiceTimeout,
+		},
+		{
+			name:    "zero transfer timeout",
+			cfg:     func(c *Config) { c.Settings.TransferTimeout = 0 },
+			wantErr: entity.ErrRoomTransferTimeout,
+		},
+		{
+			name:    "negative host timeout",
+			cfg:     func(c *Config) { c.Settings.HostTimeout = -time.Second },
+			wantErr: entity.ErrRoomHostTimeout,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, cfg := testPack(), testConfig()
+
+			if tt.pack != nil {
+				tt.pack(p)
+			}
+
+			if tt.cfg != nil {
+				tt.cfg(&cfg)
+			}
+
+			g, events, err := New(p, cfg, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr != nil {
+				assert.Nil(t, g)
+				assert.Nil(t, events)
+
+				return
+			}
+
+			assert.Equal(t, StageRoundIntro, g.Stage())
+			assert.False(t, g.Deadline().IsZero())
+		})
+	}
+}
+
+// step is either a command or a tick at current deadline of the game.
+type step struct {
+	cmd     Command
+	tick    bool
+	wantErr error
+}
+
+func tick() step { return step{tick: true} }
+
+func do(cmd Command) step { return step{cmd: cmd} }
+
+func fail(cmd Command, err error) step { return step{cmd: cmd, wantErr: err} }
+
+// skipQuestion selects question and skips it to the next choosing stage.
+func skipQuestion(chooser string, id int32) []step {
+	return []step{
+		do(SelectQuestion{Player: chooser, RoundQuestionID: id}),
+		do(Skip{Player: host}),
+		tick(),
+	}
+}
+
+func steps(ss ...[]step) []step {
+	var res []step
+
+	for _, s := range ss {
+		res = append(res, s...)
+	}
+
+	return res
+}
+
+func TestGame(t *testing.T) {
+	t.Parallel()
+
+	intro := []step{tick()}
+
+	// alice answers safe question and gets 400
+	safeCorrect := []step{
+		do(SelectQuestion{Player: alice, RoundQuestionID: qSafe}),
+		tick(),
+		do(Judge{Player: host, Correct: true}),
+		tick(),
+	}
+	round1 := steps(
+		skipQuestion(alice, qStandard),
+		skipQuestion(alice, qSafe),
+		skipQuestion(alice, qSecret),
+		skipQuestion(alice, qAuction),
+	)
+
+	tests := []struct {
+		name       string
+		cfg        func(*Config)
+		pack       func(*Pack)
+		steps      []step
+		wantStage  Stage
+		wantPaused bool
+		wantScores map[string]int32
+		// wantChooser is checked only if not empty
+		wantChooser string
+		// wantEvents are kinds of events emitted by the last step
+		wantEvents []EventKind
+	}{
+		{
+			name:       "round intro",
+			steps:      nil,
+			wantStage:  StageRoundIntro,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name:        "intro timeout starts choosing",
+			steps:       intro,
+			wantStage:   StageChoosing,
+			wantScores:  map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantChooser: alice,
+			wantEvents:  []EventKind{EventStageChanged},
+		},
+		{
+			name: "select question",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventQuestionSelected, EventHostAnswer, EventQuestionShown, EventStageChanged},
+		},
+		{
+			name: "select question not by chooser",
+			steps: steps(intro, []step{
+				fail(SelectQuestion{Player: bob, RoundQuestionID: qStandard}, ErrNotYourTurn),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "select question not from current round",
+			steps: steps(intro, []step{
+				fail(SelectQuestion{Player: alice, RoundQuestionID: qSuperSecret}, ErrQuestionNotFound),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "select played question",
+			steps: steps(intro, skipQuestion(alice, qStandard), []step{
+				fail(SelectQuestion{Player: alice, RoundQuestionID: qStandard}, ErrQuestionPlayed),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "select question before intro is finished",
+			steps: []step{
+				fail(SelectQuestion{Player: alice, RoundQuestionID: qStandard}, ErrUnexpectedCommand),
+			},
+			wantStage:  StageRoundIntro,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "choice timeout selects first not played question",
+			steps: steps(intro, skipQuestion(alice, qStandard), []step{
+				tick(),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventQuestionSelected, EventHostAnswer, EventQuestionShown, EventStageChanged},
+		},
+		{
+			name:       "command of unknown player",
+			steps:      steps(intro, []step{fail(Buzz{Player: "dave"}, ErrUnknownPlayer)}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "standard question read",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+			}),
+			wantStage:  StageBuzzing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventStageChanged},
+		},
+		{
+			name: "standard question false start",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				fail(Buzz{Player: bob}, ErrBuzzTooEarly),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "standard question buzz while reading without false starts",
+			cfg: func(c *Config) {
+				c.Settings.FalseStarts = false
+			},
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				do(Buzz{Player: bob}),
+				tick(),
+			}),
+			wantStage:  StageAnswering,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPlayerBuzzed, EventStageChanged},
+		},
+		{
+			name: "standard question buzz",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+			}),
+			wantStage:  StageAnswering,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPlayerBuzzed, EventStageChanged},
+		},
+		{
+			name: "standard question buzz is resolved after tolerance",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+			}),
+			wantStage:  StageBuzzing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{},
+		},
+		{
+			name: "standard question buzz latency compensated",
+			cfg: func(c *Config) {
+				c.BuzzTolerance = 2 * time.Second
+				c.MaxRTT = 4 * time.Second
+			},
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				do(Buzz{Player: carol, RTT: 3 * time.Second}),
+				fail(Buzz{Player: carol}, ErrAlreadyBuzzed),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: 0, carol: 100},
+			wantChooser: carol,
+		},
+		{
+			name: "standard question buzz locked out after false start",
+			cfg: func(c *Config) {
+				c.FalseStartLockout = 10 * time.Second
+			},
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				fail(Buzz{Player: bob}, ErrBuzzTooEarly),
+				tick(),
+				fail(Buzz{Player: bob}, ErrBuzzLockedOut),
+				do(Buzz{Player: carol}),
+				tick(),
+			}),
+			wantStage:  StageAnswering,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPlayerBuzzed, EventStageChanged},
+		},
+		{
+			name: "standard question pending buzz discarded on skip",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				do(Skip{Player: host}),
+				tick(),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventStageChanged},
+		},
+		{
+			name: "standard question buzz after another player",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				fail(Buzz{Player: carol}, ErrUnexpectedCommand),
+			}),
+			wantStage:  StageAnswering,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "answer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Answer{Player: bob, Text: "answer"}),
+			}),
+			wantStage:  StageJudging,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventAnswerGiven, EventStageChanged},
+		},
+		{
+			name: "answer not by answering player",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				fail(Answer{Player: carol, Text: "answer"}, ErrNotYourTurn),
+			}),
+			wantStage:  StageAnswering,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "answer judged not by host",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Answer{Player: bob, Text: "answer"}),
+				fail(Judge{Player: bob, Correct: true}, ErrNotHost),
+			}),
+			wantStage:  StageJudging,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "standard question correct answer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Answer{Player: bob, Text: "answer"}),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: 100, carol: 0},
+			wantChooser: bob,
+			wantEvents:  []EventKind{EventAnswerJudged, EventAnswerRevealed, EventStageChanged},
+		},
+		{
+			name: "standard question oral answer judged",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: 100, carol: 0},
+			wantChooser: bob,
+		},
+		{
+			name: "standard question wrong answer opens buzzer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Judge{Player: host, Correct: false}),
+			}),
+			wantStage:   StageBuzzing,
+			wantScores:  map[string]int32{alice: 0, bob: -100, carol: 0},
+			wantChooser: alice,
+			wantEvents:  []EventKind{EventAnswerJudged, EventStageChanged},
+		},
+		{
+			name: "standard question buzz after wrong answer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Judge{Player: host, Correct: false}),
+				fail(Buzz{Player: bob}, ErrAlreadyAnswered),
+				do(Buzz{Player: carol}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: -100, carol: 100},
+			wantChooser: carol,
+		},
+		{
+			name: "standard question everyone answered wrong",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Judge{Player: host, Correct: false}),
+				do(Buzz{Player: carol}),
+				tick(),
+				do(Judge{Player: host, Correct: false}),
+				do(Buzz{Player: alice}),
+				tick(),
+				do(Judge{Player: host, Correct: false}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: -100, bob: -100, carol: -100},
+			wantChooser: alice,
+			wantEvents:  []EventKind{EventAnswerJudged, EventAnswerRevealed, EventStageChanged},
+		},
+		{
+			name: "standard question everyone passed",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Pass{Player: alice}),
+				fail(Pass{Player: alice}, ErrAlreadyAnswered),
+				do(Pass{Player: bob}),
+				do(Pass{Player: carol}),
+			}),
+			wantStage:  StageReveal,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPlayerPassed, EventAnswerRevealed, EventStageChanged},
+		},
+		{
+			name: "standard question pass after buzz",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				fail(Pass{Player: bob}, ErrAlreadyBuzzed),
+				do(Pass{Player: alice}),
+				do(Pass{Player: carol}),
+				tick(),
+			}),
+			wantStage:  StageAnswering,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPlayerBuzzed, EventStageChanged},
+		},
+		{
+			name: "standard question buzz after everyone passed",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Pass{Player: alice}),
+				do(Pass{Player: bob}),
+				do(Pass{Player: carol}),
+				fail(Buzz{Player: bob}, ErrUnexpectedCommand),
+				tick(),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventStageChanged},
+		},
+		{
+			name: "standard question buzz timeout",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				tick(),
+			}),
+			wantStage:  StageReveal,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventAnswerRevealed, EventStageChanged},
+		},
+		{
+			name: "answer timeout",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				tick(),
+			}),
+			wantStage:  StageJudging,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventStageChanged},
+		},
+		{
+			name: "judge timeout",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				tick(),
+				do(Buzz{Player: bob}),
+				tick(),
+				do(Answer{Player: bob, Text: "answer"}),
+				tick(),
+				fail(Buzz{Player: bob}, ErrAlreadyAnswered),
+			}),
+			wantStage:  StageBuzzing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "safe question read",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSafe}),
+				fail(Buzz{Player: bob}, ErrUnexpectedCommand),
+				tick(),
+			}),
+			wantStage:  StageAnswering,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventStageChanged},
+		},
+		{
+			name: "safe question correct answer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSafe}),
+				tick(),
+				do(Answer{Player: alice, Text: "answer"}),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 400, bob: 0, carol: 0},
+			wantChooser: alice,
+		},
+		{
+			name: "safe question wrong answer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSafe}),
+				tick(),
+				do(Answer{Player: alice, Text: "answer"}),
+				do(Judge{Player: host, Correct: false}),
+			}),
+			wantStage:  StageReveal,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventAnswerJudged, EventAnswerRevealed, EventStageChanged},
+		},
+		{
+			name: "secret question selected",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+			}),
+			wantStage:  StageTransfer,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{
+				EventQuestionSelected, EventHostAnswer,
+				EventSecretShown, EventSecretShown, EventSecretShown, EventSecretShown,
+				EventStageChanged,
+			},
+		},
+		{
+			name: "secret question transfer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				fail(Transfer{Player: bob, To: carol}, ErrNotYourTurn),
+				fail(Transfer{Player: alice, To: alice}, ErrInvalidTransfer),
+				fail(Transfer{Player: alice, To: host}, ErrInvalidTransfer),
+				do(Transfer{Player: alice, To: carol}),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{
+				EventQuestionTransferred,
+				EventSecretShown, EventSecretShown, EventSecretShown, EventSecretShown,
+				EventQuestionShown, EventStageChanged,
+			},
+		},
+		{
+			name: "secret question correct answer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				do(Transfer{Player: alice, To: carol}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: 0, carol: 500},
+			wantChooser: carol,
+		},
+		{
+			name: "secret question wrong answer",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				do(Transfer{Player: alice, To: carol}),
+				tick(),
+				do(Judge{Player: host, Correct: false}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: 0, carol: -500},
+			wantChooser: alice,
+		},
+		{
+			name: "secret question transfer timeout",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				tick(),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: 500, carol: 0},
+			wantChooser: bob,
+		},
+		{
+			name: "secret question cost range",
+			pack: secretCostRange,
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				do(Transfer{Player: alice, To: carol}),
+			}),
+			wantStage:  StageSecretCost,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{
+				EventQuestionTransferred,
+				EventSecretShown, EventSecretShown, EventSecretShown, EventSecretShown,
+				EventStageChanged,
+			},
+		},
+		{
+			name: "secret question cost chosen",
+			pack: secretCostRange,
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				fail(ChooseCost{Player: alice, Cost: 100}, ErrUnexpectedCommand),
+				do(Transfer{Player: alice, To: carol}),
+				fail(ChooseCost{Player: alice, Cost: 100}, ErrNotYourTurn),
+				fail(ChooseCost{Player: carol, Cost: 200}, ErrInvalidCost),
+				do(ChooseCost{Player: carol, Cost: 300}),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{
+				EventSecretCostChosen,
+				EventSecretShown, EventSecretShown, EventSecretShown, EventSecretShown,
+				EventQuestionShown, EventStageChanged,
+			},
+		},
+		{
+			name: "secret question chosen cost",
+			pack: secretCostRange,
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				do(Transfer{Player: alice, To: carol}),
+				do(ChooseCost{Player: carol, Cost: 300}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 0, bob: 0, carol: 300},
+			wantChooser: carol,
+		},
+		{
+			name: "secret question cost timeout",
+			pack: secretCostRange,
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				tick(),
+				tick(),
+				tick(),
+				do(Judge{Player: host, Correct: false}),
+			}),
+			wantStage:  StageReveal,
+			wantScores: map[string]int32{alice: 0, bob: -100, carol: 0},
+		},
+		{
+			name: "secret question cost timeout after pause",
+			pack: secretCostRange,
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSecret}),
+				do(Transfer{Player: alice, To: carol}),
+				do(Pause{Player: host, Paused: true}),
+				do(Pause{Player: host, Paused: false}),
+				tick(),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{
+				EventSecretCostChosen,
+				EventSecretShown, EventSecretShown, EventSecretShown, EventSecretShown,
+				EventQuestionShown, EventStageChanged,
+			},
+		},
+		{
+			name: "auction question selected",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+			}),
+			wantStage:  StageBidding,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventQuestionSelected, EventHostAnswer, EventStageChanged},
+		},
+		{
+			name: "auction invalid bids",
+			steps: steps(intro, safeCorrect, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+				fail(Bid{Player: bob, Amount: 200}, ErrNotYourTurn),
+				fail(Bid{Player: alice, Amount: 100}, ErrInvalidBid),
+				fail(Bid{Player: alice, Amount: 500}, ErrBidTooHigh),
+				do(Bid{Player: alice, Amount: 300}),
+				fail(Bid{Player: bob, Amount: 400}, ErrBidTooHigh),
+			}),
+			wantStage:  StageBidding,
+			wantScores: map[string]int32{alice: 400, bob: 0, carol: 0},
+		},
+		{
+			name: "auction won",
+			steps: steps(intro, safeCorrect, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+				do(Bid{Player: alice, Amount: 300}),
+				do(Pass{Player: bob}),
+				do(Pass{Player: carol}),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 400, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventBidPassed, EventAuctionWon, EventQuestionShown, EventStageChanged},
+		},
+		{
+			name: "auction winner answers for the bid",
+			steps: steps(intro, safeCorrect, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+				do(Bid{Player: alice, Amount: 300}),
+				do(Pass{Player: bob}),
+				do(Pass{Player: carol}),
+				tick(),
+				fail(Answer{Player: bob, Text: "answer"}, ErrNotYourTurn),
+				do(Judge{Player: host, Correct: false}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 100, bob: 0, carol: 0},
+			wantChooser: alice,
+		},
+		{
+			name: "auction all-in",
+			steps: steps(intro, safeCorrect, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+				do(Bid{Player: alice, AllIn: true}),
+				do(Pass{Player: bob}),
+				do(Pass{Player: carol}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 800, bob: 0, carol: 0},
+			wantChooser: alice,
+		},
+		{
+			name: "auction everyone passed",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+				do(Pass{Player: alice}),
+				do(Pass{Player: bob}),
+				do(Pass{Player: carol}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 200, bob: 0, carol: 0},
+			wantChooser: alice,
+		},
+		{
+			name: "auction bid timeout",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+				tick(),
+				fail(Pass{Player: alice}, ErrNotYourTurn),
+				do(Pass{Player: bob}),
+			}),
+			wantStage:  StageBidding,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventBidPassed, EventStageChanged},
+		},
+		{
+			name: "auction bid timeout after pause",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qAuction}),
+				do(Pause{Player: host, Paused: true}),
+				do(Pause{Player: host, Paused: false}),
+				tick(),
+			}),
+			wantStage:  StageBidding,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventBidPassed, EventStageChanged},
+		},
+		{
+			name: "skip question",
+			steps: steps(intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+				fail(Skip{Player: alice}, ErrNotHost),
+				do(Skip{Player: host}),
+			}),
+			wantStage:  StageReveal,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventQuestionSkipped, EventAnswerRevealed, EventStageChanged},
+		},
+		{
+			name: "skip without question",
+			steps: steps(intro, []step{
+				fail(Skip{Player: host}, ErrUnexpectedCommand),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "pause",
+			steps: steps(intro, []step{
+				fail(Pause{Player: alice, Paused: true}, ErrNotHost),
+				fail(Pause{Player: host, Paused: false}, ErrNotPaused),
+				do(Pause{Player: host, Paused: true}),
+			}),
+			wantStage:  StageChoosing,
+			wantPaused: true,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventGamePaused},
+		},
+		{
+			name: "commands on pause",
+			steps: steps(intro, []step{
+				do(Pause{Player: host, Paused: true}),
+				fail(Pause{Player: host, Paused: true}, ErrAlreadyPaused),
+				fail(SelectQuestion{Player: alice, RoundQuestionID: qStandard}, ErrGamePaused),
+				tick(),
+			}),
+			wantStage:  StageChoosing,
+			wantPaused: true,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{},
+		},
+		{
+			name: "resume",
+			steps: steps(intro, []step{
+				do(Pause{Player: host, Paused: true}),
+				do(Pause{Player: host, Paused: false}),
+				do(SelectQuestion{Player: alice, RoundQuestionID: qStandard}),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name: "set score",
+			steps: steps(intro, []step{
+				fail(SetScore{Player: alice, Target: alice, Score: 500}, ErrNotHost),
+				fail(SetScore{Player: host, Target: host, Score: 500}, ErrUnknownPlayer),
+				do(SetScore{Player: host, Target: bob, Score: -300}),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: -300, carol: 0},
+			wantEvents: []EventKind{EventScoreChanged},
+		},
+		{
+			name: "set score on pause",
+			steps: steps(intro, []step{
+				do(Pause{Player: host, Paused: true}),
+				do(SetScore{Player: host, Target: carol, Score: 700}),
+			}),
+			wantStage:  StageChoosing,
+			wantPaused: true,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 700},
+			wantEvents: []EventKind{EventScoreChanged},
+		},
+		{
+			name: "player disconnected",
+			steps: steps(intro, []step{
+				do(Presence{Player: bob, Online: false}),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPresenceChanged},
+		},
+		{
+			name: "player disconnected twice",
+			steps: steps(intro, []step{
+				do(Presence{Player: bob, Online: false}),
+				do(Presence{Player: bob, Online: false}),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{},
+		},
+		{
+			name: "host disconnected",
+			steps: steps(intro, []step{
+				do(Presence{Player: host, Online: false}),
+			}),
+			wantStage:  StageChoosing,
+			wantPaused: true,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPresenceChanged, EventGamePaused},
+		},
+		{
+			name: "host reconnected",
+			steps: steps(intro, []step{
+				do(Presence{Player: host, Online: false}),
+				fail(SelectQuestion{Player: alice, RoundQuestionID: qStandard}, ErrGamePaused),
+				do(Presence{Player: host, Online: true}),
+			}),
+			wantStage:  StageChoosing,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPresenceChanged, EventGamePaused, EventStageChanged},
+		},
+		{
+			name: "host reconnected to game paused by host",
+			steps: steps(intro, []step{
+				do(Pause{Player: host, Paused: true}),
+				do(Presence{Player: host, Online: false}),
+				do(Presence{Player: host, Online: true}),
+			}),
+			wantStage:  StageChoosing,
+			wantPaused: true,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPresenceChanged},
+		},
+		{
+			name: "host resumed game paused on disconnect",
+			steps: steps(intro, []step{
+				do(Presence{Player: host, Online: false}),
+				do(Pause{Player: host, Paused: false}),
+				do(Pause{Player: host, Paused: true}),
+				do(Presence{Player: host, Online: true}),
+			}),
+			wantStage:  StageChoosing,
+			wantPaused: true,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantEvents: []EventKind{EventPresenceChanged},
+		},
+		{
+			name: "first chooser",
+			cfg: func(c *Config) {
+				c.FirstChooser = carol
+			},
+			steps: steps(intro, []step{
+				fail(SelectQuestion{Player: alice, RoundQuestionID: qStandard}, ErrNotYourTurn),
+				do(SelectQuestion{Player: carol, RoundQuestionID: qStandard}),
+			}),
+			wantStage:  StageReading,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+		{
+			name:        "next round",
+			steps:       steps(intro, round1),
+			wantStage:   StageRoundIntro,
+			wantScores:  map[string]int32{alice: 0, bob: 0, carol: 0},
+			wantChooser: alice,
+			wantEvents:  []EventKind{EventRoundStarted, EventStageChanged},
+		},
+		{
+			name: "super secret question kept",
+			steps: steps(intro, round1, intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSuperSecret}),
+				do(Transfer{Player: alice, To: alice}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+			}),
+			wantStage:   StageReveal,
+			wantScores:  map[string]int32{alice: 1000, bob: 0, carol: 0},
+			wantChooser: alice,
+		},
+		{
+			name: "game finished",
+			steps: steps(intro, round1, intro, []step{
+				do(SelectQuestion{Player: alice, RoundQuestionID: qSuperSecret}),
+				do(Transfer{Player: alice, To: bob}),
+				tick(),
+				do(Judge{Player: host, Correct: true}),
+				tick(),
+			}),
+			wantStage:  StageFinished,
+			wantScores: map[string]int32{alice: 0, bob: 1000, carol: 0},
+			wantEvents: []EventKind{EventGameFinished, EventStageChanged},
+		},
+		{
+			name: "command after game finished",
+			steps: steps(intro, round1, intro, skipQuestion(alice, qSuperSecret), []step{
+				fail(Pause{Player: host, Paused: true}, ErrGameFinished),
+			}),
+			wantStage:  StageFinished,
+			wantScores: map[string]int32{alice: 0, bob: 0, carol: 0},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := testConfig()
+			if tt.cfg != nil {
+				tt.cfg(&cfg)
+			}
+
+			now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			p := testPack()
+			if tt.pack != nil {
+				tt.pack(p)
+			}
+
+			g, events, err := New(p, cfg, now)
+			require.NoError(t, err)
+
+			for i, s := range tt.steps {
+				if s.tick {
+					if d := g.Deadline(); !d.IsZero() {
+						now = d
+					}
+
+					events = g.Tick(now)
+
+					continue
+				}
+
+				now = now.Add(time.Second)
+
+				events, err = g.Handle(s.cmd, now)
+				require.ErrorIs(t, err, s.wantErr, "step %d: %T", i, s.cmd)
+			}
+
+			assert.Equal(t, tt.wantStage, g.Stage())
+			assert.Equal(t, tt.wantPaused, g.Paused())
+
+			for p, score := range tt.wantScores {
+				assert.Equal(t, score, g.Score(p), p)
+			}
+
+			if tt.wantChooser != "" {
+				assert.Equal(t, tt.wantChooser, g.Chooser())
+			}
+
+			if tt.wantEvents == nil {
+				return
+			}
+
+			kinds := make([]EventKind, len(events))
+			for i, e := range events {
+				kinds[i] = e.Kind()
+			}
+
+			assert.Equal(t, tt.wantEvents, kinds)
+		})
+	}
+}
+
+func TestGame_Pause(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g, _, err := New(testPack(), testConfig(), now)
+	require.NoError(t, err)
+
+	remaining := g.Deadline().Sub(now) - time.Second
+	now = now.Add(time.Second)
+
+	_, err = g.Handle(Pause{Player: host, Paused: true}, now)
+	require.NoError(t, err)
+	assert.True(t, g.Deadline().IsZero())
+
+	// time spent on pause is not counted
+	now = now.Add(time.Hour)
+	assert.Empty(t, g.Tick(now))
+
+	events, err := g.Handle(Pause{Player: host, Paused: false}, now)
+	require.NoError(t, err)
+	assert.Equal(t, []Event{
+		GamePaused{Paused: false},
+		StageChanged{Stage: StageRoundIntro, Deadline: now.Add(remaining)},
+	}, events)
+	assert.Equal(t, now.Add(remaining), g.Deadline())
+}
+
+func TestGame_SecretShown(t *testing.T) {
+	t.Parallel()
+
+	// hidden reports whether secret topic and cost are hidden from each recipient
+	hidden := func(events []Event) map[string]bool {
+		res := make(map[string]bool)
+
+		for _, e := range events {
+			if s, ok := e.(SecretShown); ok {
+				res[s.Player] = s.Hidden
+			}
+		}
+
+		return res
+	}
+
+	tests := []struct {
+		name           string
+		transferType   entity.QuestionTransferType
+		wantOnSelect   map[string]bool
+		wantOnTransfer map[string]bool
+	}{
+		{
+			name:           "before transfer",
+			transferType:   entity.QTransferTypeBefore,
+			wantOnSelect:   map[string]bool{host: false, alice: false, bob: false, carol: false},
+			wantOnTransfer: map[string]bool{host: false, alice: false, bob: false, carol: false},
+		},
+		{
+			name:           "after transfer",
+			transferType:   entity.QTransferTypeAfter,
+			wantOnSelect:   map[string]bool{host: false, alice: true, bob: true, carol: true},
+			wantOnTransfer: map[string]bool{host: false, alice: false, bob: false, carol: false},
+		},
+		{
+			name:           "unspecified is after transfer",
+			transferType:   entity.QTransferTypeUnspecified,
+			wantOnSelect:   map[string]bool{host: false, alice: true, bob: true, carol: true},
+			wantOnTransfer: map[string]bool{host: false, alice: false, bob: false, carol: false},
+		},
+		{
+			name:           "never",
+			transferType:   entity.QTransferTypeNever,
+			wantOnSelect:   map[string]bool{host: false, alice: true, bob: true, carol: true},
+			wantOnTransfer: map[string]bool{host: false, alice: true, bob: true, carol: false},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			p := testPack()
+			p.Rounds[0].Questions[2].TransferType = tt.transferType
+
+			g, _, err := New(p, testConfig(), now)
+			require.NoError(t, err)
+
+			now = g.Deadline()
+			g.Tick(now)
+
+			events, err := g.Handle(SelectQuestion{Player: alice, RoundQuestionID: qSecret}, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOnSelect, hidden(events))
+
+			events, err = g.Handle(Transfer{Player: alice, To: carol}, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOnTransfer, hidden(events))
+
+			for _, e := range events {
+				s, ok := e.(SecretShown)
+				if !ok || s.Hidden {
+					continue
+				}
+
+				assert.Equal(t, "secret topic", s.Topic, s.Player)
+				assert.Equal(t, int32(500), s.Cost, s.Player)
+			}
+		})
+	}
+}
+
+func TestGame_AnswerTimeout(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g, _, err := New(testPack(), testConfig(), now)
+	require.NoError(t, err)
+
+	now = g.Deadline()
+	g.Tick(now)
+
+	_, err = g.Handle(SelectQuestion{Player: alice, RoundQuestionID: qStandard}, now)
+	require.NoError(t, err)
+
+	now = g.Deadline()
+	g.Tick(now)
+
+	_, err = g.Handle(Buzz{Player: bob}, now)
+	require.NoError(t, err)
+
+	now = g.Deadline()
+	g.Tick(now)
+	require.Equal(t, StageAnswering, g.Stage())
+
+	now = g.Deadline()
+	events := g.Tick(now)
+
+	// answer which wasn't given in time is judged by host as typed one
+	assert.Equal(t, []Event{StageChanged{
+		Stage:    StageJudging,
+		Player:   host,
+		Deadline: now.Add(g.cfg.Settings.HostTimeout),
+	}}, events)
+}
+
+func TestGame_Clone(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g, _, err := New(testPack(), testConfig(), now)
+	require.NoError(t, err)
+
+	now = g.Deadline()
+	g.Tick(now)
+
+	_, err = g.Handle(SelectQuestion{Player: alice, RoundQuestionID: qAuction}, now)
+	require.NoError(t, err)
+
+	c := g.Clone()
+
+	_, err = c.Handle(Pass{Player: alice}, now)
+	require.NoError(t, err)
+
+	_, err = c.Handle(SetScore{Player: host, Target: bob, Score: 100}, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(0), g.Score(bob))
+	assert.Equal(t, int32(100), c.Score(bob))
+
+	// auction of the original game is not changed by the copy
+	_, err = g.Handle(Pass{Player: alice}, now)
+	require.NoError(t, err)
+}
+
+func TestGame_SecretCosts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		pack func(*Pack)
+		want secret.Costs
+	}{
+		{
+			name: "fixed",
+			want: secret.Fixed(500),
+		},
+		{
+			name: "costs of the round",
+			pack: secretCostRange,
+			want: secret.Costs{Min: 100, Max: 300},
+		},
+		{
+			name: "range of the question",
+			pack: func(p *Pack) {
+				secretCostRange(p)
+				p.Rounds[0].Questions[2].SecretCostRange = entity.SecretCostRange{Min: 100, Max: 1000, Step: 150}
+			},
+			want: secret.Costs{Min: 100, Max: 1000, Step: 150},
+		},
+		{
+			name: "single cost in the round",
+			pack: func(p *Pack) {
+				secretCostRange(p)
+				p.Rounds[0].Questions = p.Rounds[0].Questions[2:3]
+			},
+			want: secret.Fixed(300),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+			p := testPack()
+			if tt.pack != nil {
+				tt.pack(p)
+			}
+
+			g, _, err := New(p, testConfig(), now)
+			require.NoError(t, err)
+
+			now = g.Deadline()
+			g.Tick(now)
+
+			_, err = g.Handle(SelectQuestion{Player: alice, RoundQuestionID: qSecret}, now)
+			require.NoError(t, err)
+
+			s := g.Snapshot(host).Question.Secret
+			require.NotNil(t, s)
+			require.NotNil(t, s.Costs)
+			assert.Equal(t, tt.want, *s.Costs)
+		})
+	}
+}
+
+func TestGame_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	p := testPack()
+	p.Rounds[0].Questions[2].TransferType = entity.QTransferTypeNever
+
+	g, _, err := New(p, testConfig(), now)
+	require.NoError(t, err)
+
+	now = g.Deadline()
+	g.Tick(now)
+
+	for _, cmd := range []Command{
+		SelectQuestion{Player: alice, RoundQuestionID: qStandard},
+		Skip{Player: host},
+	} {
+		_, err = g.Handle(cmd, now)
+		require.NoError(t, err)
+	}
+
+	now = g.Deadline()
+	g.Tick(now)
+
+	for _, cmd := range []Command{
+		SelectQuestion{Player: alice, RoundQuestionID: qSecret},
+		Transfer{Player: alice, To: carol},
+		Presence{Player: bob, Online: false},
+	} {
+		_, err = g.Handle(cmd, now)
+		require.NoError(t, err)
+	}
+
+	now = g.Deadline()
+	g.Tick(now)
+
+	_, err = g.Handle(Presence{Player: host, Online: false}, now.Add(time.Second))
+	require.NoError(t, err)
+
+	s := g.Snapshot(alice)
+
+	assert.Equal(t, alice, s.Player)
+	assert.Equal(t, host, s.Host)
+	assert.Equal(t, StageAnswering, s.Stage)
+	assert.Equal(t, carol, s.Turn)
+	assert.True(t, s.Paused)
+	assert.True(t, s.Deadline.IsZero())
+	assert.Equal(t, (defaultAnswerTime - time.Second).Milliseconds(), s.Remaining)
+	assert.Equal(t, []string{host, bob}, s.Offline)
+	assert.Equal(t, []Score{{alice, 0}, {bob, 0}, {carol, 0}}, s.Scores)
+
+	assert.Equal(t, "round 1", s.Round.Name)
+	assert.Equal(t, []GridQuestion{
+		{RoundQuestionID: qStandard, Cost: 100, Played: true},
+		{RoundQuestionID: qSafe, Cost: 200},
+	}, s.Round.Topics[0].Questions)
+
+	require.NotNil(t, s.Question)
+	assert.Equal(t, qSecret, s.Question.RoundQuestionID)
+	assert.Equal(t, alice, s.Question.Player)
+	assert.Equal(t, carol, s.Question.Answerer)
+	assert.NotNil(t, s.Question.Shown)
+	assert.Nil(t, s.Question.HostAnswer)
+	assert.Nil(t, s.Question.Revealed)
+
+	// cost of secret question which is never shown is hidden from other players
+	assert.Zero(t, s.Question.Price)
+	assert.Equal(t, int32(500), g.Snapshot(carol).Question.Price)
+
+	hs := g.Snapshot(host)
+	require.NotNil(t, hs.Question.HostAnswer)
+	assert.Equal(t, "answer", hs.Question.HostAnswer.Answer)
+	assert.Equal(t, int32(500), hs.Question.Price)
+}
+
+type roundRepositoryMock struct {
+	rounds []entity.Round
+	err    error
+}
+
+func (m roundRepositoryMock) GetAll(context.Context, int32) ([]entity.Round, error) {
+	return m.rounds, m.err
+}
+
+type roundQuestionRepositoryMock map[int32][]entity.RoundQuestionDetailed
+
+func (m roundQuestionRepositoryMock) GetAll(_ context.Context, roundID int32) ([]entity.RoundQuestionDetailed, error) {
+	return m[roundID], nil
+}
+
+func TestLoadPack(t *testing.T) {
+	t.Parallel()
+
+	errRepo := errors.New("repository error")
+	q := newQuestion(qStandard, 1, "topic 1", entity.QTypeStandard, 100)
+
+	tests := []struct {
+		name    string
+		r       roundRepositoryMock
+		rq      roundQuestionRepositoryMock
+		want    *Pack
+		wantErr error
+	}{
+		{
+			name: "empty rounds are skipped",
+			r: roundRepositoryMock{
+				rounds: []entity.Round{{ID: 1, PackID: 1}, {ID: 2, PackID: 1}},
+			},
+			rq: roundQuestionRepositoryMock{2: {q}},
+			want: &Pack{
+				ID: 1,
+				Rounds: []Round{{
+					Round:     entity.Round{ID: 2, PackID: 1},
+					Questions: []entity.RoundQuestionDetailed{q},
+				}},
+			},
+		},
+		{
+			name:    "repository error",
+			r:       roundRepositoryMock{err: errRepo},
+			wantErr: errRepo,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := LoadPack(context.Background(), tt.r, tt.rq, 1)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package game
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// Pack is a published pack loaded into memory before the game starts.
+type Pack struct {
+	ID     int32
+	Rounds []Round
+}
+
+// Round is a round with its questions ordered by topic and question positions.
+type Round struct {
+	entity.Round
+	Questions []entity.RoundQuestionDetailed
+}
+
+func (r *Round) question(id int32) (*entity.RoundQuestionDetailed, bool) {
+	for i := range r.Questions {
+		if r.Questions[i].ID == id {
+			return &r.Questions[i], true
+		}
+	}
+
+	return nil, false
+}
+
+type roundRepository interface {
+	GetAll(ctx context.Context, packID int32) ([]entity.Round, error)
+}
+
+type roundQuestionRepository interface {
+	GetAll(ctx context.Context, roundID int32) ([]entity.RoundQuestionDetailed, error)
+}
+
+// LoadPack loads rounds and questions of the pack, caller must check that pack is published.
+// Rounds without questions are skipped.
+func LoadPack(ctx context.Context, r roundRepository, rq roundQuestionRepository, packID int32) (*Pack, error) {
+	rounds, err := r.GetAll(ctx, packID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rounds: %w", err)
+	}
+
+	p := &Pack{
+		ID:     packID,
+		Rounds: make([]Round, 0, len(rounds)),
+	}
+
+	for _, round := range rounds {
+		qq, err := rq.GetAll(ctx, round.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting round questions: %w", err)
+		}
+
+		if len(qq) == 0 {
+			continue
+		}
+
+		p.Rounds = append(p.Rounds, Round{
+			Round:     round,
+			Questions: qq,
+		})
+	}
+
+	return p, nil
+}
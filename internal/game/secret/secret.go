@@ -0,0 +1,249 @@
+// Package secret implements transfer of secret and super secret questions.
+//
+// Opener of the question must transfer it to another player, super secret question
+// may be kept by the opener if it's keepable. If cost of the question is given by a range,
+// player who got the question chooses it. Secret topic and cost are shown to players
+// according to transfer type of the question: before the transfer, after the transfer
+// or never, in the last case they are shown only to the player who got the question.
+package secret
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// DefaultTimeout is used if Config.Timeout is not set.
+const DefaultTimeout = 15 * time.Second
+
+var (
+	ErrFinished        = errors.New("transfer is finished")
+	ErrNotYourTurn     = errors.New("it's not your turn")
+	ErrInvalidReceiver = errors.New("question can't be transferred to this player")
+	ErrInvalidCost     = errors.New("cost is out of range")
+)
+
+// Costs is a range of question costs from Min to Max with Step,
+// if Step is 0 only Min or Max can be chosen.
+type Costs struct {
+	Min  int32 `json:"min"`
+	Max  int32 `json:"max"`
+	Step int32 `json:"step,omitempty"`
+}
+
+// Fixed returns range of single cost.
+func Fixed(cost int32) Costs {
+	return Costs{Min: cost, Max: cost}
+}
+
+// Fixed reports whether range has single cost so there is nothing to choose from.
+func (c Costs) Fixed() bool {
+	return c.Min == c.Max
+}
+
+// Contains reports whether cost can be chosen from the range.
+func (c Costs) Contains(cost int32) bool {
+	if cost < c.Min || cost > c.Max {
+		return false
+	}
+
+	if cost == c.Min || cost == c.Max {
+		return true
+	}
+
+	return c.Step > 0 && (cost-c.Min)%c.Step == 0
+}
+
+type Phase int8
+
+const (
+	// PhaseTransfer waits for opener to transfer the question.
+	PhaseTransfer Phase = iota + 1
+
+	// PhaseCost waits for player who got the question to choose its cost.
+	PhaseCost
+
+	// PhaseFinished means question has player and cost.
+	PhaseFinished
+)
+
+type Config struct {
+	// Keepable allows opener to keep the question.
+	Keepable bool
+
+	Costs Costs
+
+	// Visibility defines when secret topic and cost are shown to players,
+	// unspecified is the same as entity.QTransferTypeAfter.
+	Visibility entity.QuestionTransferType
+
+	// Timeout is a time given to transfer the question and to choose its cost.
+	// Question is transferred to next player after the opener and minimal cost is chosen on timeout.
+	Timeout time.Duration
+}
+
+type Transfer struct {
+	cfg    Config
+	opener string
+
+	// players in seating order.
+	players []string
+
+	phase    Phase
+	deadline time.Time
+
+	receiver string
+	cost     int32
+}
+
+// New starts the transfer, players must be in seating order.
+func New(cfg Config, opener string, players []string, now time.Time) *Transfer {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	return &Transfer{
+		cfg:      cfg,
+		opener:   opener,
+		players:  players,
+		phase:    PhaseTransfer,
+		deadline: now.Add(cfg.Timeout),
+	}
+}
+
+// Clone returns copy of the transfer which can be changed independently.
+func (t *Transfer) Clone() *Transfer {
+	c := *t
+	return &c
+}
+
+func (t *Transfer) Phase() Phase { return t.phase }
+func (t *Transfer) Costs() Costs { return t.cfg.Costs }
+
+// Turn returns player who must act, empty if transfer is finished.
+func (t *Transfer) Turn() string {
+	switch t.phase {
+	case PhaseTransfer:
+		return t.opener
+	case PhaseCost:
+		return t.receiver
+	}
+
+	return ""
+}
+
+// Deadline returns time when current phase times out, zero if transfer is finished.
+func (t *Transfer) Deadline() time.Time {
+	if t.phase == PhaseFinished {
+		return time.Time{}
+	}
+
+	return t.deadline
+}
+
+// Delay moves deadline of current phase, it's used when game is paused.
+func (t *Transfer) Delay(d time.Duration) {
+	t.deadline = t.deadline.Add(d)
+}
+
+// Result returns player who got the question and its cost, cost is 0 until it's chosen.
+func (t *Transfer) Result() (receiver string, cost int32, finished bool) {
+	return t.receiver, t.cost, t.phase == PhaseFinished
+}
+
+// Visible reports whether secret topic and cost are shown to the player.
+func (t *Transfer) Visible(player string) bool {
+	switch t.cfg.Visibility {
+	case entity.QTransferTypeBefore:
+		return true
+	case entity.QTransferTypeNever:
+		return t.phase != PhaseTransfer && player == t.receiver
+	}
+
+	return t.phase != PhaseTransfer
+}
+
+// Give transfers the question from the opener to another player.
+func (t *Transfer) Give(player, to string, now time.Time) error {
+	if t.phase == PhaseFinished {
+		return ErrFinished
+	}
+
+	if t.phase != PhaseTransfer || player != t.opener {
+		return ErrNotYourTurn
+	}
+
+	if !slices.Contains(t.players, to) || (to == t.opener && !t.cfg.Keepable) {
+		return ErrInvalidReceiver
+	}
+
+	t.give(to, now)
+
+	return nil
+}
+
+// ChooseCost sets cost of the question chosen by player who got it.
+func (t *Transfer) ChooseCost(player string, cost int32) error {
+	if t.phase == PhaseFinished {
+		return ErrFinished
+	}
+
+	if t.phase != PhaseCost || player != t.receiver {
+		return ErrNotYourTurn
+	}
+
+	if !t.cfg.Costs.Contains(cost) {
+		return ErrInvalidCost
+	}
+
+	t.finish(cost)
+
+	return nil
+}
+
+// Tick applies timeout of current phase, returns false if deadline isn't reached.
+func (t *Transfer) Tick(now time.Time) bool {
+	if t.phase == PhaseFinished || now.Before(t.deadline) {
+		return false
+	}
+
+	switch t.phase {
+	case PhaseTransfer:
+		t.give(t.nextPlayer(), now)
+	case PhaseCost:
+		t.finish(t.cfg.Costs.Min)
+	}
+
+	return true
+}
+
+func (t *Transfer) give(to string, now time.Time) {
+	t.receiver = to
+
+	if t.cfg.Costs.Fixed() {
+		t.finish(t.cfg.Costs.Min)
+		return
+	}
+
+	t.phase = PhaseCost
+	t.deadline = now.Add(t.cfg.Timeout)
+}
+
+func (t *Transfer) finish(cost int32) {
+	t.cost = cost
+	t.phase = PhaseFinished
+}
+
+// nextPlayer returns player after the opener in seating order,
+// opener keeps the question if there is no one else.
+func (t *Transfer) nextPlayer() string {
+	for i, p := range t.players {
+		if p == t.opener {
+			return t.players[(i+1)%len(t.players)]
+		}
+	}
+
+	return t.opener
+}
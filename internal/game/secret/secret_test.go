@@ -0,0 +1,309 @@
+package secret
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+var start = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const timeout = 10 * time.Second
+
+var players = []string{"alice", "bob", "carol"}
+
+type op int8
+
+const (
+	opGive op = iota + 1
+	opChooseCost
+	opTick
+)
+
+type step struct {
+	op      op
+	player  string
+	to      string
+	cost    int32
+	wantErr error
+}
+
+func give(p, to string) step               { return step{op: opGive, player: p, to: to} }
+func chooseCost(p string, cost int32) step { return step{op: opChooseCost, player: p, cost: cost} }
+func tick() step                           { return step{op: opTick} }
+
+func fail(s step, err error) step {
+	s.wantErr = err
+	return s
+}
+
+func TestCosts_Contains(t *testing.T) {
+	tests := []struct {
+		name  string
+		costs Costs
+		cost  int32
+		want  bool
+	}{
+		{name: "fixed", costs: Fixed(500), cost: 500, want: true},
+		{name: "fixed other", costs: Fixed(500), cost: 400, want: false},
+		{name: "min", costs: Costs{Min: 100, Max: 500}, cost: 100, want: true},
+		{name: "max", costs: Costs{Min: 100, Max: 500}, cost: 500, want: true},
+		{name: "between without step", costs: Costs{Min: 100, Max: 500}, cost: 300, want: false},
+		{name: "below min", costs: Costs{Min: 100, Max: 500, Step: 100}, cost: 0, want: false},
+		{name: "above max", costs: Costs{Min: 100, Max: 500, Step: 100}, cost: 600, want: false},
+		{name: "on step", costs: Costs{Min: 100, Max: 500, Step: 100}, cost: 300, want: true},
+		{name: "off step", costs: Costs{Min: 100, Max: 500, Step: 100}, cost: 250, want: false},
+		{name: "max off step", costs: Costs{Min: 100, Max: 450, Step: 100}, cost: 450, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.costs.Contains(tt.cost))
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          Config
+		opener       string
+		players      []string
+		steps        []step
+		wantPhase    Phase
+		wantTurn     string
+		wantReceiver string
+		wantCost     int32
+	}{
+		{
+			name:      "started",
+			cfg:       Config{Costs: Fixed(500)},
+			opener:    "alice",
+			wantPhase: PhaseTransfer,
+			wantTurn:  "alice",
+		},
+		{
+			name:   "fixed cost",
+			cfg:    Config{Costs: Fixed(500)},
+			opener: "alice",
+			steps: []step{
+				fail(give("bob", "carol"), ErrNotYourTurn),
+				fail(give("alice", "dave"), ErrInvalidReceiver),
+				fail(give("alice", "alice"), ErrInvalidReceiver),
+				give("alice", "carol"),
+				fail(give("alice", "bob"), ErrFinished),
+				fail(chooseCost("carol", 500), ErrFinished),
+			},
+			wantPhase:    PhaseFinished,
+			wantReceiver: "carol",
+			wantCost:     500,
+		},
+		{
+			name:   "kept",
+			cfg:    Config{Costs: Fixed(500), Keepable: true},
+			opener: "alice",
+			steps: []step{
+				give("alice", "alice"),
+			},
+			wantPhase:    PhaseFinished,
+			wantReceiver: "alice",
+			wantCost:     500,
+		},
+		{
+			name:   "cost from min or max",
+			cfg:    Config{Costs: Costs{Min: 100, Max: 500}},
+			opener: "alice",
+			steps: []step{
+				fail(chooseCost("alice", 100), ErrNotYourTurn),
+				give("alice", "bob"),
+				fail(give("alice", "carol"), ErrNotYourTurn),
+				fail(chooseCost("alice", 100), ErrNotYourTurn),
+				fail(chooseCost("bob", 300), ErrInvalidCost),
+				chooseCost("bob", 500),
+			},
+			wantPhase:    PhaseFinished,
+			wantReceiver: "bob",
+			wantCost:     500,
+		},
+		{
+			name:   "cost from range with step",
+			cfg:    Config{Costs: Costs{Min: 100, Max: 500, Step: 200}},
+			opener: "alice",
+			steps: []step{
+				give("alice", "bob"),
+				fail(chooseCost("bob", 200), ErrInvalidCost),
+				chooseCost("bob", 300),
+			},
+			wantPhase:    PhaseFinished,
+			wantReceiver: "bob",
+			wantCost:     300,
+		},
+		{
+			name:   "waiting for cost",
+			cfg:    Config{Costs: Costs{Min: 100, Max: 500}},
+			opener: "alice",
+			steps: []step{
+				give("alice", "carol"),
+			},
+			wantPhase:    PhaseCost,
+			wantTurn:     "carol",
+			wantReceiver: "carol",
+		},
+		{
+			name:   "transfer timeout gives question to next player",
+			cfg:    Config{Costs: Fixed(500)},
+			opener: "carol",
+			steps: []step{
+				tick(),
+			},
+			wantPhase:    PhaseFinished,
+			wantReceiver: "alice",
+			wantCost:     500,
+		},
+		{
+			name:    "transfer timeout single player keeps question",
+			cfg:     Config{Costs: Fixed(500)},
+			opener:  "alice",
+			players: []string{"alice"},
+			steps: []step{
+				tick(),
+			},
+			wantPhase:    PhaseFinished,
+			wantReceiver: "alice",
+			wantCost:     500,
+		},
+		{
+			name:   "cost timeout chooses min",
+			cfg:    Config{Costs: Costs{Min: 100, Max: 500}},
+			opener: "alice",
+			steps: []step{
+				tick(),
+				tick(),
+			},
+			wantPhase:    PhaseFinished,
+			wantReceiver: "bob",
+			wantCost:     100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pp := tt.players
+			if pp == nil {
+				pp = players
+			}
+
+			tt.cfg.Timeout = timeout
+			tr := New(tt.cfg, tt.opener, pp, start)
+			now := start
+
+			for i, s := range tt.steps {
+				var err error
+
+				switch s.op {
+				case opGive:
+					now = now.Add(time.Second)
+					err = tr.Give(s.player, s.to, now)
+				case opChooseCost:
+					now = now.Add(time.Second)
+					err = tr.ChooseCost(s.player, s.cost)
+				case opTick:
+					now = tr.Deadline()
+					assert.True(t, tr.Tick(now), "step %d", i)
+				}
+
+				assert.ErrorIs(t, err, s.wantErr, "step %d", i)
+			}
+
+			receiver, cost, finished := tr.Result()
+
+			assert.Equal(t, tt.wantPhase, tr.Phase())
+			assert.Equal(t, tt.wantTurn, tr.Turn())
+			assert.Equal(t, tt.wantReceiver, receiver)
+			assert.Equal(t, tt.wantCost, cost)
+			assert.Equal(t, tt.wantPhase == PhaseFinished, finished)
+		})
+	}
+}
+
+func TestTransfer_Tick(t *testing.T) {
+	tr := New(Config{Costs: Costs{Min: 100, Max: 500}, Timeout: timeout}, "alice", players, start)
+
+	assert.False(t, tr.Tick(start.Add(timeout-time.Nanosecond)))
+	assert.Equal(t, PhaseTransfer, tr.Phase())
+
+	tr.Delay(time.Second)
+	assert.False(t, tr.Tick(start.Add(timeout)))
+	assert.Equal(t, start.Add(timeout+time.Second), tr.Deadline())
+
+	// receiver gets full timeout to choose cost
+	now := start.Add(timeout + time.Second)
+	assert.True(t, tr.Tick(now))
+	assert.Equal(t, PhaseCost, tr.Phase())
+	assert.Equal(t, now.Add(timeout), tr.Deadline())
+
+	assert.True(t, tr.Tick(now.Add(timeout)))
+	assert.True(t, tr.Deadline().IsZero())
+	assert.False(t, tr.Tick(now.Add(2*timeout)))
+}
+
+func TestNew_DefaultTimeout(t *testing.T) {
+	tr := New(Config{Costs: Fixed(500)}, "alice", players, start)
+	assert.Equal(t, start.Add(DefaultTimeout), tr.Deadline())
+}
+
+func TestTransfer_Visible(t *testing.T) {
+	type visibility map[string]bool
+
+	tests := []struct {
+		name       string
+		visibility entity.QuestionTransferType
+		wantBefore visibility
+		wantAfter  visibility
+	}{
+		{
+			name:       "before",
+			visibility: entity.QTransferTypeBefore,
+			wantBefore: visibility{"alice": true, "bob": true, "carol": true},
+			wantAfter:  visibility{"alice": true, "bob": true, "carol": true},
+		},
+		{
+			name:       "after",
+			visibility: entity.QTransferTypeAfter,
+			wantBefore: visibility{"alice": false, "bob": false, "carol": false},
+			wantAfter:  visibility{"alice": true, "bob": true, "carol": true},
+		},
+		{
+			name:       "unspecified",
+			visibility: entity.QTransferTypeUnspecified,
+			wantBefore: visibility{"alice": false, "bob": false, "carol": false},
+			wantAfter:  visibility{"alice": true, "bob": true, "carol": true},
+		},
+		{
+			name:       "never",
+			visibility: entity.QTransferTypeNever,
+			wantBefore: visibility{"alice": false, "bob": false, "carol": false},
+			wantAfter:  visibility{"alice": false, "bob": true, "carol": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := New(Config{
+				Costs:      Costs{Min: 100, Max: 500},
+				Visibility: tt.visibility,
+				Timeout:    timeout,
+			}, "alice", players, start)
+
+			for p, want := range tt.wantBefore {
+				assert.Equal(t, want, tr.Visible(p), p)
+			}
+
+			assert.NoError(t, tr.Give("alice", "bob", start))
+
+			for p, want := range tt.wantAfter {
+				assert.Equal(t, want, tr.Visible(p), p)
+			}
+		})
+	}
+}
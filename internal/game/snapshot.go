@@ -0,0 +1,163 @@
+package game
+
+import (
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// Snapshot is a full state of the game as it's seen by the player, it's sent to
+// reconnecting client so it can rebuild its state without replaying missed events.
+type Snapshot struct {
+	Player string `json:"-"`
+	Host   string `json:"host"`
+
+	Round RoundStarted `json:"round"`
+	Stage Stage        `json:"stage"`
+
+	// Turn is a player who must act on the stage, empty if anyone can act.
+	Turn string `json:"turn,omitempty"`
+
+	// Deadline of the stage, zero if the stage has no time limit or game is paused.
+	Deadline time.Time `json:"deadline"`
+	Paused   bool      `json:"paused"`
+
+	// Remaining is a time left until deadline when game was paused, in milliseconds.
+	Remaining int64 `json:"remaining,omitempty"`
+
+	Chooser string  `json:"chooser"`
+	Scores  []Score `json:"scores"`
+
+	// Offline players and host in seating order, host goes first.
+	Offline []string `json:"offline,omitempty"`
+
+	Question *QuestionState `json:"question,omitempty"`
+}
+
+// QuestionState is a state of the question which is currently played,
+// parts of it which are not shown to the player yet are nil.
+type QuestionState struct {
+	QuestionSelected
+
+	// Price is a cost the question is answered for, it differs from the cost
+	// of auction and secret questions. Zero if it's not known to the player yet.
+	Price int32 `json:"price,omitempty"`
+
+	HostAnswer *HostAnswer     `json:"host_answer,omitempty"`
+	Secret     *SecretShown    `json:"secret,omitempty"`
+	Bid        *BidPlaced      `json:"bid,omitempty"`
+	Shown      *QuestionShown  `json:"shown,omitempty"`
+	Answerer   string          `json:"answerer,omitempty"`
+	Answer     *AnswerGiven    `json:"answer,omitempty"`
+	Revealed   *AnswerRevealed `json:"revealed,omitempty"`
+
+	// Answered contains players who answered or passed the question in seating order.
+	Answered []string `json:"answered,omitempty"`
+}
+
+// Snapshot returns current state of the game as it's seen by the player,
+// host sees answer of the question and hidden parts of secret questions.
+func (g *Game) Snapshot(player string) Snapshot {
+	s := Snapshot{
+		Player:   player,
+		Host:     g.cfg.Host,
+		Round:    RoundStarted{Index: g.roundIdx, Name: g.round().Name, Topics: g.grid()},
+		Stage:    g.stage,
+		Turn:     g.actor(),
+		Deadline: g.Deadline(),
+		Paused:   g.paused,
+		Chooser:  g.chooser,
+		Scores:   g.Scores(),
+	}
+
+	if g.paused {
+		s.Remaining = g.remaining.Milliseconds()
+	}
+
+	for _, p := range append([]string{g.cfg.Host}, g.cfg.Players...) {
+		if _, ok := g.offline[p]; ok {
+			s.Offline = append(s.Offline, p)
+		}
+	}
+
+	if g.question != nil {
+		s.Question = g.questionState(player)
+	}
+
+	return s
+}
+
+func (g *Game) questionState(player string) *QuestionState {
+	q := g.question
+	qs := &QuestionState{
+		QuestionSelected: QuestionSelected{
+			RoundQuestionID: q.ID,
+			Type:            q.Type,
+			Topic:           q.Topic,
+			Cost:            q.Cost,
+			Player:          g.opener,
+		},
+		Answerer: g.answerer,
+	}
+
+	if g.isHost(player) {
+		qs.HostAnswer = &HostAnswer{
+			Host:        g.cfg.Host,
+			Answer:      q.Answer,
+			MediaURL:    q.AnswerMediaURL,
+			MediaClip:   q.AnswerMediaClip,
+			HostComment: q.HostComment,
+		}
+	}
+
+	switch g.stage {
+	case StageTransfer, StageSecretCost:
+		secret := g.secretShownTo(player)
+		qs.Secret = &secret
+	case StageBidding:
+		if p, amount, allIn := g.auction.Leader(); p != "" {
+			qs.Bid = &BidPlaced{Player: p, Amount: amount, AllIn: allIn}
+		}
+	case StageReading, StageBuzzing, StageAnswering, StageJudging, StageReveal:
+		qs.Shown = &QuestionShown{
+			Text:      q.Question,
+			MediaURL:  q.QuestionMediaURL,
+			MediaClip: q.QuestionMediaClip,
+		}
+
+		if g.priceVisible(player) {
+			qs.Price = g.cost
+		}
+	}
+
+	if g.stage == StageJudging && g.answerText != "" {
+		qs.Answer = &AnswerGiven{Player: g.answerer, Text: g.answerText}
+	}
+
+	if g.stage == StageReveal {
+		qs.Revealed = &AnswerRevealed{
+			Answer:    q.Answer,
+			MediaURL:  q.AnswerMediaURL,
+			MediaClip: q.AnswerMediaClip,
+		}
+	}
+
+	for _, p := range g.cfg.Players {
+		if _, ok := g.answered[p]; ok {
+			qs.Answered = append(qs.Answered, p)
+		}
+	}
+
+	return qs
+}
+
+// priceVisible reports whether player may see the cost question is answered for,
+// cost of secret question which is never shown is known only to host and player who got it.
+func (g *Game) priceVisible(player string) bool {
+	secret := g.question.Type == entity.QTypeSecret || g.question.Type == entity.QTypeSuperSecret
+	if !secret || g.question.TransferType != entity.QTransferTypeNever {
+		return true
+	}
+
+	return g.isHost(player) || player == g.answerer
+}
@@ -0,0 +1,64 @@
+package game
+
+type Stage int8
+
+const (
+	// StageRoundIntro shows name and topics of the round.
+	StageRoundIntro Stage = iota + 1
+
+	// StageChoosing waits for chooser to select question from the grid.
+	StageChoosing
+
+	// StageTransfer waits for opener of secret or super secret question to transfer it.
+	StageTransfer
+
+	// StageSecretCost waits for player who got secret question to choose its cost.
+	StageSecretCost
+
+	// StageBidding waits for bids of auction question.
+	StageBidding
+
+	// StageReading shows question to everyone while it is being read.
+	StageReading
+
+	// StageBuzzing waits for players to press the button.
+	StageBuzzing
+
+	// StageAnswering waits for answer of the player.
+	StageAnswering
+
+	// StageJudging waits for host to judge the answer.
+	StageJudging
+
+	// StageReveal reveals answer to everyone.
+	StageReveal
+
+	// StageFinished means all rounds are played.
+	StageFinished
+)
+
+var stageNames = map[Stage]string{
+	StageRoundIntro: "round_intro",
+	StageChoosing:   "choosing",
+	StageTransfer:   "transfer",
+	StageSecretCost: "secret_cost",
+	StageBidding:    "bidding",
+	StageReading:    "reading",
+	StageBuzzing:    "buzzing",
+	StageAnswering:  "answering",
+	StageJudging:    "judging",
+	StageReveal:     "reveal",
+	StageFinished:   "finished",
+}
+
+func (s Stage) String() string {
+	if name, ok := stageNames[s]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+func (s Stage) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
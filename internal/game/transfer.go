@@ -0,0 +1,149 @@
+package game
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game/secret"
+)
+
+func (g *Game) startTransfer(now time.Time) []Event {
+	g.secret = secret.New(secret.Config{
+		Keepable:   g.question.Type == entity.QTypeSuperSecret && g.question.Keepable,
+		Costs:      g.secretCosts(),
+		Visibility: g.question.TransferType,
+		Timeout:    g.cfg.Settings.TransferTimeout,
+	}, g.opener, g.cfg.Players, now)
+
+	events := g.secretShown()
+
+	return append(events, g.setStage(StageTransfer, g.opener, g.secret.Deadline())...)
+}
+
+// secretCosts returns costs of secret question, if neither secret cost nor its range is set
+// player who got the question chooses minimal or maximal cost of the round.
+func (g *Game) secretCosts() secret.Costs {
+	if g.question.SecretCost != 0 {
+		return secret.Fixed(g.question.SecretCost)
+	}
+
+	if r := g.question.SecretCostRange; !r.Empty() {
+		return secret.Costs{Min: r.Min, Max: r.Max, Step: r.Step}
+	}
+
+	costs := secret.Costs{Min: g.question.Cost, Max: g.question.Cost}
+
+	for _, q := range g.round().Questions {
+		costs.Min = min(costs.Min, q.Cost)
+		costs.Max = max(costs.Max, q.Cost)
+	}
+
+	return costs
+}
+
+// secretShown reveals secret topic and cost of the question to players who are allowed
+// to see them and hides from others, host sees them always.
+func (g *Game) secretShown() []Event {
+	events := make([]Event, 0, len(g.cfg.Players)+1)
+
+	for _, p := range append([]string{g.cfg.Host}, g.cfg.Players...) {
+		events = append(events, g.secretShownTo(p))
+	}
+
+	return events
+}
+
+func (g *Game) secretShownTo(player string) SecretShown {
+	if !g.isHost(player) && !g.secret.Visible(player) {
+		return SecretShown{Player: player, Hidden: true}
+	}
+
+	topic := g.question.SecretTopic
+	if topic == "" {
+		topic = g.question.Topic
+	}
+
+	_, cost, _ := g.secret.Result()
+	costs := g.secret.Costs()
+
+	return SecretShown{
+		Player: player,
+		Topic:  topic,
+		Cost:   cost,
+		Costs:  &costs,
+	}
+}
+
+func (g *Game) transfer(c Transfer, now time.Time) ([]Event, error) {
+	if g.stage != StageTransfer {
+		return nil, ErrUnexpectedCommand
+	}
+
+	if err := g.secret.Give(c.Player, c.To, now); err != nil {
+		return nil, transferError(err)
+	}
+
+	return g.afterTransfer(now), nil
+}
+
+func (g *Game) chooseCost(c ChooseCost, now time.Time) ([]Event, error) {
+	if g.stage != StageSecretCost {
+		return nil, ErrUnexpectedCommand
+	}
+
+	if err := g.secret.ChooseCost(c.Player, c.Cost); err != nil {
+		return nil, transferError(err)
+	}
+
+	return g.afterTransfer(now), nil
+}
+
+func transferError(err error) error {
+	switch {
+	case errors.Is(err, secret.ErrNotYourTurn):
+		return ErrNotYourTurn
+	case errors.Is(err, secret.ErrInvalidReceiver):
+		return ErrInvalidTransfer
+	case errors.Is(err, secret.ErrInvalidCost):
+		return ErrInvalidCost
+	}
+
+	return ErrUnexpectedCommand
+}
+
+func (g *Game) transferTimeout(now time.Time) []Event {
+	if !g.secret.Tick(now) {
+		g.deadline = g.secret.Deadline()
+		return nil
+	}
+
+	return g.afterTransfer(now)
+}
+
+// afterTransfer emits events of finished phase of the transfer and starts the next one,
+// question is shown when it has player and cost.
+func (g *Game) afterTransfer(now time.Time) []Event {
+	receiver, cost, finished := g.secret.Result()
+
+	var events []Event
+
+	switch {
+	case g.stage == StageTransfer:
+		events = append(events, QuestionTransferred{From: g.opener, To: receiver})
+	case finished:
+		events = append(events, SecretCostChosen{Player: receiver})
+	}
+
+	events = append(events, g.secretShown()...)
+
+	if !finished {
+		return append(events, g.setStage(StageSecretCost, receiver, g.secret.Deadline())...)
+	}
+
+	g.answerer = receiver
+	g.cost = cost
+	g.secret = nil
+
+	return append(events, g.showQuestion(now)...)
+}
@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+)
+
+type roomRepository interface {
+	GetOne(ctx context.Context, roomID string) (*entity.Room, error)
+}
+
+// authorize returns nickname of the player if he is a member of the room,
+// otherwise writes error to w and returns false.
+func authorize(w http.ResponseWriter, r *http.Request, sm *session.Manager, rooms roomRepository, roomID string) (string, bool) {
+	ctx := r.Context()
+
+	sid, ok := appctx.GetSessionID(ctx)
+	if !ok {
+		writeError(w, twirp.Unauthenticated, apperr.MsgUnauthorized)
+		return "", false
+	}
+
+	sess, err := sm.Get(ctx, sid)
+	if err != nil {
+		slog.Info("error getting session", slog.String("error", err.Error()))
+		writeError(w, twirp.Unauthenticated, apperr.MsgUnauthorized)
+
+		return "", false
+	}
+
+	if roomID == "" {
+		writeError(w, twirp.InvalidArgument, "room id is required")
+		return "", false
+	}
+
+	room, err := rooms.GetOne(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, apperr.RoomNotFound) {
+			writeError(w, twirp.NotFound, apperr.MsgRoomNotFound)
+			return "", false
+		}
+
+		writeError(w, twirp.Internal, err.Error())
+
+		return "", false
+	}
+
+	if _, ok := room.Member(sess.User.ID); !ok {
+		writeError(w, twirp.PermissionDenied, apperr.MsgRoomNotMember)
+		return "", false
+	}
+
+	return sess.User.ID, true
+}
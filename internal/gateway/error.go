@@ -0,0 +1,27 @@
+package gateway
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+)
+
+type httpError struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// writeError writes error in the same format as twirp does.
+func writeError(w http.ResponseWriter, code twirp.ErrorCode, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(twirp.ServerHTTPStatusFromErrorCode(code))
+
+	if err := json.NewEncoder(w).Encode(httpError{
+		Code: string(code),
+		Msg:  msg,
+	}); err != nil {
+		slog.Error("error encoding http error", slog.String("error", err.Error()))
+	}
+}
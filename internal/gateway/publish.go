@@ -0,0 +1,31 @@
+// Package gateway delivers game events to room members over long-lived connections.
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/hub"
+)
+
+// Publish publishes game events to the room, private events are delivered only to their recipients.
+func Publish(h *hub.Hub, roomID string, events []game.Event) error {
+	for _, e := range events {
+		var recipients []string
+
+		if pe, ok := e.(game.PrivateEvent); ok {
+			recipients = pe.Recipients()
+
+			// empty recipients means everyone for the hub
+			if len(recipients) == 0 {
+				continue
+			}
+		}
+
+		if err := h.Publish(roomID, string(e.Kind()), e, recipients...); err != nil {
+			return fmt.Errorf("error publishing %s event: %w", e.Kind(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+type gameRepository interface {
+	Handle(ctx context.Context, roomID string, cmd game.Command) ([]game.Event, error)
+	Snapshot(ctx context.Context, roomID, player string) error
+}
+
+// Seats keeps seats of room members while they're connected. Seat is bound to the player
+// of the session, so it can be taken back from any session of the same player.
+// Seat is released only after grace period since its last connection is closed,
+// reconnects within grace period are not noticed by other members. Host has its own
+// shorter grace period since the game is paused only after host seat is released.
+type Seats struct {
+	mu        sync.Mutex
+	game      gameRepository
+	room      roomRepository
+	grace     time.Duration
+	hostGrace time.Duration
+	rooms     map[string]*roomSeats
+}
+
+// roomSeats are seats of the room, they're locked separately from other rooms
+// so slow game of one room doesn't block connections to others.
+type roomSeats struct {
+	mu    sync.Mutex
+	seats map[string]*seat
+
+	// refs is a number of callers which hold or wait for mu, guarded by Seats.mu.
+	// Room is removed when it has no seats and refs.
+	refs int
+}
+
+type seat struct {
+	conns int
+
+	// release is running while seat has no connections.
+	release *time.Timer
+}
+
+func NewSeats(g gameRepository, r roomRepository, grace, hostGrace time.Duration) *Seats {
+	return &Seats{
+		game:      g,
+		room:      r,
+		grace:     grace,
+		hostGrace: hostGrace,
+		rooms:     make(map[string]*roomSeats),
+	}
+}
+
+// Take takes seat of the player in the room and publishes snapshot of the game to him.
+// Must be called after client is subscribed to the room so it doesn't miss events
+// published after the snapshot.
+func (s *Seats) Take(ctx context.Context, roomID, nickname string) {
+	rs := s.lock(roomID)
+
+	st, ok := rs.seats[nickname]
+	if !ok {
+		st = &seat{}
+		rs.seats[nickname] = st
+
+		s.presence(ctx, roomID, nickname, true)
+	}
+
+	st.conns++
+
+	if st.release != nil {
+		st.release.Stop()
+		st.release = nil
+	}
+
+	s.unlock(roomID, rs)
+
+	if err := s.game.Snapshot(ctx, roomID, nickname); err != nil && !errors.Is(err, apperr.RoomGameNotStarted) {
+		slog.Error("error publishing game snapshot",
+			slog.String("room_id", roomID),
+			slog.String("nickname", nickname),
+			slog.String("error", err.Error()))
+	}
+}
+
+// Leave closes connection of the player, the seat is released if player doesn't
+// come back during grace period.
+func (s *Seats) Leave(roomID, nickname string) {
+	grace := s.gracePeriod(roomID, nickname)
+
+	rs := s.lock(roomID)
+	defer s.unlock(roomID, rs)
+
+	st, ok := rs.seats[nickname]
+	if !ok {
+		return
+	}
+
+	st.conns--
+	if st.conns > 0 {
+		return
+	}
+
+	st.release = time.AfterFunc(grace, func() {
+		rs := s.lock(roomID)
+		defer s.unlock(roomID, rs)
+
+		// player came back while release was waiting for the lock
+		if rs.seats[nickname] != st || st.conns > 0 {
+			return
+		}
+
+		delete(rs.seats, nickname)
+		s.presence(context.Background(), roomID, nickname, false)
+	})
+}
+
+// gracePeriod returns time the seat of the player is kept after disconnect.
+func (s *Seats) gracePeriod(roomID, nickname string) time.Duration {
+	room, err := s.room.GetOne(context.Background(), roomID)
+	if err != nil || room.Host() != nickname {
+		return s.grace
+	}
+
+	return s.hostGrace
+}
+
+// lock locks seats of the room, the room is created if it has no seats.
+func (s *Seats) lock(roomID string) *roomSeats {
+	s.mu.Lock()
+
+	rs, ok := s.rooms[roomID]
+	if !ok {
+		rs = &roomSeats{seats: make(map[string]*seat)}
+		s.rooms[roomID] = rs
+	}
+
+	rs.refs++
+
+	s.mu.Unlock()
+
+	rs.mu.Lock()
+
+	return rs
+}
+
+// unlock unlocks seats of the room and removes the room if it has no seats left.
+func (s *Seats) unlock(roomID string, rs *roomSeats) {
+	rs.mu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs.refs--
+
+	// nobody else holds rs.mu if there are no refs, so seats can be read
+	if rs.refs == 0 && len(rs.seats) == 0 {
+		delete(s.rooms, roomID)
+	}
+}
+
+// presence notifies the game that player connected or disconnected, seats of the room must be
+// locked so notifications of the same player are applied in order.
+func (s *Seats) presence(ctx context.Context, roomID, nickname string, online bool) {
+	_, err := s.game.Handle(ctx, roomID, game.Presence{Player: nickname, Online: online})
+
+	switch {
+	case err == nil,
+		// game is not started yet or member is a spectator
+		errors.Is(err, apperr.RoomGameNotStarted),
+		errors.Is(err, game.ErrUnknownPlayer),
+		errors.Is(err, game.ErrGameFinished):
+		return
+	}
+
+	slog.Error("error changing presence of the player",
+		slog.String("room_id", roomID),
+		slog.String("nickname", nickname),
+		slog.Bool("online", online),
+		slog.String("error", err.Error()))
+}
@@ -0,0 +1,171 @@
+package gateway
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"github.com/ysomad/answersuck/internal/pkg/hub"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+)
+
+var _ apptwirp.Handler = &SSEHandler{}
+
+const (
+	// ssePath is followed by room id, e.g. /sse/v1/rooms/{room_id}.
+	ssePath = "/sse/v1/rooms/"
+
+	sseWriteTimeout = 10 * time.Second
+
+	// sseKeepAlive is an interval of comments sent to keep proxies from closing idle stream.
+	sseKeepAlive = 15 * time.Second
+
+	// lastEventIDParam is used by clients which can't set Last-Event-ID header on first connect.
+	lastEventIDParam = "last_event_id"
+)
+
+// sseReset is sent instead of missed events if they're not in the room history anymore,
+// client must reload state of the room.
+const sseReset = "event: reset\ndata: {}\n\n"
+
+// SSEHandler streams events of the room to its members as server-sent events,
+// it's a fallback for clients which can't use websocket.
+type SSEHandler struct {
+	hub     *hub.Hub
+	room    roomRepository
+	seats   *Seats
+	session *session.Manager
+}
+
+func NewSSEHandler(h *hub.Hub, r roomRepository, s *Seats, sm *session.Manager) *SSEHandler {
+	return &SSEHandler{
+		hub:     h,
+		room:    r,
+		seats:   s,
+		session: sm,
+	}
+}
+
+func (h *SSEHandler) Handle(m *http.ServeMux) {
+	// stream is open until client disconnects, write deadline is set before every write instead
+	m.Handle(ssePath, middleware.WithSessionID(
+		middleware.WithTimeouts(0, 0)(http.HandlerFunc(h.serveHTTP))))
+}
+
+func (h *SSEHandler) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, twirp.BadRoute, "method not allowed")
+		return
+	}
+
+	lastID, err := lastEventID(r)
+	if err != nil {
+		writeError(w, twirp.InvalidArgument, "invalid last event id")
+		return
+	}
+
+	roomID := strings.TrimPrefix(r.URL.Path, ssePath)
+
+	nickname, ok := authorize(w, r, h.session, h.room, roomID)
+	if !ok {
+		return
+	}
+
+	c, complete := h.hub.Subscribe(roomID, nickname, lastID)
+	defer h.hub.Unsubscribe(roomID, c)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	s := &sseStream{w: w, rc: http.NewResponseController(w)}
+
+	if !complete {
+		if err := s.write(sseReset); err != nil {
+			return
+		}
+	} else if err := s.flush(); err != nil {
+		return
+	}
+
+	h.seats.Take(r.Context(), roomID, nickname)
+	defer h.seats.Leave(roomID, nickname)
+
+	ticker := time.NewTicker(sseKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case m := <-c.Messages():
+			err = s.write(fmt.Sprintf("id: %d\ndata: %s\n\n", m.ID, m.Data))
+		case <-ticker.C:
+			err = s.write(": ping\n\n")
+		case <-c.Evicted():
+			slog.Info("sse client evicted",
+				slog.String("room_id", roomID),
+				slog.String("nickname", nickname))
+
+			// messages published before the room is closed are still delivered, e.g. end of the game
+			for len(c.Messages()) > 0 && err == nil {
+				m := <-c.Messages()
+				err = s.write(fmt.Sprintf("id: %d\ndata: %s\n\n", m.ID, m.Data))
+			}
+
+			return
+		case <-r.Context().Done():
+			return
+		}
+
+		if err != nil {
+			slog.Info("error writing to sse stream",
+				slog.String("room_id", roomID),
+				slog.String("nickname", nickname),
+				slog.String("error", err.Error()))
+
+			return
+		}
+	}
+}
+
+// lastEventID returns id of the last event received by reconnecting client or 0.
+func lastEventID(r *http.Request) (uint64, error) {
+	id := r.Header.Get("Last-Event-ID")
+	if id == "" {
+		id = r.URL.Query().Get(lastEventIDParam)
+	}
+
+	if id == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(id, 10, 64)
+}
+
+type sseStream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (s *sseStream) write(data string) error {
+	if err := s.rc.SetWriteDeadline(time.Now().Add(sseWriteTimeout)); err != nil {
+		return err
+	}
+
+	if _, err := s.w.Write([]byte(data)); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+func (s *sseStream) flush() error {
+	return s.rc.Flush()
+}
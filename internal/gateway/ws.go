@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/ysomad/answersuck/internal/pkg/hub"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+)
+
+var _ apptwirp.Handler = &WebSocketHandler{}
+
+const (
+	// wsPath is followed by room id, e.g. /ws/v1/rooms/{room_id}.
+	wsPath = "/ws/v1/rooms/"
+
+	wsWriteTimeout = 10 * time.Second
+
+	// clients are not expected to send anything except close frames,
+	// player commands are sent via room.v1.PlayerService.
+	wsMaxPayloadBytes = 512
+)
+
+var errCrossOrigin = errors.New("cross origin websocket requests are not allowed")
+
+// WebSocketHandler streams events of the room to its members.
+type WebSocketHandler struct {
+	hub     *hub.Hub
+	room    roomRepository
+	seats   *Seats
+	session *session.Manager
+}
+
+func NewWebSocketHandler(h *hub.Hub, r roomRepository, s *Seats, sm *session.Manager) *WebSocketHandler {
+	return &WebSocketHandler{
+		hub:     h,
+		room:    r,
+		seats:   s,
+		session: sm,
+	}
+}
+
+func (h *WebSocketHandler) Handle(m *http.ServeMux) {
+	m.Handle(wsPath, middleware.WithSessionID(http.HandlerFunc(h.serveHTTP)))
+}
+
+func (h *WebSocketHandler) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	roomID := strings.TrimPrefix(r.URL.Path, wsPath)
+
+	nickname, ok := authorize(w, r, h.session, h.room, roomID)
+	if !ok {
+		return
+	}
+
+	s := websocket.Server{
+		Handshake: checkOrigin,
+		Handler: func(conn *websocket.Conn) {
+			h.serve(conn, roomID, nickname)
+		},
+	}
+
+	s.ServeHTTP(w, r)
+}
+
+// checkOrigin protects session cookie from being used by other sites,
+// requests without origin are sent by non-browser clients and allowed.
+func checkOrigin(conf *websocket.Config, r *http.Request) error {
+	origin, err := websocket.Origin(conf, r)
+	if err != nil {
+		return err
+	}
+
+	if origin != nil && origin.Host != r.Host {
+		return errCrossOrigin
+	}
+
+	return nil
+}
+
+func (h *WebSocketHandler) serve(conn *websocket.Conn, roomID, nickname string) {
+	defer conn.Close()
+
+	// http server deadlines are still set on hijacked connection
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		slog.Error("error resetting websocket deadline", slog.String("error", err.Error()))
+		return
+	}
+
+	conn.MaxPayloadBytes = wsMaxPayloadBytes
+
+	c, _ := h.hub.Subscribe(roomID, nickname, 0)
+	defer h.hub.Unsubscribe(roomID, c)
+
+	h.seats.Take(conn.Request().Context(), roomID, nickname)
+	defer h.seats.Leave(roomID, nickname)
+
+	closed := make(chan struct{})
+
+	go func() {
+		defer close(closed)
+
+		var msg []byte
+
+		for {
+			if err := websocket.Message.Receive(conn, &msg); err != nil {
+				return
+			}
+		}
+	}()
+
+	send := func(m hub.Message) error {
+		if err := conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+			return err
+		}
+
+		if err := websocket.Message.Send(conn, string(m.Data)); err != nil {
+			slog.Info("error writing to websocket",
+				slog.String("room_id", roomID),
+				slog.String("nickname", nickname),
+				slog.String("error", err.Error()))
+
+			return err
+		}
+
+		return nil
+	}
+
+	for {
+		select {
+		case m := <-c.Messages():
+			if err := send(m); err != nil {
+				return
+			}
+		case <-c.Evicted():
+			slog.Info("websocket client evicted",
+				slog.String("room_id", roomID),
+				slog.String("nickname", nickname))
+
+			// messages published before the room is closed are still delivered, e.g. end of the game
+			for len(c.Messages()) > 0 {
+				if err := send(<-c.Messages()); err != nil {
+					return
+				}
+			}
+
+			return
+		case <-closed:
+			return
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.23.4
+// source: editor/v1/language.proto
+
+package editorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Language of pack, topic or question content, russian is used if not specified.
+type Language int32
+
+const (
+	Language_LANGUAGE_UNSPECIFIED Language = 0
+	Language_RUSSIAN              Language = 1
+	Language_ENGLISH              Language = 2
+)
+
+// Enum value maps for Language.
+var (
+	Language_name = map[int32]string{
+		0: "LANGUAGE_UNSPECIFIED",
+		1: "RUSSIAN",
+		2: "ENGLISH",
+	}
+	Language_value = map[string]int32{
+		"LANGUAGE_UNSPECIFIED": 0,
+		"RUSSIAN":              1,
+		"ENGLISH":              2,
+	}
+)
+
+func (x Language) Enum() *Language {
+	p := new(Language)
+	*p = x
+	return p
+}
+
+func (x Language) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Language) Descriptor() protoreflect.EnumDescriptor {
+	return file_editor_v1_language_proto_enumTypes[0].Descriptor()
+}
+
+func (Language) Type() protoreflect.EnumType {
+	return &file_editor_v1_language_proto_enumTypes[0]
+}
+
+func (x Language) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Language.Descriptor instead.
+func (Language) EnumDescriptor() ([]byte, []int) {
+	return file_editor_v1_language_proto_rawDescGZIP(), []int{0}
+}
+
+var File_editor_v1_language_proto protoreflect.FileDescriptor
+
+var file_editor_v1_language_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65, 0x64, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2a, 0x3e, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x55, 0x53, 0x53, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x47, 0x4c,
+	0x49, 0x53, 0x48, 0x10, 0x02, 0x42, 0x14, 0x5a, 0x12, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f,
+	0x76, 0x31, 0x3b, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_editor_v1_language_proto_rawDescOnce sync.Once
+	file_editor_v1_language_proto_rawDescData = file_editor_v1_language_proto_rawDesc
+)
+
+func file_editor_v1_language_proto_rawDescGZIP() []byte {
+	file_editor_v1_language_proto_rawDescOnce.Do(func() {
+		file_editor_v1_language_proto_rawDescData = protoimpl.X.CompressGZIP(file_editor_v1_language_proto_rawDescData)
+	})
+	return file_editor_v1_language_proto_rawDescData
+}
+
+var file_editor_v1_language_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_editor_v1_language_proto_goTypes = []interface{}{
+	(Language)(0), // 0: editor.v1.Language
+}
+var file_editor_v1_language_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_editor_v1_language_proto_init() }
+func file_editor_v1_language_proto_init() {
+	if File_editor_v1_language_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_editor_v1_language_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_editor_v1_language_proto_goTypes,
+		DependencyIndexes: file_editor_v1_language_proto_depIdxs,
+		EnumInfos:         file_editor_v1_language_proto_enumTypes,
+	}.Build()
+	File_editor_v1_language_proto = out.File
+	file_editor_v1_language_proto_rawDesc = nil
+	file_editor_v1_language_proto_goTypes = nil
+	file_editor_v1_language_proto_depIdxs = nil
+}
@@ -62,6 +62,35 @@ func (m *Media) validate(all bool) error {
 
 	// no validation rules for Author
 
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MediaValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MediaValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MediaValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MediaMultiError(errors)
 	}
@@ -139,6 +168,165 @@ var _ interface {
 	ErrorName() string
 } = MediaValidationError{}
 
+// Validate checks the field values on MediaClip with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaClip) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaClip with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaClipMultiError, or nil
+// if none found.
+func (m *MediaClip) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaClip) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetStart(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = MediaClipValidationError{
+				field:  "Start",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := MediaClipValidationError{
+					field:  "Start",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetEnd(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = MediaClipValidationError{
+				field:  "End",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := MediaClipValidationError{
+					field:  "End",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return MediaClipMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaClipMultiError is an error wrapping multiple validation errors returned
+// by MediaClip.ValidateAll() if the designated constraints aren't met.
+type MediaClipMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaClipMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaClipMultiError) AllErrors() []error { return m }
+
+// MediaClipValidationError is the validation error returned by
+// MediaClip.Validate if the designated constraints aren't met.
+type MediaClipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaClipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaClipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaClipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaClipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaClipValidationError) ErrorName() string { return "MediaClipValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaClipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaClip.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaClipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaClipValidationError{}
+
 // Validate checks the field values on UploadMediaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -182,6 +370,36 @@ func (m *UploadMediaRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UploadMediaRequestValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := UploadMediaRequestValidationError{
+					field:  "Duration",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return UploadMediaRequestMultiError(errors)
 	}
@@ -1087,25 +1087,31 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x4d, 0xc9, 0x2c,
-	0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0xd4, 0xcf, 0x4d, 0x4d, 0xc9, 0x4c, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x84, 0x08, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0x97, 0x25, 0xe6, 0x64, 0xa6, 0x24,
-	0x96, 0xa4, 0xea, 0xc3, 0x18, 0x10, 0x35, 0x4a, 0xd1, 0x5c, 0xac, 0xbe, 0x20, 0x2d, 0x42, 0x02,
-	0x5c, 0xcc, 0xa5, 0x45, 0x39, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20, 0xa6, 0x90, 0x06,
-	0x17, 0x4b, 0x49, 0x65, 0x41, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x9f, 0x91, 0x88, 0x1e, 0xdc,
-	0x34, 0x3d, 0xb0, 0x8e, 0x90, 0xca, 0x82, 0xd4, 0x20, 0xb0, 0x0a, 0x21, 0x31, 0x2e, 0xb6, 0xc4,
-	0xd2, 0x92, 0x8c, 0xfc, 0x22, 0x09, 0x66, 0xb0, 0x76, 0x28, 0x4f, 0xc9, 0x80, 0x4b, 0x28, 0xb4,
-	0x20, 0x27, 0x3f, 0x31, 0x05, 0xac, 0x21, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x48, 0x0a,
-	0xc9, 0x26, 0x27, 0x8e, 0x5f, 0x4e, 0xac, 0x45, 0xcc, 0x1d, 0x8c, 0x8c, 0x60, 0x3b, 0x95, 0x6c,
-	0xb9, 0x84, 0x51, 0x74, 0x14, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0xa9, 0x71, 0xb1, 0x82, 0x3d,
-	0x06, 0xd6, 0xc4, 0x6d, 0x24, 0x80, 0xee, 0x96, 0x20, 0x88, 0xb4, 0x96, 0x07, 0x17, 0x27, 0xdc,
-	0x6d, 0x42, 0x52, 0x5c, 0x62, 0xbe, 0xae, 0x2e, 0x9e, 0x8e, 0xf1, 0x21, 0x91, 0x01, 0xae, 0xf1,
-	0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0x9c, 0x5c,
-	0xac, 0x9e, 0xbe, 0x8e, 0xee, 0xae, 0x02, 0x8c, 0x20, 0xa6, 0x63, 0xa8, 0x8b, 0xa7, 0xbf, 0x00,
-	0x13, 0x88, 0x19, 0xe6, 0xe9, 0xe2, 0xea, 0x2f, 0xc0, 0x6c, 0x14, 0xc3, 0xc5, 0x03, 0x36, 0x29,
-	0x38, 0xb5, 0xa8, 0x2c, 0x33, 0x39, 0x55, 0xc8, 0x87, 0x8b, 0x1b, 0xc9, 0x61, 0x42, 0xb2, 0x48,
-	0x2e, 0xc0, 0xf4, 0xa2, 0x94, 0x1c, 0x2e, 0x69, 0x88, 0x7f, 0x9c, 0x44, 0xa2, 0x84, 0xe0, 0x51,
-	0x66, 0x0d, 0x61, 0x95, 0x19, 0x26, 0xb1, 0x81, 0xa3, 0xc4, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0xb0, 0x46, 0x21, 0x17, 0xcf, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xde, 0x49, 0x36, 0xcb, 0xe6, 0xad, 0x48, 0x18, 0xd7, 0x35, 0x06, 0x2c, 0xa5, 0x07, 0x09,
+	0x1e, 0x12, 0x1a, 0xe9, 0x41, 0x44, 0xa4, 0x6d, 0xa2, 0x06, 0xac, 0x2d, 0x69, 0x2b, 0x28, 0x42,
+	0x49, 0xcd, 0x58, 0x03, 0xb1, 0x93, 0x4e, 0x26, 0x81, 0x1e, 0xbc, 0x7b, 0xf6, 0x67, 0xf8, 0x13,
+	0x3d, 0x49, 0x66, 0xda, 0x18, 0x15, 0xd1, 0xbd, 0x7d, 0x93, 0xf7, 0x7d, 0xef, 0xfb, 0xde, 0x7b,
+	0x81, 0xdb, 0x24, 0x49, 0x39, 0x65, 0x6e, 0xd5, 0x77, 0x3f, 0x91, 0x24, 0x8d, 0x9d, 0x9c, 0x51,
+	0x4e, 0xb1, 0x2e, 0x3f, 0x3b, 0x55, 0xdf, 0xba, 0x53, 0xc5, 0x59, 0x9a, 0xc4, 0x9c, 0xb8, 0x47,
+	0x20, 0x39, 0x56, 0x67, 0x43, 0xe9, 0x26, 0x23, 0xae, 0x78, 0xad, 0xcb, 0x0f, 0x6e, 0x52, 0xb2,
+	0x98, 0xa7, 0x74, 0x2b, 0xeb, 0xbd, 0xaf, 0x08, 0xb4, 0x49, 0xdd, 0x13, 0x1b, 0xa0, 0x96, 0x2c,
+	0x33, 0x51, 0x17, 0xd9, 0x7a, 0x54, 0x43, 0x6c, 0xc3, 0x29, 0xdf, 0xe7, 0xc4, 0x54, 0xba, 0xc8,
+	0xbe, 0xe9, 0x5d, 0x3a, 0x8d, 0x9d, 0x23, 0x14, 0x8b, 0x7d, 0x4e, 0x22, 0xc1, 0xc0, 0x57, 0x70,
+	0x16, 0x97, 0xfc, 0x23, 0x65, 0xa6, 0x2a, 0xe4, 0x87, 0x17, 0x1e, 0xc0, 0xf9, 0xd1, 0xcf, 0x3c,
+	0xed, 0x22, 0xfb, 0xc2, 0xbb, 0xeb, 0xc8, 0x40, 0xce, 0x31, 0x90, 0xe3, 0x1f, 0x08, 0x51, 0x43,
+	0xed, 0x7d, 0x06, 0x5d, 0x38, 0x8c, 0xb3, 0x34, 0xc7, 0x8f, 0x40, 0x2b, 0x78, 0xcc, 0xb8, 0x89,
+	0xfe, 0xd1, 0x60, 0x74, 0xfe, 0x7d, 0xa4, 0x7d, 0x43, 0x8a, 0x77, 0x12, 0x49, 0x05, 0x1e, 0x80,
+	0x4a, 0xb6, 0x89, 0xa9, 0xfc, 0xbf, 0xb0, 0xe6, 0xf7, 0x76, 0x80, 0x97, 0x79, 0x46, 0xe3, 0x44,
+	0x84, 0x88, 0xc8, 0xae, 0x24, 0x05, 0xc7, 0x56, 0x6b, 0x3f, 0x42, 0xc1, 0xd4, 0x2f, 0x08, 0xc9,
+	0x4d, 0x3d, 0x6d, 0xcd, 0x79, 0x0d, 0xb7, 0x9f, 0x13, 0x3f, 0x81, 0x5b, 0xbf, 0x58, 0x16, 0x39,
+	0xdd, 0x16, 0x04, 0xdf, 0x07, 0x4d, 0x1c, 0xfc, 0x30, 0xbb, 0xf1, 0xfb, 0x09, 0x22, 0x59, 0x7e,
+	0xf0, 0x02, 0xf4, 0xe6, 0x24, 0xd8, 0x82, 0xab, 0x49, 0xe0, 0x87, 0xc3, 0xd5, 0xe2, 0xcd, 0x2c,
+	0x58, 0x2d, 0x5f, 0xcd, 0x67, 0xc1, 0x38, 0x7c, 0x16, 0x06, 0xbe, 0x71, 0x82, 0x75, 0xd0, 0xc2,
+	0xc9, 0xf0, 0x79, 0x60, 0xa0, 0x1a, 0x0e, 0x97, 0x7e, 0x38, 0x35, 0x94, 0x1a, 0xbe, 0x0e, 0xfd,
+	0x60, 0x6a, 0xa8, 0xde, 0x3b, 0xb8, 0x21, 0x3a, 0xcd, 0x09, 0xab, 0xd2, 0xf7, 0x04, 0xbf, 0x84,
+	0x8b, 0x56, 0x30, 0x7c, 0xaf, 0x95, 0xe0, 0xcf, 0x1d, 0x59, 0x9d, 0xbf, 0x95, 0xe5, 0x3c, 0xa3,
+	0xcb, 0xb7, 0xb8, 0xf9, 0x95, 0x1f, 0x4b, 0x54, 0xf5, 0xd7, 0x67, 0x62, 0x47, 0x0f, 0x7f, 0x0c,
+	0x00, 0x0f, 0xf9, 0x1c, 0xfa, 0xe7, 0x02, 0x00, 0x00,
 }
@@ -66,6 +66,12 @@ func (m *Pack) validate(all bool) error {
 
 	// no validation rules for CoverUrl
 
+	// no validation rules for Language
+
+	// no validation rules for Visibility
+
+	// no validation rules for IsHidden
+
 	if all {
 		switch v := interface{}(m.GetCreateTime()).(type) {
 		case interface{ ValidateAll() error }:
@@ -465,6 +471,8 @@ func (m *GetPackRequest) validate(all bool) error {
 
 	// no validation rules for PackId
 
+	// no validation rules for ShareToken
+
 	if len(errors) > 0 {
 		return GetPackRequestMultiError(errors)
 	}
@@ -762,6 +770,28 @@ func (m *CreatePackRequest) validate(all bool) error {
 		// no validation rules for Tags[idx]
 	}
 
+	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
+		err := CreatePackRequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PackVisibility_name[int32(m.GetVisibility())]; !ok {
+		err := CreatePackRequestValidationError{
+			field:  "Visibility",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreatePackRequestMultiError(errors)
 	}
@@ -1180,3 +1210,1223 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PublishPackResponseValidationError{}
+
+// Validate checks the field values on ListPacksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPacksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPacksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPacksRequestMultiError, or nil if none found.
+func (m *ListPacksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPacksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQuery()) > 50 {
+		err := ListPacksRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
+		err := ListPacksRequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val <= 0 || val >= 500 {
+		err := ListPacksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range (0, 500)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListPacksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPacksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPacksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPacksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPacksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPacksRequestMultiError) AllErrors() []error { return m }
+
+// ListPacksRequestValidationError is the validation error returned by
+// ListPacksRequest.Validate if the designated constraints aren't met.
+type ListPacksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPacksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPacksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPacksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPacksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPacksRequestValidationError) ErrorName() string { return "ListPacksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPacksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPacksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPacksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPacksRequestValidationError{}
+
+// Validate checks the field values on ListPacksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPacksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPacksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPacksResponseMultiError, or nil if none found.
+func (m *ListPacksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPacksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPacks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPacksResponseValidationError{
+						field:  fmt.Sprintf("Packs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPacksResponseValidationError{
+						field:  fmt.Sprintf("Packs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPacksResponseValidationError{
+					field:  fmt.Sprintf("Packs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPacksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPacksResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPacksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPacksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPacksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPacksResponseMultiError) AllErrors() []error { return m }
+
+// ListPacksResponseValidationError is the validation error returned by
+// ListPacksResponse.Validate if the designated constraints aren't met.
+type ListPacksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPacksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPacksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPacksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPacksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPacksResponseValidationError) ErrorName() string {
+	return "ListPacksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPacksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPacksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPacksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPacksResponseValidationError{}
+
+// Validate checks the field values on SetPackVisibilityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPackVisibilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPackVisibilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPackVisibilityRequestMultiError, or nil if none found.
+func (m *SetPackVisibilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPackVisibilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	if _, ok := _SetPackVisibilityRequest_Visibility_InLookup[m.GetVisibility()]; !ok {
+		err := SetPackVisibilityRequestValidationError{
+			field:  "Visibility",
+			reason: "value must be in list [PUBLIC UNLISTED PRIVATE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetPackVisibilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPackVisibilityRequestMultiError is an error wrapping multiple validation
+// errors returned by SetPackVisibilityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetPackVisibilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPackVisibilityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPackVisibilityRequestMultiError) AllErrors() []error { return m }
+
+// SetPackVisibilityRequestValidationError is the validation error returned by
+// SetPackVisibilityRequest.Validate if the designated constraints aren't met.
+type SetPackVisibilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPackVisibilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPackVisibilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPackVisibilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPackVisibilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPackVisibilityRequestValidationError) ErrorName() string {
+	return "SetPackVisibilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPackVisibilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPackVisibilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPackVisibilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPackVisibilityRequestValidationError{}
+
+var _SetPackVisibilityRequest_Visibility_InLookup = map[PackVisibility]struct{}{
+	1: {},
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on ShareToken with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShareToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShareTokenMultiError, or
+// nil if none found.
+func (m *ShareToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for PackId
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareTokenValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareTokenValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareTokenValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShareTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareTokenMultiError is an error wrapping multiple validation errors
+// returned by ShareToken.ValidateAll() if the designated constraints aren't met.
+type ShareTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareTokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareTokenMultiError) AllErrors() []error { return m }
+
+// ShareTokenValidationError is the validation error returned by
+// ShareToken.Validate if the designated constraints aren't met.
+type ShareTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareTokenValidationError) ErrorName() string { return "ShareTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShareTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareTokenValidationError{}
+
+// Validate checks the field values on CreateShareTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShareTokenRequestMultiError, or nil if none found.
+func (m *CreateShareTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	if len(errors) > 0 {
+		return CreateShareTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShareTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateShareTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShareTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShareTokenRequestMultiError) AllErrors() []error { return m }
+
+// CreateShareTokenRequestValidationError is the validation error returned by
+// CreateShareTokenRequest.Validate if the designated constraints aren't met.
+type CreateShareTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShareTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShareTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShareTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShareTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShareTokenRequestValidationError) ErrorName() string {
+	return "CreateShareTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShareTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShareTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShareTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShareTokenRequestValidationError{}
+
+// Validate checks the field values on CreateShareTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShareTokenResponseMultiError, or nil if none found.
+func (m *CreateShareTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShareToken()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShareTokenResponseValidationError{
+					field:  "ShareToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShareTokenResponseValidationError{
+					field:  "ShareToken",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShareToken()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShareTokenResponseValidationError{
+				field:  "ShareToken",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateShareTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShareTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateShareTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShareTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShareTokenResponseMultiError) AllErrors() []error { return m }
+
+// CreateShareTokenResponseValidationError is the validation error returned by
+// CreateShareTokenResponse.Validate if the designated constraints aren't met.
+type CreateShareTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShareTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShareTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShareTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShareTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShareTokenResponseValidationError) ErrorName() string {
+	return "CreateShareTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShareTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShareTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShareTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShareTokenResponseValidationError{}
+
+// Validate checks the field values on ListShareTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListShareTokensRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShareTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListShareTokensRequestMultiError, or nil if none found.
+func (m *ListShareTokensRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShareTokensRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	if len(errors) > 0 {
+		return ListShareTokensRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShareTokensRequestMultiError is an error wrapping multiple validation
+// errors returned by ListShareTokensRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListShareTokensRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShareTokensRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShareTokensRequestMultiError) AllErrors() []error { return m }
+
+// ListShareTokensRequestValidationError is the validation error returned by
+// ListShareTokensRequest.Validate if the designated constraints aren't met.
+type ListShareTokensRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShareTokensRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShareTokensRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShareTokensRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShareTokensRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShareTokensRequestValidationError) ErrorName() string {
+	return "ListShareTokensRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShareTokensRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShareTokensRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShareTokensRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShareTokensRequestValidationError{}
+
+// Validate checks the field values on ListShareTokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListShareTokensResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShareTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListShareTokensResponseMultiError, or nil if none found.
+func (m *ListShareTokensResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShareTokensResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetShareTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListShareTokensResponseValidationError{
+						field:  fmt.Sprintf("ShareTokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListShareTokensResponseValidationError{
+						field:  fmt.Sprintf("ShareTokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListShareTokensResponseValidationError{
+					field:  fmt.Sprintf("ShareTokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListShareTokensResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShareTokensResponseMultiError is an error wrapping multiple validation
+// errors returned by ListShareTokensResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListShareTokensResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShareTokensResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShareTokensResponseMultiError) AllErrors() []error { return m }
+
+// ListShareTokensResponseValidationError is the validation error returned by
+// ListShareTokensResponse.Validate if the designated constraints aren't met.
+type ListShareTokensResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShareTokensResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShareTokensResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShareTokensResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShareTokensResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShareTokensResponseValidationError) ErrorName() string {
+	return "ListShareTokensResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShareTokensResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShareTokensResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShareTokensResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShareTokensResponseValidationError{}
+
+// Validate checks the field values on RevokeShareTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeShareTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeShareTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeShareTokenRequestMultiError, or nil if none found.
+func (m *RevokeShareTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeShareTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return RevokeShareTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeShareTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeShareTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeShareTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeShareTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeShareTokenRequestMultiError) AllErrors() []error { return m }
+
+// RevokeShareTokenRequestValidationError is the validation error returned by
+// RevokeShareTokenRequest.Validate if the designated constraints aren't met.
+type RevokeShareTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeShareTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeShareTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeShareTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeShareTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeShareTokenRequestValidationError) ErrorName() string {
+	return "RevokeShareTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeShareTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeShareTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeShareTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeShareTokenRequestValidationError{}
+
+// Validate checks the field values on RatePackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RatePackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RatePackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RatePackRequestMultiError, or nil if none found.
+func (m *RatePackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RatePackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	if val := m.GetRating(); val < 1 || val > 5 {
+		err := RatePackRequestValidationError{
+			field:  "Rating",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ShareToken
+
+	if len(errors) > 0 {
+		return RatePackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RatePackRequestMultiError is an error wrapping multiple validation errors
+// returned by RatePackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RatePackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RatePackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RatePackRequestMultiError) AllErrors() []error { return m }
+
+// RatePackRequestValidationError is the validation error returned by
+// RatePackRequest.Validate if the designated constraints aren't met.
+type RatePackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RatePackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RatePackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RatePackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RatePackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RatePackRequestValidationError) ErrorName() string { return "RatePackRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RatePackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRatePackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RatePackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RatePackRequestValidationError{}
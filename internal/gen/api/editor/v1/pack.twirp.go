@@ -16,6 +16,8 @@ import proto "google.golang.org/protobuf/proto"
 import twirp "github.com/twitchtv/twirp"
 import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
 
+import google_protobuf3 "google.golang.org/protobuf/types/known/emptypb"
+
 // Version compatibility assertion.
 // If the constant is not defined in the package, that likely means
 // the package needs to be updated to work with this generated code.
@@ -29,9 +31,37 @@ const _ = twirp.TwirpPackageMinVersion_8_1_0
 type PackService interface {
 	CreatePack(context.Context, *CreatePackRequest) (*CreatePackResponse, error)
 
+	// GetPack returns pack.
+	// Drafts are readable only by the author, published public packs by anyone,
+	// unlisted packs by anyone with share token and private packs by authenticated players with share token.
 	GetPack(context.Context, *GetPackRequest) (*GetPackResponse, error)
 
+	// PublishPack publishes pack, only author of the pack can publish it.
+	// Pack must contain from 1 to 6 rounds, each round from 1 to 10 topics
+	// and each topic from 1 to 10 questions.
+	// Authors banned by moderators cannot publish packs.
 	PublishPack(context.Context, *PublishPackRequest) (*PublishPackResponse, error)
+
+	// ListPacks returns published public packs which are not hidden, newest first.
+	// If query is set packs are searched by name and sorted by relevance.
+	ListPacks(context.Context, *ListPacksRequest) (*ListPacksResponse, error)
+
+	// SetPackVisibility changes pack visibility, only author of the pack can change it.
+	SetPackVisibility(context.Context, *SetPackVisibilityRequest) (*google_protobuf3.Empty, error)
+
+	// CreateShareToken creates token which grants read access to unlisted or private pack.
+	CreateShareToken(context.Context, *CreateShareTokenRequest) (*CreateShareTokenResponse, error)
+
+	// ListShareTokens returns share tokens of the pack to its author.
+	ListShareTokens(context.Context, *ListShareTokensRequest) (*ListShareTokensResponse, error)
+
+	// RevokeShareToken deletes share token, players who used it lose access to the pack.
+	RevokeShareToken(context.Context, *RevokeShareTokenRequest) (*google_protobuf3.Empty, error)
+
+	// RatePack rates published pack from 1 to 5, next rating of the same player overwrites previous one.
+	// Only players and hosts who finished a game with the pack can rate it, author cannot rate his own pack.
+	// Share token is required to rate unlisted and private packs.
+	RatePack(context.Context, *RatePackRequest) (*google_protobuf3.Empty, error)
 }
 
 // ===========================
@@ -40,7 +70,7 @@ type PackService interface {
 
 type packServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [9]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -68,10 +98,16 @@ func NewPackServiceProtobufClient(baseURL string, client HTTPClient, opts ...twi
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "PackService")
-	urls := [3]string{
+	urls := [9]string{
 		serviceURL + "CreatePack",
 		serviceURL + "GetPack",
 		serviceURL + "PublishPack",
+		serviceURL + "ListPacks",
+		serviceURL + "SetPackVisibility",
+		serviceURL + "CreateShareToken",
+		serviceURL + "ListShareTokens",
+		serviceURL + "RevokeShareToken",
+		serviceURL + "RatePack",
 	}
 
 	return &packServiceProtobufClient{
@@ -220,13 +256,289 @@ func (c *packServiceProtobufClient) callPublishPack(ctx context.Context, in *Pub
 	return out, nil
 }
 
+func (c *packServiceProtobufClient) ListPacks(ctx context.Context, in *ListPacksRequest) (*ListPacksResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPacks")
+	caller := c.callListPacks
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListPacksRequest) (*ListPacksResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPacksRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPacksRequest) when calling interceptor")
+					}
+					return c.callListPacks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPacksResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPacksResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceProtobufClient) callListPacks(ctx context.Context, in *ListPacksRequest) (*ListPacksResponse, error) {
+	out := new(ListPacksResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceProtobufClient) SetPackVisibility(ctx context.Context, in *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPackVisibility")
+	caller := c.callSetPackVisibility
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPackVisibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPackVisibilityRequest) when calling interceptor")
+					}
+					return c.callSetPackVisibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceProtobufClient) callSetPackVisibility(ctx context.Context, in *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceProtobufClient) CreateShareToken(ctx context.Context, in *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShareToken")
+	caller := c.callCreateShareToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShareTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShareTokenRequest) when calling interceptor")
+					}
+					return c.callCreateShareToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShareTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShareTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceProtobufClient) callCreateShareToken(ctx context.Context, in *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+	out := new(CreateShareTokenResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceProtobufClient) ListShareTokens(ctx context.Context, in *ListShareTokensRequest) (*ListShareTokensResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListShareTokens")
+	caller := c.callListShareTokens
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListShareTokensRequest) (*ListShareTokensResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListShareTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListShareTokensRequest) when calling interceptor")
+					}
+					return c.callListShareTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListShareTokensResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListShareTokensResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceProtobufClient) callListShareTokens(ctx context.Context, in *ListShareTokensRequest) (*ListShareTokensResponse, error) {
+	out := new(ListShareTokensResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceProtobufClient) RevokeShareToken(ctx context.Context, in *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeShareToken")
+	caller := c.callRevokeShareToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeShareTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeShareTokenRequest) when calling interceptor")
+					}
+					return c.callRevokeShareToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceProtobufClient) callRevokeShareToken(ctx context.Context, in *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceProtobufClient) RatePack(ctx context.Context, in *RatePackRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "RatePack")
+	caller := c.callRatePack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RatePackRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatePackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatePackRequest) when calling interceptor")
+					}
+					return c.callRatePack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceProtobufClient) callRatePack(ctx context.Context, in *RatePackRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // =======================
 // PackService JSON Client
 // =======================
 
 type packServiceJSONClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [9]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -254,10 +566,16 @@ func NewPackServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.C
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "PackService")
-	urls := [3]string{
+	urls := [9]string{
 		serviceURL + "CreatePack",
 		serviceURL + "GetPack",
 		serviceURL + "PublishPack",
+		serviceURL + "ListPacks",
+		serviceURL + "SetPackVisibility",
+		serviceURL + "CreateShareToken",
+		serviceURL + "ListShareTokens",
+		serviceURL + "RevokeShareToken",
+		serviceURL + "RatePack",
 	}
 
 	return &packServiceJSONClient{
@@ -386,140 +704,1514 @@ func (c *packServiceJSONClient) PublishPack(ctx context.Context, in *PublishPack
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
+	return caller(ctx, in)
+}
+
+func (c *packServiceJSONClient) callPublishPack(ctx context.Context, in *PublishPackRequest) (*PublishPackResponse, error) {
+	out := new(PublishPackResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceJSONClient) ListPacks(ctx context.Context, in *ListPacksRequest) (*ListPacksResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListPacks")
+	caller := c.callListPacks
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListPacksRequest) (*ListPacksResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPacksRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPacksRequest) when calling interceptor")
+					}
+					return c.callListPacks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPacksResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPacksResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceJSONClient) callListPacks(ctx context.Context, in *ListPacksRequest) (*ListPacksResponse, error) {
+	out := new(ListPacksResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceJSONClient) SetPackVisibility(ctx context.Context, in *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "SetPackVisibility")
+	caller := c.callSetPackVisibility
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPackVisibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPackVisibilityRequest) when calling interceptor")
+					}
+					return c.callSetPackVisibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceJSONClient) callSetPackVisibility(ctx context.Context, in *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceJSONClient) CreateShareToken(ctx context.Context, in *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShareToken")
+	caller := c.callCreateShareToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShareTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShareTokenRequest) when calling interceptor")
+					}
+					return c.callCreateShareToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShareTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShareTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceJSONClient) callCreateShareToken(ctx context.Context, in *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+	out := new(CreateShareTokenResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceJSONClient) ListShareTokens(ctx context.Context, in *ListShareTokensRequest) (*ListShareTokensResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListShareTokens")
+	caller := c.callListShareTokens
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListShareTokensRequest) (*ListShareTokensResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListShareTokensRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListShareTokensRequest) when calling interceptor")
+					}
+					return c.callListShareTokens(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListShareTokensResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListShareTokensResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceJSONClient) callListShareTokens(ctx context.Context, in *ListShareTokensRequest) (*ListShareTokensResponse, error) {
+	out := new(ListShareTokensResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceJSONClient) RevokeShareToken(ctx context.Context, in *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeShareToken")
+	caller := c.callRevokeShareToken
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RevokeShareTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeShareTokenRequest) when calling interceptor")
+					}
+					return c.callRevokeShareToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceJSONClient) callRevokeShareToken(ctx context.Context, in *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *packServiceJSONClient) RatePack(ctx context.Context, in *RatePackRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithMethodName(ctx, "RatePack")
+	caller := c.callRatePack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RatePackRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RatePackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RatePackRequest) when calling interceptor")
+					}
+					return c.callRatePack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *packServiceJSONClient) callRatePack(ctx context.Context, in *RatePackRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// PackService Server Handler
+// ==========================
+
+type packServiceServer struct {
+	PackService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewPackServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewPackServiceServer(svc PackService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &packServiceServer{
+		PackService:      svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *packServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *packServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// PackServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const PackServicePathPrefix = "/twirp/editor.v1.PackService/"
+
+func (s *packServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PackService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "editor.v1.PackService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreatePack":
+		s.serveCreatePack(ctx, resp, req)
+		return
+	case "GetPack":
+		s.serveGetPack(ctx, resp, req)
+		return
+	case "PublishPack":
+		s.servePublishPack(ctx, resp, req)
+		return
+	case "ListPacks":
+		s.serveListPacks(ctx, resp, req)
+		return
+	case "SetPackVisibility":
+		s.serveSetPackVisibility(ctx, resp, req)
+		return
+	case "CreateShareToken":
+		s.serveCreateShareToken(ctx, resp, req)
+		return
+	case "ListShareTokens":
+		s.serveListShareTokens(ctx, resp, req)
+		return
+	case "RevokeShareToken":
+		s.serveRevokeShareToken(ctx, resp, req)
+		return
+	case "RatePack":
+		s.serveRatePack(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *packServiceServer) serveCreatePack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreatePackJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreatePackProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *packServiceServer) serveCreatePackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreatePackRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PackService.CreatePack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreatePackRequest) (*CreatePackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePackRequest) when calling interceptor")
+					}
+					return s.PackService.CreatePack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreatePackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreatePackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreatePackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreatePackResponse and nil error while calling CreatePack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveCreatePackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreatePack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreatePackRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PackService.CreatePack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreatePackRequest) (*CreatePackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreatePackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreatePackRequest) when calling interceptor")
+					}
+					return s.PackService.CreatePack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreatePackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreatePackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreatePackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreatePackResponse and nil error while calling CreatePack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveGetPack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPackJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPackProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *packServiceServer) serveGetPackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetPackRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PackService.GetPack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPackRequest) (*GetPackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPackRequest) when calling interceptor")
+					}
+					return s.PackService.GetPack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPackResponse and nil error while calling GetPack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveGetPackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetPackRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PackService.GetPack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPackRequest) (*GetPackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPackRequest) when calling interceptor")
+					}
+					return s.PackService.GetPack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPackResponse and nil error while calling GetPack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) servePublishPack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePublishPackJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePublishPackProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *packServiceServer) servePublishPackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PublishPack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(PublishPackRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PackService.PublishPack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PublishPackRequest) (*PublishPackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PublishPackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PublishPackRequest) when calling interceptor")
+					}
+					return s.PackService.PublishPack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PublishPackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PublishPackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PublishPackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PublishPackResponse and nil error while calling PublishPack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) servePublishPackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "PublishPack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(PublishPackRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PackService.PublishPack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *PublishPackRequest) (*PublishPackResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*PublishPackRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*PublishPackRequest) when calling interceptor")
+					}
+					return s.PackService.PublishPack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*PublishPackResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*PublishPackResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *PublishPackResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PublishPackResponse and nil error while calling PublishPack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveListPacks(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListPacksJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListPacksProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *packServiceServer) serveListPacksJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPacks")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListPacksRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PackService.ListPacks
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPacksRequest) (*ListPacksResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPacksRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPacksRequest) when calling interceptor")
+					}
+					return s.PackService.ListPacks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPacksResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPacksResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListPacksResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPacksResponse and nil error while calling ListPacks. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveListPacksProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListPacks")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListPacksRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PackService.ListPacks
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListPacksRequest) (*ListPacksResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListPacksRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListPacksRequest) when calling interceptor")
+					}
+					return s.PackService.ListPacks(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListPacksResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListPacksResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListPacksResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListPacksResponse and nil error while calling ListPacks. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveSetPackVisibility(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSetPackVisibilityJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSetPackVisibilityProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *packServiceServer) serveSetPackVisibilityJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPackVisibility")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SetPackVisibilityRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PackService.SetPackVisibility
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPackVisibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPackVisibilityRequest) when calling interceptor")
+					}
+					return s.PackService.SetPackVisibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling SetPackVisibility. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveSetPackVisibilityProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SetPackVisibility")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SetPackVisibilityRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PackService.SetPackVisibility
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SetPackVisibilityRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SetPackVisibilityRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SetPackVisibilityRequest) when calling interceptor")
+					}
+					return s.PackService.SetPackVisibility(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling SetPackVisibility. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *packServiceServer) serveCreateShareToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateShareTokenJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateShareTokenProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *packServiceServer) serveCreateShareTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShareToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateShareTokenRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PackService.CreateShareToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShareTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShareTokenRequest) when calling interceptor")
+					}
+					return s.PackService.CreateShareToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShareTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShareTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateShareTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-func (c *packServiceJSONClient) callPublishPack(ctx context.Context, in *PublishPackRequest) (*PublishPackResponse, error) {
-	out := new(PublishPackResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateShareTokenResponse and nil error while calling CreateShareToken. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
-
-// ==========================
-// PackService Server Handler
-// ==========================
-
-type packServiceServer struct {
-	PackService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewPackServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewPackServiceServer(svc PackService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
 	}
 
-	return &packServiceServer{
-		PackService:      svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *packServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+func (s *packServiceServer) serveCreateShareTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateShareToken")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *packServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(CreateShareTokenRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// PackServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const PackServicePathPrefix = "/twirp/editor.v1.PackService/"
+	handler := s.PackService.CreateShareToken
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateShareTokenRequest) (*CreateShareTokenResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateShareTokenRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateShareTokenRequest) when calling interceptor")
+					}
+					return s.PackService.CreateShareToken(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateShareTokenResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateShareTokenResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *packServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "PackService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *CreateShareTokenResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateShareTokenResponse and nil error while calling CreateShareToken. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "editor.v1.PackService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "CreatePack":
-		s.serveCreatePack(ctx, resp, req)
-		return
-	case "GetPack":
-		s.serveGetPack(ctx, resp, req)
-		return
-	case "PublishPack":
-		s.servePublishPack(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *packServiceServer) serveCreatePack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveListShareTokens(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -527,9 +2219,9 @@ func (s *packServiceServer) serveCreatePack(ctx context.Context, resp http.Respo
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreatePackJSON(ctx, resp, req)
+		s.serveListShareTokensJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreatePackProtobuf(ctx, resp, req)
+		s.serveListShareTokensProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -537,9 +2229,9 @@ func (s *packServiceServer) serveCreatePack(ctx context.Context, resp http.Respo
 	}
 }
 
-func (s *packServiceServer) serveCreatePackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveListShareTokensJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreatePack")
+	ctx = ctxsetters.WithMethodName(ctx, "ListShareTokens")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -552,29 +2244,29 @@ func (s *packServiceServer) serveCreatePackJSON(ctx context.Context, resp http.R
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreatePackRequest)
+	reqContent := new(ListShareTokensRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.PackService.CreatePack
+	handler := s.PackService.ListShareTokens
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreatePackRequest) (*CreatePackResponse, error) {
+		handler = func(ctx context.Context, req *ListShareTokensRequest) (*ListShareTokensResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreatePackRequest)
+					typedReq, ok := req.(*ListShareTokensRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreatePackRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListShareTokensRequest) when calling interceptor")
 					}
-					return s.PackService.CreatePack(ctx, typedReq)
+					return s.PackService.ListShareTokens(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreatePackResponse)
+				typedResp, ok := resp.(*ListShareTokensResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreatePackResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListShareTokensResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -583,7 +2275,7 @@ func (s *packServiceServer) serveCreatePackJSON(ctx context.Context, resp http.R
 	}
 
 	// Call service method
-	var respContent *CreatePackResponse
+	var respContent *ListShareTokensResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -594,7 +2286,7 @@ func (s *packServiceServer) serveCreatePackJSON(ctx context.Context, resp http.R
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreatePackResponse and nil error while calling CreatePack. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListShareTokensResponse and nil error while calling ListShareTokens. nil responses are not supported"))
 		return
 	}
 
@@ -620,9 +2312,9 @@ func (s *packServiceServer) serveCreatePackJSON(ctx context.Context, resp http.R
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *packServiceServer) serveCreatePackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveListShareTokensProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreatePack")
+	ctx = ctxsetters.WithMethodName(ctx, "ListShareTokens")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -634,28 +2326,28 @@ func (s *packServiceServer) serveCreatePackProtobuf(ctx context.Context, resp ht
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreatePackRequest)
+	reqContent := new(ListShareTokensRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.PackService.CreatePack
+	handler := s.PackService.ListShareTokens
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreatePackRequest) (*CreatePackResponse, error) {
+		handler = func(ctx context.Context, req *ListShareTokensRequest) (*ListShareTokensResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreatePackRequest)
+					typedReq, ok := req.(*ListShareTokensRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreatePackRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListShareTokensRequest) when calling interceptor")
 					}
-					return s.PackService.CreatePack(ctx, typedReq)
+					return s.PackService.ListShareTokens(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreatePackResponse)
+				typedResp, ok := resp.(*ListShareTokensResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreatePackResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListShareTokensResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -664,7 +2356,7 @@ func (s *packServiceServer) serveCreatePackProtobuf(ctx context.Context, resp ht
 	}
 
 	// Call service method
-	var respContent *CreatePackResponse
+	var respContent *ListShareTokensResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -675,7 +2367,7 @@ func (s *packServiceServer) serveCreatePackProtobuf(ctx context.Context, resp ht
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreatePackResponse and nil error while calling CreatePack. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListShareTokensResponse and nil error while calling ListShareTokens. nil responses are not supported"))
 		return
 	}
 
@@ -699,7 +2391,7 @@ func (s *packServiceServer) serveCreatePackProtobuf(ctx context.Context, resp ht
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *packServiceServer) serveGetPack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveRevokeShareToken(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -707,9 +2399,9 @@ func (s *packServiceServer) serveGetPack(ctx context.Context, resp http.Response
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetPackJSON(ctx, resp, req)
+		s.serveRevokeShareTokenJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetPackProtobuf(ctx, resp, req)
+		s.serveRevokeShareTokenProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -717,9 +2409,9 @@ func (s *packServiceServer) serveGetPack(ctx context.Context, resp http.Response
 	}
 }
 
-func (s *packServiceServer) serveGetPackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveRevokeShareTokenJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetPack")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeShareToken")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -732,29 +2424,29 @@ func (s *packServiceServer) serveGetPackJSON(ctx context.Context, resp http.Resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetPackRequest)
+	reqContent := new(RevokeShareTokenRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.PackService.GetPack
+	handler := s.PackService.RevokeShareToken
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetPackRequest) (*GetPackResponse, error) {
+		handler = func(ctx context.Context, req *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPackRequest)
+					typedReq, ok := req.(*RevokeShareTokenRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPackRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeShareTokenRequest) when calling interceptor")
 					}
-					return s.PackService.GetPack(ctx, typedReq)
+					return s.PackService.RevokeShareToken(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPackResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPackResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -763,7 +2455,7 @@ func (s *packServiceServer) serveGetPackJSON(ctx context.Context, resp http.Resp
 	}
 
 	// Call service method
-	var respContent *GetPackResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -774,7 +2466,7 @@ func (s *packServiceServer) serveGetPackJSON(ctx context.Context, resp http.Resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPackResponse and nil error while calling GetPack. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling RevokeShareToken. nil responses are not supported"))
 		return
 	}
 
@@ -800,9 +2492,9 @@ func (s *packServiceServer) serveGetPackJSON(ctx context.Context, resp http.Resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *packServiceServer) serveGetPackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveRevokeShareTokenProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetPack")
+	ctx = ctxsetters.WithMethodName(ctx, "RevokeShareToken")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -814,28 +2506,28 @@ func (s *packServiceServer) serveGetPackProtobuf(ctx context.Context, resp http.
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetPackRequest)
+	reqContent := new(RevokeShareTokenRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.PackService.GetPack
+	handler := s.PackService.RevokeShareToken
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetPackRequest) (*GetPackResponse, error) {
+		handler = func(ctx context.Context, req *RevokeShareTokenRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetPackRequest)
+					typedReq, ok := req.(*RevokeShareTokenRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetPackRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RevokeShareTokenRequest) when calling interceptor")
 					}
-					return s.PackService.GetPack(ctx, typedReq)
+					return s.PackService.RevokeShareToken(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetPackResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetPackResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -844,7 +2536,7 @@ func (s *packServiceServer) serveGetPackProtobuf(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *GetPackResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -855,7 +2547,7 @@ func (s *packServiceServer) serveGetPackProtobuf(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPackResponse and nil error while calling GetPack. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling RevokeShareToken. nil responses are not supported"))
 		return
 	}
 
@@ -879,7 +2571,7 @@ func (s *packServiceServer) serveGetPackProtobuf(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *packServiceServer) servePublishPack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveRatePack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -887,9 +2579,9 @@ func (s *packServiceServer) servePublishPack(ctx context.Context, resp http.Resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.servePublishPackJSON(ctx, resp, req)
+		s.serveRatePackJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.servePublishPackProtobuf(ctx, resp, req)
+		s.serveRatePackProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -897,9 +2589,9 @@ func (s *packServiceServer) servePublishPack(ctx context.Context, resp http.Resp
 	}
 }
 
-func (s *packServiceServer) servePublishPackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveRatePackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "PublishPack")
+	ctx = ctxsetters.WithMethodName(ctx, "RatePack")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -912,29 +2604,29 @@ func (s *packServiceServer) servePublishPackJSON(ctx context.Context, resp http.
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(PublishPackRequest)
+	reqContent := new(RatePackRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.PackService.PublishPack
+	handler := s.PackService.RatePack
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *PublishPackRequest) (*PublishPackResponse, error) {
+		handler = func(ctx context.Context, req *RatePackRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PublishPackRequest)
+					typedReq, ok := req.(*RatePackRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PublishPackRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RatePackRequest) when calling interceptor")
 					}
-					return s.PackService.PublishPack(ctx, typedReq)
+					return s.PackService.RatePack(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*PublishPackResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*PublishPackResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -943,7 +2635,7 @@ func (s *packServiceServer) servePublishPackJSON(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *PublishPackResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -954,7 +2646,7 @@ func (s *packServiceServer) servePublishPackJSON(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *PublishPackResponse and nil error while calling PublishPack. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling RatePack. nil responses are not supported"))
 		return
 	}
 
@@ -980,9 +2672,9 @@ func (s *packServiceServer) servePublishPackJSON(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *packServiceServer) servePublishPackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *packServiceServer) serveRatePackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "PublishPack")
+	ctx = ctxsetters.WithMethodName(ctx, "RatePack")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -994,28 +2686,28 @@ func (s *packServiceServer) servePublishPackProtobuf(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(PublishPackRequest)
+	reqContent := new(RatePackRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.PackService.PublishPack
+	handler := s.PackService.RatePack
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *PublishPackRequest) (*PublishPackResponse, error) {
+		handler = func(ctx context.Context, req *RatePackRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*PublishPackRequest)
+					typedReq, ok := req.(*RatePackRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*PublishPackRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RatePackRequest) when calling interceptor")
 					}
-					return s.PackService.PublishPack(ctx, typedReq)
+					return s.PackService.RatePack(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*PublishPackResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*PublishPackResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1024,7 +2716,7 @@ func (s *packServiceServer) servePublishPackProtobuf(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *PublishPackResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1035,7 +2727,7 @@ func (s *packServiceServer) servePublishPackProtobuf(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *PublishPackResponse and nil error while calling PublishPack. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling RatePack. nil responses are not supported"))
 		return
 	}
 
@@ -1075,44 +2767,81 @@ func (s *packServiceServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor1 = []byte{
-	// 623 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x86, 0x35, 0x89, 0x93, 0xc6, 0xc7, 0x5f, 0x7f, 0xbe, 0xa1, 0xa2, 0xc6, 0xd0, 0x36, 0x18,
-	0x81, 0x02, 0x02, 0x47, 0x4d, 0x97, 0xdd, 0x20, 0x77, 0x81, 0x8a, 0x10, 0xaa, 0x0c, 0x08, 0x89,
-	0x4d, 0x98, 0xda, 0x43, 0x3a, 0x6a, 0x92, 0x09, 0xf6, 0xd8, 0x77, 0x80, 0xc4, 0x9a, 0x1b, 0xe2,
-	0x16, 0xd8, 0x71, 0x0b, 0x5c, 0x43, 0x57, 0x68, 0xce, 0x4c, 0x8c, 0xdb, 0x12, 0x89, 0xdd, 0xf8,
-	0x9d, 0x67, 0xce, 0x79, 0xcf, 0x8f, 0x0c, 0xdb, 0x3c, 0x13, 0x4a, 0xe6, 0xc3, 0xea, 0x60, 0xb8,
-	0x60, 0xe9, 0x45, 0xb4, 0xc8, 0xa5, 0x92, 0xd4, 0x35, 0x6a, 0x54, 0x1d, 0x04, 0x3b, 0x15, 0x9b,
-	0x8a, 0x8c, 0x29, 0x3e, 0x5c, 0x1e, 0x0c, 0x13, 0xec, 0x4f, 0xa4, 0x9c, 0x4c, 0xf9, 0x10, 0xbf,
-	0xce, 0xca, 0x4f, 0x43, 0x25, 0x66, 0xbc, 0x50, 0x6c, 0xb6, 0x30, 0x40, 0xf8, 0x9d, 0x80, 0x73,
-	0xca, 0xd2, 0x0b, 0xba, 0x01, 0x2d, 0x91, 0xf9, 0xa4, 0x4f, 0x06, 0x9d, 0xa4, 0x25, 0x32, 0x4a,
-	0xc1, 0x99, 0xb3, 0x19, 0xf7, 0x5b, 0x7d, 0x32, 0x70, 0x13, 0x3c, 0xd3, 0xdb, 0xd0, 0x65, 0xa5,
-	0x3a, 0x97, 0xb9, 0xdf, 0x46, 0xd5, 0x7e, 0xd1, 0xfb, 0xf0, 0x9f, 0x28, 0xc6, 0x8b, 0xf2, 0x6c,
-	0x2a, 0x8a, 0x73, 0x9e, 0xf9, 0x4e, 0x9f, 0x0c, 0x7a, 0x89, 0x27, 0x8a, 0xd3, 0xa5, 0x44, 0xef,
-	0x82, 0x9b, 0xca, 0x8a, 0xe7, 0xe3, 0x32, 0x9f, 0xfa, 0x1d, 0x7c, 0xdd, 0x43, 0xe1, 0x5d, 0x3e,
-	0xa5, 0x47, 0xe0, 0xa5, 0x39, 0x67, 0x8a, 0x8f, 0xb5, 0x3d, 0x7f, 0xd4, 0x27, 0x03, 0x6f, 0x14,
-	0x44, 0xc6, 0x7b, 0xb4, 0xf4, 0x1e, 0xbd, 0x5d, 0x7a, 0x4f, 0xc0, 0xe0, 0x5a, 0x08, 0x7f, 0x12,
-	0x70, 0x75, 0x05, 0x6f, 0x14, 0x53, 0x05, 0xdd, 0x07, 0x2f, 0x97, 0xe5, 0x3c, 0x1b, 0xa7, 0xb2,
-	0x9c, 0x2b, 0x5b, 0x0f, 0xa0, 0x74, 0xac, 0x15, 0x0d, 0x28, 0xb9, 0x10, 0xa9, 0x05, 0x5a, 0x06,
-	0x40, 0xc9, 0x00, 0x0f, 0x61, 0xe3, 0x73, 0xc9, 0x0b, 0x25, 0xe4, 0xdc, 0x32, 0x6d, 0x64, 0xd6,
-	0x97, 0x6a, 0x1d, 0xa7, 0x12, 0x19, 0x97, 0x96, 0x71, 0x4c, 0x1c, 0x94, 0x6a, 0x80, 0x95, 0x99,
-	0x58, 0x02, 0x1d, 0x03, 0xa0, 0x54, 0x03, 0x62, 0xc6, 0x26, 0xdc, 0x02, 0x5d, 0x03, 0xa0, 0x84,
-	0x40, 0xf8, 0x11, 0xd6, 0x75, 0x61, 0xef, 0x85, 0x3a, 0x37, 0xc5, 0x3d, 0x00, 0x47, 0xcf, 0x1f,
-	0xab, 0xf2, 0x46, 0x9b, 0x51, 0xbd, 0x00, 0x91, 0xe6, 0x12, 0xbc, 0xa4, 0x4f, 0xa0, 0x53, 0x68,
-	0x1a, 0x4b, 0xf3, 0x46, 0xdb, 0xd7, 0x28, 0x8c, 0x94, 0x18, 0x24, 0x7c, 0x0c, 0x1b, 0x2f, 0xb8,
-	0xc2, 0xc7, 0x1c, 0xcb, 0xa3, 0x3b, 0xb0, 0xa6, 0xa3, 0x8c, 0xeb, 0x5d, 0xe8, 0xea, 0xcf, 0x93,
-	0x2c, 0x7c, 0x09, 0x9b, 0x35, 0x5a, 0x2c, 0xe4, 0xbc, 0xe0, 0xff, 0x66, 0x87, 0x82, 0xa3, 0xd8,
-	0x44, 0xbb, 0x69, 0xeb, 0x3d, 0xd2, 0xe7, 0xf0, 0x0b, 0x81, 0xff, 0x8f, 0x71, 0x82, 0xcd, 0xd4,
-	0x8f, 0xc0, 0xc5, 0xd4, 0xb8, 0x76, 0x3a, 0xa6, 0x1b, 0xbb, 0x97, 0x71, 0x37, 0x77, 0xb6, 0xda,
-	0xfe, 0x28, 0xe9, 0xe9, 0xbb, 0xd7, 0x7a, 0x0b, 0x07, 0xcd, 0x55, 0xc2, 0xf5, 0x8c, 0xbd, 0xcb,
-	0xb8, 0x97, 0x77, 0xbf, 0x12, 0xf2, 0x83, 0x90, 0xc6, 0x5e, 0xed, 0xd9, 0xdc, 0x6d, 0x9d, 0x3b,
-	0x86, 0xcb, 0x78, 0xed, 0x1b, 0x71, 0xb6, 0x3a, 0x3e, 0xb1, 0x3e, 0x9e, 0x01, 0x6d, 0xda, 0xb0,
-	0x65, 0xad, 0x6c, 0xc1, 0x21, 0x50, 0xbb, 0xd0, 0x4d, 0xdb, 0xbb, 0x00, 0xfa, 0x5e, 0x0f, 0xb2,
-	0x7e, 0xe1, 0x5a, 0xe5, 0x24, 0x0b, 0x8f, 0xe1, 0xd6, 0x95, 0x47, 0x36, 0xc9, 0xd3, 0x2b, 0xbd,
-	0xf3, 0xaf, 0xf5, 0xae, 0x1e, 0xb9, 0x69, 0xe2, 0xe8, 0x17, 0x01, 0x0f, 0x87, 0xc7, 0xf3, 0x4a,
-	0xa4, 0x9c, 0x9e, 0x00, 0xfc, 0x31, 0x4e, 0xef, 0x35, 0x5e, 0xdf, 0x68, 0x6b, 0xb0, 0xbb, 0xe2,
-	0xd6, 0x1a, 0x79, 0x0e, 0x6b, 0x76, 0xae, 0xf4, 0x4e, 0x83, 0xbc, 0xba, 0x16, 0x41, 0xf0, 0xb7,
-	0x2b, 0x1b, 0xe1, 0x15, 0x78, 0x8d, 0x0a, 0x69, 0x33, 0xdf, 0xcd, 0x76, 0x05, 0x7b, 0xab, 0xae,
-	0x4d, 0xb4, 0x78, 0xfb, 0x03, 0xad, 0xff, 0x76, 0x47, 0xe6, 0x54, 0x1d, 0x9c, 0x75, 0xf1, 0x27,
-	0x70, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xae, 0x71, 0xac, 0x79, 0x0a, 0x05, 0x00, 0x00,
+	// 1201 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x0e, 0x29, 0x52, 0x1f, 0x23, 0xc7, 0x56, 0x36, 0x7e, 0x63, 0xbe, 0x74, 0x12, 0xcb, 0x34,
+	0x92, 0x0a, 0x41, 0x2b, 0xc1, 0x0a, 0x50, 0x34, 0x08, 0x50, 0x34, 0x54, 0x9c, 0x94, 0xa9, 0x60,
+	0x08, 0x94, 0xed, 0xa6, 0xed, 0x41, 0xa5, 0xc5, 0xad, 0xbc, 0xb0, 0x2c, 0x2a, 0x24, 0x25, 0xc4,
+	0x41, 0x4f, 0x01, 0x0a, 0xf4, 0xdc, 0xdf, 0xd2, 0x1f, 0xd1, 0xde, 0x7a, 0xeb, 0x1f, 0xe9, 0x49,
+	0xa7, 0x62, 0x3f, 0x44, 0x2d, 0x29, 0x2b, 0x6e, 0xd1, 0x1b, 0x77, 0xe6, 0xd9, 0xd9, 0xf9, 0x78,
+	0x9e, 0x91, 0x0d, 0x9b, 0xd8, 0x27, 0x71, 0x10, 0x36, 0xa6, 0xfb, 0x8d, 0xb1, 0xd7, 0x3f, 0xaf,
+	0x8f, 0xc3, 0x20, 0x0e, 0x50, 0x89, 0x5b, 0xeb, 0xd3, 0x7d, 0xd3, 0x58, 0x00, 0x86, 0xde, 0x68,
+	0x30, 0xf1, 0x06, 0x98, 0x83, 0xcc, 0xad, 0xa9, 0x37, 0x24, 0xbe, 0x17, 0xe3, 0xc6, 0xfc, 0x43,
+	0x38, 0x76, 0x06, 0x41, 0x30, 0x18, 0xe2, 0x06, 0x3b, 0x9d, 0x4e, 0x7e, 0x68, 0xc4, 0xe4, 0x02,
+	0x47, 0xb1, 0x77, 0x31, 0x16, 0x80, 0xed, 0x2c, 0x00, 0x5f, 0x8c, 0xe3, 0x4b, 0xee, 0xb4, 0x7e,
+	0x53, 0x41, 0xeb, 0x78, 0xfd, 0x73, 0xb4, 0x0e, 0x2a, 0xf1, 0x0d, 0xa5, 0xaa, 0xd4, 0x74, 0x57,
+	0x25, 0x3e, 0x42, 0xa0, 0x8d, 0xbc, 0x0b, 0x6c, 0xa8, 0x55, 0xa5, 0x56, 0x72, 0xd9, 0x37, 0xba,
+	0x03, 0x79, 0x6f, 0x12, 0x9f, 0x05, 0xa1, 0x91, 0x63, 0x56, 0x71, 0x42, 0xbb, 0xb0, 0x46, 0xa2,
+	0xde, 0x78, 0x72, 0x3a, 0x24, 0xd1, 0x19, 0xf6, 0x0d, 0xad, 0xaa, 0xd4, 0x8a, 0x6e, 0x99, 0x44,
+	0x9d, 0xb9, 0x09, 0x6d, 0x43, 0xa9, 0x1f, 0x4c, 0x71, 0xd8, 0x9b, 0x84, 0x43, 0x43, 0x67, 0xb7,
+	0x8b, 0xcc, 0x70, 0x1c, 0x0e, 0x51, 0x03, 0x8a, 0xf3, 0x6a, 0x8d, 0x7c, 0x55, 0xa9, 0xad, 0x37,
+	0x6f, 0xd7, 0x93, 0x9e, 0xd4, 0xdb, 0xc2, 0xe5, 0x26, 0x20, 0xf4, 0x04, 0x60, 0x4a, 0x22, 0x72,
+	0x4a, 0x86, 0x24, 0xbe, 0x34, 0x0a, 0xec, 0xca, 0xff, 0xa5, 0x2b, 0xb4, 0xa2, 0x93, 0x04, 0xe0,
+	0x4a, 0x60, 0x9a, 0x08, 0x89, 0x7a, 0x67, 0xc4, 0xf7, 0xf1, 0xc8, 0x28, 0xb2, 0x44, 0x8b, 0x24,
+	0xfa, 0x92, 0x9d, 0xd1, 0x53, 0x28, 0xf7, 0x43, 0xec, 0xc5, 0xb8, 0x47, 0x9b, 0x68, 0x34, 0xab,
+	0x4a, 0xad, 0xdc, 0x34, 0xeb, 0xbc, 0x81, 0xf5, 0x79, 0x03, 0xeb, 0x47, 0xf3, 0x0e, 0xbb, 0xc0,
+	0xe1, 0xd4, 0x60, 0xfd, 0xa9, 0x40, 0x89, 0x3e, 0xdc, 0x8d, 0xbd, 0x38, 0x42, 0x3b, 0x50, 0x0e,
+	0x83, 0xc9, 0xc8, 0xef, 0xf5, 0x83, 0xc9, 0x28, 0x16, 0x8d, 0x05, 0x66, 0x6a, 0x51, 0x0b, 0x05,
+	0xc4, 0xc1, 0x98, 0xf4, 0x05, 0x40, 0xe5, 0x00, 0x66, 0xe2, 0x80, 0x07, 0xb0, 0xfe, 0x66, 0x82,
+	0xa3, 0x98, 0x04, 0x23, 0x81, 0xc9, 0x31, 0xcc, 0xcd, 0xb9, 0x35, 0x89, 0x33, 0x25, 0x3e, 0x0e,
+	0x04, 0x46, 0xe3, 0x71, 0x98, 0x29, 0x01, 0x78, 0x13, 0x9f, 0xcc, 0x01, 0x3a, 0x07, 0x30, 0x53,
+	0x02, 0x20, 0x17, 0xde, 0x00, 0x0b, 0x40, 0x9e, 0x03, 0x98, 0x89, 0x01, 0xac, 0xef, 0xe1, 0x26,
+	0x2d, 0xec, 0x6b, 0x12, 0x9f, 0xf1, 0xe2, 0xf6, 0x40, 0xa3, 0xfc, 0x65, 0x55, 0x95, 0x9b, 0x1b,
+	0x99, 0xce, 0xbb, 0xcc, 0x89, 0x1e, 0x81, 0x1e, 0x51, 0x34, 0x2b, 0xad, 0xdc, 0xdc, 0xcc, 0xa0,
+	0x58, 0x24, 0x97, 0x43, 0xac, 0x57, 0xb0, 0xfe, 0x12, 0xc7, 0xec, 0x32, 0x66, 0xe5, 0xa1, 0x2d,
+	0x28, 0xd0, 0x28, 0xbd, 0x84, 0x94, 0x79, 0x7a, 0x74, 0x7c, 0x9a, 0x6d, 0x74, 0xe6, 0x85, 0xb8,
+	0x17, 0x07, 0xe7, 0x78, 0x24, 0xf8, 0x09, 0xcc, 0x74, 0x44, 0x2d, 0xd6, 0x2b, 0xd8, 0x48, 0x62,
+	0x45, 0xe3, 0x60, 0x14, 0xe1, 0x7f, 0x96, 0x2f, 0x02, 0x2d, 0xf6, 0x06, 0x34, 0xdd, 0x1c, 0x65,
+	0x3c, 0xfd, 0xb6, 0x7e, 0x52, 0xe1, 0x56, 0x8b, 0x8d, 0x58, 0xce, 0xed, 0x21, 0x94, 0x58, 0x6e,
+	0x4c, 0x20, 0x34, 0x66, 0xc9, 0x2e, 0xcd, 0xec, 0x7c, 0xa8, 0x55, 0x72, 0x46, 0xd3, 0x2d, 0x52,
+	0xdf, 0x21, 0xd5, 0x4b, 0x4d, 0x26, 0x3d, 0x4b, 0xd4, 0x2e, 0xcf, 0xec, 0x62, 0x98, 0xff, 0x59,
+	0x51, 0xfe, 0x50, 0x14, 0x49, 0x01, 0xf7, 0xc5, 0xdb, 0x39, 0xfa, 0xb6, 0x0d, 0x33, 0xbb, 0xf0,
+	0x8b, 0xa2, 0x19, 0x4a, 0x45, 0xe7, 0x79, 0xa0, 0x27, 0x92, 0x42, 0xb4, 0x95, 0x0a, 0xb1, 0x8b,
+	0x33, 0x5b, 0x7f, 0xaf, 0xa8, 0x15, 0x45, 0xd2, 0x4a, 0x2b, 0xa5, 0x15, 0xfd, 0x1a, 0xad, 0x48,
+	0x21, 0xa4, 0x6b, 0xd6, 0x27, 0x80, 0xe4, 0x36, 0x88, 0xb6, 0xae, 0x9a, 0x91, 0xf5, 0x18, 0x90,
+	0x90, 0xbe, 0xdc, 0xb6, 0x7b, 0x00, 0xd4, 0x4f, 0x99, 0x96, 0xdc, 0x28, 0x09, 0x8b, 0xe3, 0x5b,
+	0x2d, 0xb8, 0x9d, 0xba, 0x24, 0x1e, 0xf9, 0x38, 0x35, 0x3b, 0x23, 0x93, 0x79, 0xc2, 0x49, 0x3e,
+	0x44, 0xeb, 0x57, 0x05, 0x2a, 0x6d, 0x12, 0xb1, 0xf1, 0x47, 0x8b, 0x87, 0xf5, 0x37, 0x13, 0x1c,
+	0x5e, 0x8a, 0x59, 0x15, 0x66, 0xb6, 0x16, 0xaa, 0x46, 0xd3, 0xe5, 0xd6, 0x54, 0x73, 0xd5, 0x7f,
+	0xd7, 0xdc, 0x8f, 0x28, 0x13, 0x06, 0xb8, 0x17, 0x91, 0x77, 0x98, 0xcb, 0x93, 0x0d, 0xcf, 0xd4,
+	0xab, 0x37, 0x2a, 0x7f, 0xe5, 0x28, 0x15, 0x06, 0xb8, 0x4b, 0xde, 0x61, 0x5e, 0xfb, 0x60, 0x4e,
+	0x5a, 0x8d, 0x91, 0x96, 0x5d, 0xe5, 0x9c, 0x3d, 0x85, 0x5b, 0x52, 0xd6, 0xa2, 0xf2, 0x07, 0xa0,
+	0xd3, 0x9a, 0x22, 0x43, 0xa9, 0xe6, 0xae, 0xa2, 0x2d, 0xf7, 0xa2, 0x87, 0xb0, 0x31, 0xc2, 0x6f,
+	0xe3, 0x9e, 0x14, 0x9f, 0x8b, 0xe2, 0x26, 0x35, 0x77, 0x92, 0x37, 0x7e, 0x04, 0xa3, 0x8b, 0xe3,
+	0xf4, 0xb8, 0xaf, 0x55, 0xdb, 0xcb, 0x14, 0x7b, 0xd4, 0xeb, 0xd8, 0xb3, 0x36, 0xb3, 0x4b, 0xef,
+	0x95, 0xbc, 0xa1, 0x18, 0xaa, 0x91, 0x4b, 0x31, 0xe8, 0x2d, 0x40, 0x37, 0xd1, 0x28, 0xda, 0x04,
+	0x9d, 0x67, 0xca, 0x26, 0xe2, 0xf2, 0x83, 0x9c, 0x85, 0x9a, 0xca, 0xe2, 0x3f, 0xed, 0xe5, 0x26,
+	0x6c, 0x71, 0xee, 0x2e, 0xde, 0xbf, 0xae, 0x6c, 0xcb, 0x05, 0x63, 0xf9, 0x8e, 0x18, 0xcb, 0xa7,
+	0xe9, 0x05, 0xc4, 0x79, 0xf9, 0x3f, 0xa9, 0x27, 0xd2, 0x1d, 0x79, 0x2f, 0xed, 0xc3, 0x1d, 0x3a,
+	0xe3, 0x85, 0x37, 0xba, 0x36, 0x8d, 0x2e, 0x6c, 0x2d, 0x5d, 0x11, 0x59, 0x7c, 0x06, 0x6b, 0x52,
+	0x16, 0x73, 0x8e, 0xac, 0x48, 0xa3, 0xbc, 0x48, 0x23, 0xb2, 0x1a, 0xb0, 0xe5, 0xe2, 0x69, 0x70,
+	0x7e, 0x45, 0x3f, 0xae, 0x1c, 0x8b, 0x35, 0x82, 0x0d, 0x37, 0xb3, 0x01, 0x57, 0xf2, 0x65, 0x17,
+	0xf2, 0xa1, 0x17, 0x93, 0xd1, 0x80, 0x4f, 0x90, 0xed, 0x45, 0x53, 0xab, 0x29, 0x86, 0xee, 0x0a,
+	0x47, 0x76, 0x81, 0xe7, 0xb2, 0x0b, 0xfc, 0xd1, 0x6b, 0x58, 0x4f, 0xd3, 0x0a, 0xed, 0xc0, 0x76,
+	0xe7, 0x59, 0xeb, 0xab, 0xde, 0x89, 0xd3, 0x75, 0x6c, 0xa7, 0xed, 0x1c, 0x7d, 0xd3, 0x3b, 0x3e,
+	0xec, 0x76, 0x0e, 0x5a, 0xce, 0x0b, 0xe7, 0xe0, 0x79, 0xe5, 0x06, 0x02, 0xc8, 0x77, 0x8e, 0xed,
+	0xb6, 0xd3, 0xaa, 0x28, 0x68, 0x0d, 0x8a, 0xc7, 0x87, 0x6d, 0xa7, 0x7b, 0x74, 0xf0, 0xbc, 0xa2,
+	0xa2, 0x32, 0x14, 0x3a, 0xae, 0x73, 0xf2, 0xec, 0xe8, 0xa0, 0x92, 0x6b, 0xfe, 0xae, 0x43, 0x99,
+	0xfd, 0xf6, 0xe0, 0x70, 0x4a, 0xfa, 0x18, 0x39, 0x00, 0x8b, 0xb5, 0x86, 0xee, 0x4a, 0xcd, 0x5b,
+	0x5a, 0xfa, 0xe6, 0xbd, 0x15, 0x5e, 0x31, 0x8f, 0x2f, 0xa0, 0x20, 0x7e, 0x75, 0x90, 0xac, 0x8f,
+	0xf4, 0xaf, 0x9a, 0x69, 0x5e, 0xe5, 0x12, 0x11, 0xda, 0x50, 0x96, 0xf6, 0x1f, 0x92, 0xdf, 0x5b,
+	0x5e, 0xa6, 0xe6, 0xfd, 0x55, 0x6e, 0x11, 0xed, 0x05, 0x94, 0x92, 0x8d, 0x82, 0xb6, 0xe5, 0x7d,
+	0x96, 0xd9, 0x8e, 0xe6, 0xdd, 0xab, 0x9d, 0x22, 0x4e, 0x07, 0x6e, 0x2d, 0x6d, 0x0d, 0xb4, 0x27,
+	0xd3, 0x6c, 0xc5, 0x4e, 0x31, 0xef, 0x2c, 0xe9, 0xf3, 0x80, 0xfe, 0xe1, 0x89, 0xbe, 0x83, 0x4a,
+	0x56, 0x5b, 0xc8, 0x5a, 0x6a, 0xee, 0x12, 0x39, 0xcd, 0xbd, 0x0f, 0x62, 0x44, 0xba, 0xaf, 0x61,
+	0x23, 0xa3, 0x18, 0xb4, 0x9b, 0xa9, 0x6f, 0x59, 0x80, 0xa6, 0xf5, 0x21, 0x88, 0x88, 0x7c, 0x08,
+	0x95, 0xac, 0x6c, 0x52, 0x69, 0xaf, 0xd0, 0xd4, 0xca, 0x36, 0x7c, 0x0e, 0xc5, 0xb9, 0xaa, 0x90,
+	0x4c, 0x8b, 0x8c, 0xd4, 0x56, 0xdd, 0xb7, 0x37, 0xbf, 0x45, 0xc9, 0x3f, 0x0b, 0x4f, 0xf9, 0xd7,
+	0x74, 0xff, 0x34, 0xcf, 0x50, 0x8f, 0xff, 0x1e, 0x00, 0x6f, 0x57, 0x7f, 0x71, 0x6a, 0x0c, 0x00,
+	0x00,
 }
@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.23.4
+// source: editor/v1/preview.proto
+
+package editorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PreviewStage int32
+
+const (
+	PreviewStage_PREVIEW_STAGE_UNSPECIFIED PreviewStage = 0
+	PreviewStage_PREVIEW_ROUND_START       PreviewStage = 1
+	PreviewStage_PREVIEW_GRID              PreviewStage = 2
+	PreviewStage_PREVIEW_TRANSFER          PreviewStage = 3
+	PreviewStage_PREVIEW_QUESTION          PreviewStage = 4
+	PreviewStage_PREVIEW_ANSWER            PreviewStage = 5
+	PreviewStage_PREVIEW_FINISHED          PreviewStage = 6
+)
+
+// Enum value maps for PreviewStage.
+var (
+	PreviewStage_name = map[int32]string{
+		0: "PREVIEW_STAGE_UNSPECIFIED",
+		1: "PREVIEW_ROUND_START",
+		2: "PREVIEW_GRID",
+		3: "PREVIEW_TRANSFER",
+		4: "PREVIEW_QUESTION",
+		5: "PREVIEW_ANSWER",
+		6: "PREVIEW_FINISHED",
+	}
+	PreviewStage_value = map[string]int32{
+		"PREVIEW_STAGE_UNSPECIFIED": 0,
+		"PREVIEW_ROUND_START":       1,
+		"PREVIEW_GRID":              2,
+		"PREVIEW_TRANSFER":          3,
+		"PREVIEW_QUESTION":          4,
+		"PREVIEW_ANSWER":            5,
+		"PREVIEW_FINISHED":          6,
+	}
+)
+
+func (x PreviewStage) Enum() *PreviewStage {
+	p := new(PreviewStage)
+	*p = x
+	return p
+}
+
+func (x PreviewStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreviewStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_editor_v1_preview_proto_enumTypes[0].Descriptor()
+}
+
+func (PreviewStage) Type() protoreflect.EnumType {
+	return &file_editor_v1_preview_proto_enumTypes[0]
+}
+
+func (x PreviewStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreviewStage.Descriptor instead.
+func (PreviewStage) EnumDescriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{0}
+}
+
+type PreviewGridQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundQuestionId int32  `protobuf:"varint,1,opt,name=round_question_id,json=roundQuestionId,proto3" json:"round_question_id,omitempty"`
+	TopicId         int32  `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	TopicTitle      string `protobuf:"bytes,3,opt,name=topic_title,json=topicTitle,proto3" json:"topic_title,omitempty"`
+	QuestionCost    int32  `protobuf:"varint,4,opt,name=question_cost,json=questionCost,proto3" json:"question_cost,omitempty"`
+	IsPlayed        bool   `protobuf:"varint,5,opt,name=is_played,json=isPlayed,proto3" json:"is_played,omitempty"`
+}
+
+func (x *PreviewGridQuestion) Reset() {
+	*x = PreviewGridQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewGridQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewGridQuestion) ProtoMessage() {}
+
+func (x *PreviewGridQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewGridQuestion.ProtoReflect.Descriptor instead.
+func (*PreviewGridQuestion) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewGridQuestion) GetRoundQuestionId() int32 {
+	if x != nil {
+		return x.RoundQuestionId
+	}
+	return 0
+}
+
+func (x *PreviewGridQuestion) GetTopicId() int32 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *PreviewGridQuestion) GetTopicTitle() string {
+	if x != nil {
+		return x.TopicTitle
+	}
+	return ""
+}
+
+func (x *PreviewGridQuestion) GetQuestionCost() int32 {
+	if x != nil {
+		return x.QuestionCost
+	}
+	return 0
+}
+
+func (x *PreviewGridQuestion) GetIsPlayed() bool {
+	if x != nil {
+		return x.IsPlayed
+	}
+	return false
+}
+
+type Preview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackId int32        `protobuf:"varint,1,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+	Stage  PreviewStage `protobuf:"varint,2,opt,name=stage,proto3,enum=editor.v1.PreviewStage" json:"stage,omitempty"`
+	// Empty if preview is finished.
+	Round      *Round `protobuf:"bytes,3,opt,name=round,proto3" json:"round,omitempty"`
+	RoundCount int32  `protobuf:"varint,4,opt,name=round_count,json=roundCount,proto3" json:"round_count,omitempty"`
+	// Questions of the round ordered by topic and question positions.
+	Grid []*PreviewGridQuestion `protobuf:"bytes,5,rep,name=grid,proto3" json:"grid,omitempty"`
+	// Selected question, answer and host comment are set only on answer stage.
+	Question *RoundQuestion `protobuf:"bytes,6,opt,name=question,proto3" json:"question,omitempty"`
+}
+
+func (x *Preview) Reset() {
+	*x = Preview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preview) ProtoMessage() {}
+
+func (x *Preview) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preview.ProtoReflect.Descriptor instead.
+func (*Preview) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Preview) GetPackId() int32 {
+	if x != nil {
+		return x.PackId
+	}
+	return 0
+}
+
+func (x *Preview) GetStage() PreviewStage {
+	if x != nil {
+		return x.Stage
+	}
+	return PreviewStage_PREVIEW_STAGE_UNSPECIFIED
+}
+
+func (x *Preview) GetRound() *Round {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+func (x *Preview) GetRoundCount() int32 {
+	if x != nil {
+		return x.RoundCount
+	}
+	return 0
+}
+
+func (x *Preview) GetGrid() []*PreviewGridQuestion {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *Preview) GetQuestion() *RoundQuestion {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type StartPreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackId int32 `protobuf:"varint,1,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"` // required
+}
+
+func (x *StartPreviewRequest) Reset() {
+	*x = StartPreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPreviewRequest) ProtoMessage() {}
+
+func (x *StartPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPreviewRequest.ProtoReflect.Descriptor instead.
+func (*StartPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartPreviewRequest) GetPackId() int32 {
+	if x != nil {
+		return x.PackId
+	}
+	return 0
+}
+
+type StartPreviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preview *Preview `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *StartPreviewResponse) Reset() {
+	*x = StartPreviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPreviewResponse) ProtoMessage() {}
+
+func (x *StartPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPreviewResponse.ProtoReflect.Descriptor instead.
+func (*StartPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartPreviewResponse) GetPreview() *Preview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+type GetPreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPreviewRequest) Reset() {
+	*x = GetPreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreviewRequest) ProtoMessage() {}
+
+func (x *GetPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{4}
+}
+
+type GetPreviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preview *Preview `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *GetPreviewResponse) Reset() {
+	*x = GetPreviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreviewResponse) ProtoMessage() {}
+
+func (x *GetPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreviewResponse.ProtoReflect.Descriptor instead.
+func (*GetPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPreviewResponse) GetPreview() *Preview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+type NextPreviewStageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NextPreviewStageRequest) Reset() {
+	*x = NextPreviewStageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextPreviewStageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextPreviewStageRequest) ProtoMessage() {}
+
+func (x *NextPreviewStageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextPreviewStageRequest.ProtoReflect.Descriptor instead.
+func (*NextPreviewStageRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{6}
+}
+
+type NextPreviewStageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preview *Preview `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *NextPreviewStageResponse) Reset() {
+	*x = NextPreviewStageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextPreviewStageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextPreviewStageResponse) ProtoMessage() {}
+
+func (x *NextPreviewStageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextPreviewStageResponse.ProtoReflect.Descriptor instead.
+func (*NextPreviewStageResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NextPreviewStageResponse) GetPreview() *Preview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+type SelectPreviewQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundQuestionId int32 `protobuf:"varint,1,opt,name=round_question_id,json=roundQuestionId,proto3" json:"round_question_id,omitempty"` // required
+}
+
+func (x *SelectPreviewQuestionRequest) Reset() {
+	*x = SelectPreviewQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectPreviewQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectPreviewQuestionRequest) ProtoMessage() {}
+
+func (x *SelectPreviewQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectPreviewQuestionRequest.ProtoReflect.Descriptor instead.
+func (*SelectPreviewQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SelectPreviewQuestionRequest) GetRoundQuestionId() int32 {
+	if x != nil {
+		return x.RoundQuestionId
+	}
+	return 0
+}
+
+type SelectPreviewQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preview *Preview `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *SelectPreviewQuestionResponse) Reset() {
+	*x = SelectPreviewQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectPreviewQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectPreviewQuestionResponse) ProtoMessage() {}
+
+func (x *SelectPreviewQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectPreviewQuestionResponse.ProtoReflect.Descriptor instead.
+func (*SelectPreviewQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SelectPreviewQuestionResponse) GetPreview() *Preview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+type StopPreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopPreviewRequest) Reset() {
+	*x = StopPreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_preview_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPreviewRequest) ProtoMessage() {}
+
+func (x *StopPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_preview_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPreviewRequest.ProtoReflect.Descriptor instead.
+func (*StopPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_preview_proto_rawDescGZIP(), []int{10}
+}
+
+var File_editor_v1_preview_proto protoreflect.FileDescriptor
+
+var file_editor_v1_preview_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x1a, 0x15, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x69, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x07, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x26,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x69, 0x64, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2e, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x49,
+	0x64, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0x19, 0x0a, 0x17, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x18, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x4a, 0x0a, 0x1c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x4d, 0x0a, 0x1d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2a, 0xae, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x45, 0x56, 0x49,
+	0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45,
+	0x57, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x47, 0x52, 0x49, 0x44, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x45, 0x56, 0x49,
+	0x45, 0x57, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52, 0x10,
+	0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x46, 0x49, 0x4e,
+	0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x06, 0x32, 0xbb, 0x03, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e, 0x2e, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1c, 0x2e, 0x65, 0x64, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x15, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1d,
+	0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x14, 0x5a, 0x12, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f,
+	0x76, 0x31, 0x3b, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_editor_v1_preview_proto_rawDescOnce sync.Once
+	file_editor_v1_preview_proto_rawDescData = file_editor_v1_preview_proto_rawDesc
+)
+
+func file_editor_v1_preview_proto_rawDescGZIP() []byte {
+	file_editor_v1_preview_proto_rawDescOnce.Do(func() {
+		file_editor_v1_preview_proto_rawDescData = protoimpl.X.CompressGZIP(file_editor_v1_preview_proto_rawDescData)
+	})
+	return file_editor_v1_preview_proto_rawDescData
+}
+
+var file_editor_v1_preview_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_editor_v1_preview_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_editor_v1_preview_proto_goTypes = []interface{}{
+	(PreviewStage)(0),                     // 0: editor.v1.PreviewStage
+	(*PreviewGridQuestion)(nil),           // 1: editor.v1.PreviewGridQuestion
+	(*Preview)(nil),                       // 2: editor.v1.Preview
+	(*StartPreviewRequest)(nil),           // 3: editor.v1.StartPreviewRequest
+	(*StartPreviewResponse)(nil),          // 4: editor.v1.StartPreviewResponse
+	(*GetPreviewRequest)(nil),             // 5: editor.v1.GetPreviewRequest
+	(*GetPreviewResponse)(nil),            // 6: editor.v1.GetPreviewResponse
+	(*NextPreviewStageRequest)(nil),       // 7: editor.v1.NextPreviewStageRequest
+	(*NextPreviewStageResponse)(nil),      // 8: editor.v1.NextPreviewStageResponse
+	(*SelectPreviewQuestionRequest)(nil),  // 9: editor.v1.SelectPreviewQuestionRequest
+	(*SelectPreviewQuestionResponse)(nil), // 10: editor.v1.SelectPreviewQuestionResponse
+	(*StopPreviewRequest)(nil),            // 11: editor.v1.StopPreviewRequest
+	(*Round)(nil),                         // 12: editor.v1.Round
+	(*RoundQuestion)(nil),                 // 13: editor.v1.RoundQuestion
+	(*emptypb.Empty)(nil),                 // 14: google.protobuf.Empty
+}
+var file_editor_v1_preview_proto_depIdxs = []int32{
+	0,  // 0: editor.v1.Preview.stage:type_name -> editor.v1.PreviewStage
+	12, // 1: editor.v1.Preview.round:type_name -> editor.v1.Round
+	1,  // 2: editor.v1.Preview.grid:type_name -> editor.v1.PreviewGridQuestion
+	13, // 3: editor.v1.Preview.question:type_name -> editor.v1.RoundQuestion
+	2,  // 4: editor.v1.StartPreviewResponse.preview:type_name -> editor.v1.Preview
+	2,  // 5: editor.v1.GetPreviewResponse.preview:type_name -> editor.v1.Preview
+	2,  // 6: editor.v1.NextPreviewStageResponse.preview:type_name -> editor.v1.Preview
+	2,  // 7: editor.v1.SelectPreviewQuestionResponse.preview:type_name -> editor.v1.Preview
+	3,  // 8: editor.v1.PreviewService.StartPreview:input_type -> editor.v1.StartPreviewRequest
+	5,  // 9: editor.v1.PreviewService.GetPreview:input_type -> editor.v1.GetPreviewRequest
+	7,  // 10: editor.v1.PreviewService.NextPreviewStage:input_type -> editor.v1.NextPreviewStageRequest
+	9,  // 11: editor.v1.PreviewService.SelectPreviewQuestion:input_type -> editor.v1.SelectPreviewQuestionRequest
+	11, // 12: editor.v1.PreviewService.StopPreview:input_type -> editor.v1.StopPreviewRequest
+	4,  // 13: editor.v1.PreviewService.StartPreview:output_type -> editor.v1.StartPreviewResponse
+	6,  // 14: editor.v1.PreviewService.GetPreview:output_type -> editor.v1.GetPreviewResponse
+	8,  // 15: editor.v1.PreviewService.NextPreviewStage:output_type -> editor.v1.NextPreviewStageResponse
+	10, // 16: editor.v1.PreviewService.SelectPreviewQuestion:output_type -> editor.v1.SelectPreviewQuestionResponse
+	14, // 17: editor.v1.PreviewService.StopPreview:output_type -> google.protobuf.Empty
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_editor_v1_preview_proto_init() }
+func file_editor_v1_preview_proto_init() {
+	if File_editor_v1_preview_proto != nil {
+		return
+	}
+	file_editor_v1_round_proto_init()
+	file_editor_v1_round_question_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_editor_v1_preview_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewGridQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartPreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextPreviewStageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextPreviewStageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectPreviewQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectPreviewQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_preview_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_editor_v1_preview_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_editor_v1_preview_proto_goTypes,
+		DependencyIndexes: file_editor_v1_preview_proto_depIdxs,
+		EnumInfos:         file_editor_v1_preview_proto_enumTypes,
+		MessageInfos:      file_editor_v1_preview_proto_msgTypes,
+	}.Build()
+	File_editor_v1_preview_proto = out.File
+	file_editor_v1_preview_proto_rawDesc = nil
+	file_editor_v1_preview_proto_goTypes = nil
+	file_editor_v1_preview_proto_depIdxs = nil
+}
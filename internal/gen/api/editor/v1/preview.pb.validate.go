@@ -0,0 +1,1384 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: editor/v1/preview.proto
+
+package editorv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PreviewGridQuestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewGridQuestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewGridQuestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewGridQuestionMultiError, or nil if none found.
+func (m *PreviewGridQuestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewGridQuestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoundQuestionId
+
+	// no validation rules for TopicId
+
+	// no validation rules for TopicTitle
+
+	// no validation rules for QuestionCost
+
+	// no validation rules for IsPlayed
+
+	if len(errors) > 0 {
+		return PreviewGridQuestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewGridQuestionMultiError is an error wrapping multiple validation
+// errors returned by PreviewGridQuestion.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewGridQuestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewGridQuestionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewGridQuestionMultiError) AllErrors() []error { return m }
+
+// PreviewGridQuestionValidationError is the validation error returned by
+// PreviewGridQuestion.Validate if the designated constraints aren't met.
+type PreviewGridQuestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewGridQuestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewGridQuestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewGridQuestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewGridQuestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewGridQuestionValidationError) ErrorName() string {
+	return "PreviewGridQuestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewGridQuestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewGridQuestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewGridQuestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewGridQuestionValidationError{}
+
+// Validate checks the field values on Preview with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Preview) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Preview with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PreviewMultiError, or nil if none found.
+func (m *Preview) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Preview) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	// no validation rules for Stage
+
+	if all {
+		switch v := interface{}(m.GetRound()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewValidationError{
+					field:  "Round",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewValidationError{
+					field:  "Round",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRound()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewValidationError{
+				field:  "Round",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RoundCount
+
+	for idx, item := range m.GetGrid() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewValidationError{
+						field:  fmt.Sprintf("Grid[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewValidationError{
+						field:  fmt.Sprintf("Grid[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewValidationError{
+					field:  fmt.Sprintf("Grid[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuestion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuestion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewValidationError{
+				field:  "Question",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewMultiError is an error wrapping multiple validation errors returned
+// by Preview.ValidateAll() if the designated constraints aren't met.
+type PreviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewMultiError) AllErrors() []error { return m }
+
+// PreviewValidationError is the validation error returned by Preview.Validate
+// if the designated constraints aren't met.
+type PreviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewValidationError) ErrorName() string { return "PreviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewValidationError{}
+
+// Validate checks the field values on StartPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPreviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPreviewRequestMultiError, or nil if none found.
+func (m *StartPreviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPreviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	if len(errors) > 0 {
+		return StartPreviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPreviewRequestMultiError is an error wrapping multiple validation
+// errors returned by StartPreviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartPreviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPreviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPreviewRequestMultiError) AllErrors() []error { return m }
+
+// StartPreviewRequestValidationError is the validation error returned by
+// StartPreviewRequest.Validate if the designated constraints aren't met.
+type StartPreviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPreviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPreviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPreviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPreviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPreviewRequestValidationError) ErrorName() string {
+	return "StartPreviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPreviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPreviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPreviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPreviewRequestValidationError{}
+
+// Validate checks the field values on StartPreviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartPreviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartPreviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartPreviewResponseMultiError, or nil if none found.
+func (m *StartPreviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartPreviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartPreviewResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartPreviewResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartPreviewResponseValidationError{
+				field:  "Preview",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartPreviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartPreviewResponseMultiError is an error wrapping multiple validation
+// errors returned by StartPreviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartPreviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartPreviewResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartPreviewResponseMultiError) AllErrors() []error { return m }
+
+// StartPreviewResponseValidationError is the validation error returned by
+// StartPreviewResponse.Validate if the designated constraints aren't met.
+type StartPreviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartPreviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartPreviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartPreviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartPreviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartPreviewResponseValidationError) ErrorName() string {
+	return "StartPreviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartPreviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartPreviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartPreviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartPreviewResponseValidationError{}
+
+// Validate checks the field values on GetPreviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPreviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreviewRequestMultiError, or nil if none found.
+func (m *GetPreviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPreviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPreviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPreviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreviewRequestMultiError) AllErrors() []error { return m }
+
+// GetPreviewRequestValidationError is the validation error returned by
+// GetPreviewRequest.Validate if the designated constraints aren't met.
+type GetPreviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreviewRequestValidationError) ErrorName() string {
+	return "GetPreviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreviewRequestValidationError{}
+
+// Validate checks the field values on GetPreviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreviewResponseMultiError, or nil if none found.
+func (m *GetPreviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPreviewResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPreviewResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPreviewResponseValidationError{
+				field:  "Preview",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPreviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreviewResponseMultiError is an error wrapping multiple validation errors
+// returned by GetPreviewResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetPreviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreviewResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreviewResponseMultiError) AllErrors() []error { return m }
+
+// GetPreviewResponseValidationError is the validation error returned by
+// GetPreviewResponse.Validate if the designated constraints aren't met.
+type GetPreviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreviewResponseValidationError) ErrorName() string {
+	return "GetPreviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreviewResponseValidationError{}
+
+// Validate checks the field values on NextPreviewStageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NextPreviewStageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NextPreviewStageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NextPreviewStageRequestMultiError, or nil if none found.
+func (m *NextPreviewStageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NextPreviewStageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return NextPreviewStageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// NextPreviewStageRequestMultiError is an error wrapping multiple validation
+// errors returned by NextPreviewStageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type NextPreviewStageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NextPreviewStageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NextPreviewStageRequestMultiError) AllErrors() []error { return m }
+
+// NextPreviewStageRequestValidationError is the validation error returned by
+// NextPreviewStageRequest.Validate if the designated constraints aren't met.
+type NextPreviewStageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NextPreviewStageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NextPreviewStageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NextPreviewStageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NextPreviewStageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NextPreviewStageRequestValidationError) ErrorName() string {
+	return "NextPreviewStageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NextPreviewStageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNextPreviewStageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NextPreviewStageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NextPreviewStageRequestValidationError{}
+
+// Validate checks the field values on NextPreviewStageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NextPreviewStageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NextPreviewStageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NextPreviewStageResponseMultiError, or nil if none found.
+func (m *NextPreviewStageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NextPreviewStageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NextPreviewStageResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NextPreviewStageResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NextPreviewStageResponseValidationError{
+				field:  "Preview",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NextPreviewStageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// NextPreviewStageResponseMultiError is an error wrapping multiple validation
+// errors returned by NextPreviewStageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type NextPreviewStageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NextPreviewStageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NextPreviewStageResponseMultiError) AllErrors() []error { return m }
+
+// NextPreviewStageResponseValidationError is the validation error returned by
+// NextPreviewStageResponse.Validate if the designated constraints aren't met.
+type NextPreviewStageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NextPreviewStageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NextPreviewStageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NextPreviewStageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NextPreviewStageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NextPreviewStageResponseValidationError) ErrorName() string {
+	return "NextPreviewStageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NextPreviewStageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNextPreviewStageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NextPreviewStageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NextPreviewStageResponseValidationError{}
+
+// Validate checks the field values on SelectPreviewQuestionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SelectPreviewQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelectPreviewQuestionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SelectPreviewQuestionRequestMultiError, or nil if none found.
+func (m *SelectPreviewQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelectPreviewQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoundQuestionId
+
+	if len(errors) > 0 {
+		return SelectPreviewQuestionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelectPreviewQuestionRequestMultiError is an error wrapping multiple
+// validation errors returned by SelectPreviewQuestionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SelectPreviewQuestionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelectPreviewQuestionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelectPreviewQuestionRequestMultiError) AllErrors() []error { return m }
+
+// SelectPreviewQuestionRequestValidationError is the validation error returned
+// by SelectPreviewQuestionRequest.Validate if the designated constraints
+// aren't met.
+type SelectPreviewQuestionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelectPreviewQuestionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelectPreviewQuestionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelectPreviewQuestionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelectPreviewQuestionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelectPreviewQuestionRequestValidationError) ErrorName() string {
+	return "SelectPreviewQuestionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SelectPreviewQuestionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelectPreviewQuestionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelectPreviewQuestionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelectPreviewQuestionRequestValidationError{}
+
+// Validate checks the field values on SelectPreviewQuestionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SelectPreviewQuestionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelectPreviewQuestionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SelectPreviewQuestionResponseMultiError, or nil if none found.
+func (m *SelectPreviewQuestionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelectPreviewQuestionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SelectPreviewQuestionResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SelectPreviewQuestionResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SelectPreviewQuestionResponseValidationError{
+				field:  "Preview",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SelectPreviewQuestionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelectPreviewQuestionResponseMultiError is an error wrapping multiple
+// validation errors returned by SelectPreviewQuestionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SelectPreviewQuestionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelectPreviewQuestionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelectPreviewQuestionResponseMultiError) AllErrors() []error { return m }
+
+// SelectPreviewQuestionResponseValidationError is the validation error
+// returned by SelectPreviewQuestionResponse.Validate if the designated
+// constraints aren't met.
+type SelectPreviewQuestionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelectPreviewQuestionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelectPreviewQuestionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelectPreviewQuestionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelectPreviewQuestionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelectPreviewQuestionResponseValidationError) ErrorName() string {
+	return "SelectPreviewQuestionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SelectPreviewQuestionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelectPreviewQuestionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelectPreviewQuestionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelectPreviewQuestionResponseValidationError{}
+
+// Validate checks the field values on StopPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StopPreviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StopPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StopPreviewRequestMultiError, or nil if none found.
+func (m *StopPreviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StopPreviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StopPreviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StopPreviewRequestMultiError is an error wrapping multiple validation errors
+// returned by StopPreviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StopPreviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StopPreviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StopPreviewRequestMultiError) AllErrors() []error { return m }
+
+// StopPreviewRequestValidationError is the validation error returned by
+// StopPreviewRequest.Validate if the designated constraints aren't met.
+type StopPreviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StopPreviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StopPreviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StopPreviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StopPreviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StopPreviewRequestValidationError) ErrorName() string {
+	return "StopPreviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StopPreviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStopPreviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StopPreviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StopPreviewRequestValidationError{}
@@ -0,0 +1,1690 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: editor/v1/preview.proto
+
+package editorv1
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+import google_protobuf3 "google.golang.org/protobuf/types/known/emptypb"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// ========================
+// PreviewService Interface
+// ========================
+
+// PreviewService walks pack through the same sequence as a real game for its author,
+// single player and without timers. Player has at most one preview at a time.
+type PreviewService interface {
+	// StartPreview starts preview of the pack from the first round, previous preview is replaced.
+	StartPreview(context.Context, *StartPreviewRequest) (*StartPreviewResponse, error)
+
+	// GetPreview returns current preview.
+	GetPreview(context.Context, *GetPreviewRequest) (*GetPreviewResponse, error)
+
+	// NextPreviewStage moves preview to the next stage:
+	// round start -> grid -> (transfer prompt for secret questions) -> question -> answer -> grid or next round.
+	// Question must be selected with SelectPreviewQuestion on grid stage.
+	NextPreviewStage(context.Context, *NextPreviewStageRequest) (*NextPreviewStageResponse, error)
+
+	// SelectPreviewQuestion selects not played question from the grid.
+	SelectPreviewQuestion(context.Context, *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error)
+
+	// StopPreview stops current preview.
+	StopPreview(context.Context, *StopPreviewRequest) (*google_protobuf3.Empty, error)
+}
+
+// ==============================
+// PreviewService Protobuf Client
+// ==============================
+
+type previewServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewPreviewServiceProtobufClient creates a Protobuf client that implements the PreviewService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewPreviewServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PreviewService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "editor.v1", "PreviewService")
+	urls := [5]string{
+		serviceURL + "StartPreview",
+		serviceURL + "GetPreview",
+		serviceURL + "NextPreviewStage",
+		serviceURL + "SelectPreviewQuestion",
+		serviceURL + "StopPreview",
+	}
+
+	return &previewServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *previewServiceProtobufClient) StartPreview(ctx context.Context, in *StartPreviewRequest) (*StartPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPreview")
+	caller := c.callStartPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StartPreviewRequest) (*StartPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartPreviewRequest) when calling interceptor")
+					}
+					return c.callStartPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceProtobufClient) callStartPreview(ctx context.Context, in *StartPreviewRequest) (*StartPreviewResponse, error) {
+	out := new(StartPreviewResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceProtobufClient) GetPreview(ctx context.Context, in *GetPreviewRequest) (*GetPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreview")
+	caller := c.callGetPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPreviewRequest) (*GetPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreviewRequest) when calling interceptor")
+					}
+					return c.callGetPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceProtobufClient) callGetPreview(ctx context.Context, in *GetPreviewRequest) (*GetPreviewResponse, error) {
+	out := new(GetPreviewResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceProtobufClient) NextPreviewStage(ctx context.Context, in *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "NextPreviewStage")
+	caller := c.callNextPreviewStage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NextPreviewStageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NextPreviewStageRequest) when calling interceptor")
+					}
+					return c.callNextPreviewStage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NextPreviewStageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NextPreviewStageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceProtobufClient) callNextPreviewStage(ctx context.Context, in *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+	out := new(NextPreviewStageResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceProtobufClient) SelectPreviewQuestion(ctx context.Context, in *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "SelectPreviewQuestion")
+	caller := c.callSelectPreviewQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SelectPreviewQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SelectPreviewQuestionRequest) when calling interceptor")
+					}
+					return c.callSelectPreviewQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SelectPreviewQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SelectPreviewQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceProtobufClient) callSelectPreviewQuestion(ctx context.Context, in *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+	out := new(SelectPreviewQuestionResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceProtobufClient) StopPreview(ctx context.Context, in *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "StopPreview")
+	caller := c.callStopPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StopPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StopPreviewRequest) when calling interceptor")
+					}
+					return c.callStopPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceProtobufClient) callStopPreview(ctx context.Context, in *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// PreviewService JSON Client
+// ==========================
+
+type previewServiceJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewPreviewServiceJSONClient creates a JSON client that implements the PreviewService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewPreviewServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) PreviewService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "editor.v1", "PreviewService")
+	urls := [5]string{
+		serviceURL + "StartPreview",
+		serviceURL + "GetPreview",
+		serviceURL + "NextPreviewStage",
+		serviceURL + "SelectPreviewQuestion",
+		serviceURL + "StopPreview",
+	}
+
+	return &previewServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *previewServiceJSONClient) StartPreview(ctx context.Context, in *StartPreviewRequest) (*StartPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "StartPreview")
+	caller := c.callStartPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StartPreviewRequest) (*StartPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartPreviewRequest) when calling interceptor")
+					}
+					return c.callStartPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceJSONClient) callStartPreview(ctx context.Context, in *StartPreviewRequest) (*StartPreviewResponse, error) {
+	out := new(StartPreviewResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceJSONClient) GetPreview(ctx context.Context, in *GetPreviewRequest) (*GetPreviewResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreview")
+	caller := c.callGetPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetPreviewRequest) (*GetPreviewResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreviewRequest) when calling interceptor")
+					}
+					return c.callGetPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceJSONClient) callGetPreview(ctx context.Context, in *GetPreviewRequest) (*GetPreviewResponse, error) {
+	out := new(GetPreviewResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceJSONClient) NextPreviewStage(ctx context.Context, in *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "NextPreviewStage")
+	caller := c.callNextPreviewStage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NextPreviewStageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NextPreviewStageRequest) when calling interceptor")
+					}
+					return c.callNextPreviewStage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NextPreviewStageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NextPreviewStageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceJSONClient) callNextPreviewStage(ctx context.Context, in *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+	out := new(NextPreviewStageResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceJSONClient) SelectPreviewQuestion(ctx context.Context, in *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "SelectPreviewQuestion")
+	caller := c.callSelectPreviewQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SelectPreviewQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SelectPreviewQuestionRequest) when calling interceptor")
+					}
+					return c.callSelectPreviewQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SelectPreviewQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SelectPreviewQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceJSONClient) callSelectPreviewQuestion(ctx context.Context, in *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+	out := new(SelectPreviewQuestionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *previewServiceJSONClient) StopPreview(ctx context.Context, in *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithMethodName(ctx, "StopPreview")
+	caller := c.callStopPreview
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StopPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StopPreviewRequest) when calling interceptor")
+					}
+					return c.callStopPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *previewServiceJSONClient) callStopPreview(ctx context.Context, in *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =============================
+// PreviewService Server Handler
+// =============================
+
+type previewServiceServer struct {
+	PreviewService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewPreviewServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewPreviewServiceServer(svc PreviewService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &previewServiceServer{
+		PreviewService:   svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *previewServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *previewServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// PreviewServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const PreviewServicePathPrefix = "/twirp/editor.v1.PreviewService/"
+
+func (s *previewServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PreviewService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "editor.v1.PreviewService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "StartPreview":
+		s.serveStartPreview(ctx, resp, req)
+		return
+	case "GetPreview":
+		s.serveGetPreview(ctx, resp, req)
+		return
+	case "NextPreviewStage":
+		s.serveNextPreviewStage(ctx, resp, req)
+		return
+	case "SelectPreviewQuestion":
+		s.serveSelectPreviewQuestion(ctx, resp, req)
+		return
+	case "StopPreview":
+		s.serveStopPreview(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *previewServiceServer) serveStartPreview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStartPreviewJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStartPreviewProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *previewServiceServer) serveStartPreviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(StartPreviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PreviewService.StartPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StartPreviewRequest) (*StartPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartPreviewRequest) when calling interceptor")
+					}
+					return s.PreviewService.StartPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StartPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartPreviewResponse and nil error while calling StartPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveStartPreviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StartPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(StartPreviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PreviewService.StartPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StartPreviewRequest) (*StartPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StartPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StartPreviewRequest) when calling interceptor")
+					}
+					return s.PreviewService.StartPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*StartPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*StartPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *StartPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *StartPreviewResponse and nil error while calling StartPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveGetPreview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetPreviewJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetPreviewProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *previewServiceServer) serveGetPreviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetPreviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PreviewService.GetPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPreviewRequest) (*GetPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreviewRequest) when calling interceptor")
+					}
+					return s.PreviewService.GetPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPreviewResponse and nil error while calling GetPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveGetPreviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetPreviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PreviewService.GetPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetPreviewRequest) (*GetPreviewResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetPreviewRequest) when calling interceptor")
+					}
+					return s.PreviewService.GetPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetPreviewResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetPreviewResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetPreviewResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetPreviewResponse and nil error while calling GetPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveNextPreviewStage(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveNextPreviewStageJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveNextPreviewStageProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *previewServiceServer) serveNextPreviewStageJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NextPreviewStage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(NextPreviewStageRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PreviewService.NextPreviewStage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NextPreviewStageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NextPreviewStageRequest) when calling interceptor")
+					}
+					return s.PreviewService.NextPreviewStage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NextPreviewStageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NextPreviewStageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NextPreviewStageResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NextPreviewStageResponse and nil error while calling NextPreviewStage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveNextPreviewStageProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "NextPreviewStage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(NextPreviewStageRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PreviewService.NextPreviewStage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NextPreviewStageRequest) (*NextPreviewStageResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NextPreviewStageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NextPreviewStageRequest) when calling interceptor")
+					}
+					return s.PreviewService.NextPreviewStage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NextPreviewStageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NextPreviewStageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NextPreviewStageResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NextPreviewStageResponse and nil error while calling NextPreviewStage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveSelectPreviewQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSelectPreviewQuestionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSelectPreviewQuestionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *previewServiceServer) serveSelectPreviewQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SelectPreviewQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SelectPreviewQuestionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PreviewService.SelectPreviewQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SelectPreviewQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SelectPreviewQuestionRequest) when calling interceptor")
+					}
+					return s.PreviewService.SelectPreviewQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SelectPreviewQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SelectPreviewQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SelectPreviewQuestionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SelectPreviewQuestionResponse and nil error while calling SelectPreviewQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveSelectPreviewQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SelectPreviewQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SelectPreviewQuestionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PreviewService.SelectPreviewQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SelectPreviewQuestionRequest) (*SelectPreviewQuestionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SelectPreviewQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SelectPreviewQuestionRequest) when calling interceptor")
+					}
+					return s.PreviewService.SelectPreviewQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SelectPreviewQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SelectPreviewQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SelectPreviewQuestionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SelectPreviewQuestionResponse and nil error while calling SelectPreviewQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveStopPreview(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStopPreviewJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStopPreviewProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *previewServiceServer) serveStopPreviewJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StopPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(StopPreviewRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PreviewService.StopPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StopPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StopPreviewRequest) when calling interceptor")
+					}
+					return s.PreviewService.StopPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling StopPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) serveStopPreviewProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "StopPreview")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(StopPreviewRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PreviewService.StopPreview
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *StopPreviewRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*StopPreviewRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*StopPreviewRequest) when calling interceptor")
+					}
+					return s.PreviewService.StopPreview(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling StopPreview. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *previewServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor2, 0
+}
+
+func (s *previewServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *previewServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "editor.v1", "PreviewService")
+}
+
+var twirpFileDescriptor2 = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5d, 0x73, 0xd2, 0x4c,
+	0x18, 0x7d, 0x53, 0xca, 0x47, 0x1f, 0x78, 0x6b, 0xfa, 0x40, 0x25, 0x4d, 0x4b, 0xdb, 0x49, 0x67,
+	0x94, 0xe9, 0x68, 0x98, 0xa2, 0x77, 0x5e, 0xb5, 0x25, 0xa5, 0x71, 0xc6, 0x94, 0x6e, 0xa8, 0x9d,
+	0xd1, 0x0b, 0x86, 0x92, 0x95, 0x89, 0x22, 0x89, 0xc9, 0x82, 0xf6, 0xde, 0xdf, 0xe3, 0x1f, 0xf0,
+	0xc2, 0xbf, 0xe6, 0x64, 0x93, 0x40, 0x80, 0x52, 0xc7, 0x7a, 0xc7, 0x9e, 0x73, 0xf6, 0xf9, 0x38,
+	0x9c, 0xcc, 0x42, 0x99, 0x5a, 0x36, 0x73, 0xbc, 0xda, 0xf8, 0xa8, 0xe6, 0x7a, 0x74, 0x6c, 0xd3,
+	0xaf, 0xaa, 0xeb, 0x39, 0xcc, 0xc1, 0xb5, 0x90, 0x50, 0xc7, 0x47, 0xf2, 0xe6, 0x54, 0xe3, 0x39,
+	0xa3, 0xa1, 0x15, 0x2a, 0xe4, 0xdd, 0x39, 0xb8, 0xf3, 0x65, 0x44, 0x7d, 0x66, 0x3b, 0xc3, 0x88,
+	0xdf, 0xee, 0x3b, 0x4e, 0x7f, 0x40, 0x6b, 0xfc, 0x74, 0x33, 0xfa, 0x50, 0xa3, 0x9f, 0x5d, 0x76,
+	0x1b, 0x92, 0xca, 0x2f, 0x01, 0x8a, 0xad, 0xb0, 0x61, 0xd3, 0xb3, 0xad, 0xcb, 0xe8, 0x2a, 0x1e,
+	0xc2, 0xc6, 0x6c, 0xb1, 0x8e, 0x6d, 0x49, 0xc2, 0xbe, 0x50, 0x4d, 0x93, 0x47, 0x9c, 0x88, 0x95,
+	0xba, 0x85, 0x5b, 0x90, 0x63, 0x8e, 0x6b, 0xf7, 0x02, 0xc9, 0x0a, 0x97, 0x64, 0xf9, 0x59, 0xb7,
+	0x70, 0x0f, 0xf2, 0x21, 0xc5, 0x6c, 0x36, 0xa0, 0x52, 0x6a, 0x5f, 0xa8, 0xae, 0x11, 0xe0, 0x50,
+	0x3b, 0x40, 0xf0, 0x00, 0xfe, 0x9f, 0x74, 0xe8, 0x39, 0x3e, 0x93, 0x56, 0x79, 0x81, 0x42, 0x0c,
+	0x9e, 0x3a, 0x3e, 0xc3, 0x6d, 0x58, 0xb3, 0xfd, 0x8e, 0x3b, 0xe8, 0xde, 0x52, 0x4b, 0x4a, 0xef,
+	0x0b, 0xd5, 0x1c, 0xc9, 0xd9, 0x7e, 0x8b, 0x9f, 0x95, 0xef, 0x2b, 0x90, 0x8d, 0x36, 0xc0, 0x32,
+	0x64, 0xdd, 0x6e, 0xef, 0xd3, 0x74, 0xd6, 0x4c, 0x70, 0xd4, 0x2d, 0x7c, 0x0e, 0x69, 0x9f, 0x75,
+	0xfb, 0x94, 0xcf, 0xb7, 0x5e, 0x2f, 0xab, 0x13, 0x57, 0xd5, 0xe8, 0xae, 0x19, 0xd0, 0x24, 0x54,
+	0xe1, 0x13, 0x48, 0xf3, 0x25, 0xf9, 0xc0, 0xf9, 0xba, 0x98, 0x90, 0x93, 0x00, 0x27, 0x21, 0x1d,
+	0xac, 0x17, 0xba, 0xd4, 0x73, 0x46, 0xc3, 0x78, 0x76, 0xe0, 0xd0, 0x69, 0x80, 0x60, 0x1d, 0x56,
+	0xfb, 0x9e, 0x1d, 0x0c, 0x9d, 0xaa, 0xe6, 0xeb, 0xbb, 0x8b, 0x6d, 0x93, 0xa6, 0x13, 0xae, 0xc5,
+	0x97, 0x90, 0x8b, 0xb7, 0x97, 0x32, 0xbc, 0xbf, 0x34, 0xdf, 0x7f, 0x72, 0x63, 0xa2, 0x54, 0x54,
+	0x28, 0x9a, 0xac, 0xeb, 0xb1, 0xa8, 0x2e, 0xa1, 0x9c, 0x59, 0xea, 0x88, 0xd2, 0x80, 0xd2, 0xac,
+	0xde, 0x77, 0x9d, 0xa1, 0x4f, 0xf1, 0x19, 0x64, 0xa3, 0x00, 0xf2, 0x0b, 0xf9, 0x3a, 0x2e, 0x0e,
+	0x4d, 0x62, 0x89, 0x52, 0x84, 0x8d, 0x26, 0x9d, 0xeb, 0xa9, 0x9c, 0x00, 0x36, 0xe9, 0x3f, 0x16,
+	0xde, 0x82, 0xb2, 0x41, 0xbf, 0xb1, 0x99, 0x3f, 0x27, 0x2a, 0x7f, 0x0e, 0xd2, 0x22, 0xf5, 0xa0,
+	0x26, 0xaf, 0x61, 0xc7, 0xa4, 0x03, 0xda, 0x8b, 0x6b, 0x4d, 0x6c, 0x8d, 0xcc, 0xfb, 0x8b, 0x8f,
+	0x40, 0x79, 0x03, 0x95, 0x25, 0xb5, 0x1e, 0x34, 0x5a, 0x09, 0xd0, 0x64, 0x8e, 0x3b, 0xeb, 0xec,
+	0xe1, 0x0f, 0x01, 0x0a, 0xc9, 0xbd, 0xb1, 0x02, 0x5b, 0x2d, 0xa2, 0xbd, 0xd5, 0xb5, 0xeb, 0x8e,
+	0xd9, 0x3e, 0x6e, 0x6a, 0x9d, 0x2b, 0xc3, 0x6c, 0x69, 0xa7, 0xfa, 0x99, 0xae, 0x35, 0xc4, 0xff,
+	0xb0, 0x0c, 0xc5, 0x98, 0x26, 0x17, 0x57, 0x46, 0x23, 0x10, 0x91, 0xb6, 0x28, 0xa0, 0x08, 0x85,
+	0x98, 0x68, 0x12, 0xbd, 0x21, 0xae, 0x60, 0x09, 0xc4, 0x18, 0x69, 0x93, 0x63, 0xc3, 0x3c, 0xd3,
+	0x88, 0x98, 0x4a, 0xa2, 0x97, 0x57, 0x9a, 0xd9, 0xd6, 0x2f, 0x0c, 0x71, 0x15, 0x11, 0xd6, 0x63,
+	0xf4, 0xd8, 0x30, 0xaf, 0x35, 0x22, 0xa6, 0x93, 0xca, 0x33, 0xdd, 0xd0, 0xcd, 0x73, 0xad, 0x21,
+	0x66, 0xea, 0x3f, 0x53, 0xb0, 0x1e, 0x0f, 0x4c, 0xbd, 0xb1, 0xdd, 0xa3, 0x78, 0x01, 0x85, 0x64,
+	0xf0, 0x30, 0xf9, 0x51, 0xdc, 0x91, 0x60, 0x79, 0x6f, 0x29, 0x1f, 0x19, 0xab, 0x03, 0x4c, 0xe3,
+	0x86, 0x3b, 0x09, 0xf9, 0x42, 0x34, 0xe5, 0xca, 0x12, 0x36, 0x2a, 0xf5, 0x1e, 0xc4, 0xf9, 0x68,
+	0xa1, 0x92, 0xb8, 0xb2, 0x24, 0x92, 0xf2, 0xc1, 0xbd, 0x9a, 0xa8, 0xf8, 0x47, 0xd8, 0xbc, 0x33,
+	0x21, 0xf8, 0x34, 0xb9, 0xe1, 0x3d, 0x79, 0x94, 0xab, 0x7f, 0x16, 0x46, 0xbd, 0x1a, 0x90, 0x4f,
+	0xc4, 0x07, 0x2b, 0x33, 0x1e, 0xce, 0xc7, 0x4a, 0x7e, 0xac, 0x86, 0x4f, 0x84, 0x1a, 0x3f, 0x11,
+	0xaa, 0x16, 0x3c, 0x11, 0x27, 0xa5, 0x77, 0x38, 0x79, 0x5b, 0x5e, 0x85, 0xbf, 0xc6, 0x47, 0x37,
+	0x19, 0xae, 0x7a, 0xf1, 0x7b, 0x00, 0x7a, 0x8e, 0xa8, 0x16, 0xb3, 0x06, 0x00, 0x00,
+}
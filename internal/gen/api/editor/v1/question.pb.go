@@ -10,6 +10,8 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,20 +24,161 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QuestionPartType int32
+
+const (
+	QuestionPartType_QUESTION_PART_TYPE_UNSPECIFIED QuestionPartType = 0
+	QuestionPartType_PART_TEXT                      QuestionPartType = 1
+	QuestionPartType_PART_IMAGE                     QuestionPartType = 2
+	QuestionPartType_PART_AUDIO                     QuestionPartType = 3
+	QuestionPartType_PART_VIDEO                     QuestionPartType = 4
+)
+
+// Enum value maps for QuestionPartType.
+var (
+	QuestionPartType_name = map[int32]string{
+		0: "QUESTION_PART_TYPE_UNSPECIFIED",
+		1: "PART_TEXT",
+		2: "PART_IMAGE",
+		3: "PART_AUDIO",
+		4: "PART_VIDEO",
+	}
+	QuestionPartType_value = map[string]int32{
+		"QUESTION_PART_TYPE_UNSPECIFIED": 0,
+		"PART_TEXT":                      1,
+		"PART_IMAGE":                     2,
+		"PART_AUDIO":                     3,
+		"PART_VIDEO":                     4,
+	}
+)
+
+func (x QuestionPartType) Enum() *QuestionPartType {
+	p := new(QuestionPartType)
+	*p = x
+	return p
+}
+
+func (x QuestionPartType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionPartType) Descriptor() protoreflect.EnumDescriptor {
+	return file_editor_v1_question_proto_enumTypes[0].Descriptor()
+}
+
+func (QuestionPartType) Type() protoreflect.EnumType {
+	return &file_editor_v1_question_proto_enumTypes[0]
+}
+
+func (x QuestionPartType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionPartType.Descriptor instead.
+func (QuestionPartType) EnumDescriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{0}
+}
+
+// QuestionPart is a part of question content, parts are shown to players in order.
+// Text part must have only text, media part must have only media url of the same type.
+type QuestionPart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     QuestionPartType `protobuf:"varint,1,opt,name=type,proto3,enum=editor.v1.QuestionPartType" json:"type,omitempty"` // required
+	Text     string           `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	MediaUrl string           `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	// Zero duration means the part is shown until the next part is requested.
+	Duration *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Fragment of audio or video media to play.
+	MediaClip *MediaClip `protobuf:"bytes,5,opt,name=media_clip,json=mediaClip,proto3" json:"media_clip,omitempty"`
+}
+
+func (x *QuestionPart) Reset() {
+	*x = QuestionPart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionPart) ProtoMessage() {}
+
+func (x *QuestionPart) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionPart.ProtoReflect.Descriptor instead.
+func (*QuestionPart) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuestionPart) GetType() QuestionPartType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionPartType_QUESTION_PART_TYPE_UNSPECIFIED
+}
+
+func (x *QuestionPart) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuestionPart) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *QuestionPart) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *QuestionPart) GetMediaClip() *MediaClip {
+	if x != nil {
+		return x.MediaClip
+	}
+	return nil
+}
+
 type Answer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	MediaUrl string `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Id            int32      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string     `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	MediaUrl      string     `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Alternatives  []string   `protobuf:"bytes,4,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	WrongVariants []string   `protobuf:"bytes,5,rep,name=wrong_variants,json=wrongVariants,proto3" json:"wrong_variants,omitempty"`
+	MediaClip     *MediaClip `protobuf:"bytes,6,opt,name=media_clip,json=mediaClip,proto3" json:"media_clip,omitempty"`
 }
 
 func (x *Answer) Reset() {
 	*x = Answer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_question_proto_msgTypes[0]
+		mi := &file_editor_v1_question_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -48,7 +191,7 @@ func (x *Answer) String() string {
 func (*Answer) ProtoMessage() {}
 
 func (x *Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_question_proto_msgTypes[0]
+	mi := &file_editor_v1_question_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +204,7 @@ func (x *Answer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Answer.ProtoReflect.Descriptor instead.
 func (*Answer) Descriptor() ([]byte, []int) {
-	return file_editor_v1_question_proto_rawDescGZIP(), []int{0}
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Answer) GetId() int32 {
@@ -85,36 +228,627 @@ func (x *Answer) GetMediaUrl() string {
 	return ""
 }
 
-type Question struct {
+func (x *Answer) GetAlternatives() []string {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *Answer) GetWrongVariants() []string {
+	if x != nil {
+		return x.WrongVariants
+	}
+	return nil
+}
+
+func (x *Answer) GetMediaClip() *MediaClip {
+	if x != nil {
+		return x.MediaClip
+	}
+	return nil
+}
+
+type Question struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Empty if caller is not allowed to see the answer.
+	Answer     *Answer `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	Author     string  `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	MediaUrl   string  `protobuf:"bytes,5,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	UsageCount int32   `protobuf:"varint,6,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	// Ordered question content, text and media_url are kept for clients which can't play parts.
+	Parts      []*QuestionPart        `protobuf:"bytes,7,rep,name=parts,proto3" json:"parts,omitempty"`
+	MediaClip  *MediaClip             `protobuf:"bytes,8,opt,name=media_clip,json=mediaClip,proto3" json:"media_clip,omitempty"`
+	Language   Language               `protobuf:"varint,9,opt,name=language,proto3,enum=editor.v1.Language" json:"language,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,50,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Question) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Question) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Question) GetAnswer() *Answer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *Question) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Question) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *Question) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+func (x *Question) GetParts() []*QuestionPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *Question) GetMediaClip() *MediaClip {
+	if x != nil {
+		return x.MediaClip
+	}
+	return nil
+}
+
+func (x *Question) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+func (x *Question) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type SimilarQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text       string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Similarity float32 `protobuf:"fixed32,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+}
+
+func (x *SimilarQuestion) Reset() {
+	*x = SimilarQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarQuestion) ProtoMessage() {}
+
+func (x *SimilarQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarQuestion.ProtoReflect.Descriptor instead.
+func (*SimilarQuestion) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SimilarQuestion) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SimilarQuestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SimilarQuestion) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type QuestionUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundQuestionId int32  `protobuf:"varint,1,opt,name=round_question_id,json=roundQuestionId,proto3" json:"round_question_id,omitempty"`
+	PackId          int32  `protobuf:"varint,2,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+	PackName        string `protobuf:"bytes,3,opt,name=pack_name,json=packName,proto3" json:"pack_name,omitempty"`
+	PackAuthor      string `protobuf:"bytes,4,opt,name=pack_author,json=packAuthor,proto3" json:"pack_author,omitempty"`
+	RoundId         int32  `protobuf:"varint,5,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	RoundName       string `protobuf:"bytes,6,opt,name=round_name,json=roundName,proto3" json:"round_name,omitempty"`
+	TopicId         int32  `protobuf:"varint,7,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	TopicTitle      string `protobuf:"bytes,8,opt,name=topic_title,json=topicTitle,proto3" json:"topic_title,omitempty"`
+}
+
+func (x *QuestionUsage) Reset() {
+	*x = QuestionUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionUsage) ProtoMessage() {}
+
+func (x *QuestionUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionUsage.ProtoReflect.Descriptor instead.
+func (*QuestionUsage) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuestionUsage) GetRoundQuestionId() int32 {
+	if x != nil {
+		return x.RoundQuestionId
+	}
+	return 0
+}
+
+func (x *QuestionUsage) GetPackId() int32 {
+	if x != nil {
+		return x.PackId
+	}
+	return 0
+}
+
+func (x *QuestionUsage) GetPackName() string {
+	if x != nil {
+		return x.PackName
+	}
+	return ""
+}
+
+func (x *QuestionUsage) GetPackAuthor() string {
+	if x != nil {
+		return x.PackAuthor
+	}
+	return ""
+}
+
+func (x *QuestionUsage) GetRoundId() int32 {
+	if x != nil {
+		return x.RoundId
+	}
+	return 0
+}
+
+func (x *QuestionUsage) GetRoundName() string {
+	if x != nil {
+		return x.RoundName
+	}
+	return ""
+}
+
+func (x *QuestionUsage) GetTopicId() int32 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *QuestionUsage) GetTopicTitle() string {
+	if x != nil {
+		return x.TopicTitle
+	}
+	return ""
+}
+
+type CreateQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question            string   `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"` // required
+	QuestionMediaUrl    string   `protobuf:"bytes,2,opt,name=question_media_url,json=questionMediaUrl,proto3" json:"question_media_url,omitempty"`
+	Answer              string   `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"` // required
+	AnswerMediaUrl      string   `protobuf:"bytes,4,opt,name=answer_media_url,json=answerMediaUrl,proto3" json:"answer_media_url,omitempty"`
+	AnswerAlternatives  []string `protobuf:"bytes,5,rep,name=answer_alternatives,json=answerAlternatives,proto3" json:"answer_alternatives,omitempty"`
+	AnswerWrongVariants []string `protobuf:"bytes,6,rep,name=answer_wrong_variants,json=answerWrongVariants,proto3" json:"answer_wrong_variants,omitempty"`
+	// Refuse to create question if similar questions exist.
+	Strict        bool            `protobuf:"varint,7,opt,name=strict,proto3" json:"strict,omitempty"`
+	QuestionParts []*QuestionPart `protobuf:"bytes,8,rep,name=question_parts,json=questionParts,proto3" json:"question_parts,omitempty"`
+	// Fragments of question and answer audio or video media to play.
+	QuestionMediaClip *MediaClip `protobuf:"bytes,9,opt,name=question_media_clip,json=questionMediaClip,proto3" json:"question_media_clip,omitempty"`
+	AnswerMediaClip   *MediaClip `protobuf:"bytes,10,opt,name=answer_media_clip,json=answerMediaClip,proto3" json:"answer_media_clip,omitempty"`
+	Language          Language   `protobuf:"varint,11,opt,name=language,proto3,enum=editor.v1.Language" json:"language,omitempty"`
+}
+
+func (x *CreateQuestionRequest) Reset() {
+	*x = CreateQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateQuestionRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetQuestionMediaUrl() string {
+	if x != nil {
+		return x.QuestionMediaUrl
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetAnswerMediaUrl() string {
+	if x != nil {
+		return x.AnswerMediaUrl
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetAnswerAlternatives() []string {
+	if x != nil {
+		return x.AnswerAlternatives
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetAnswerWrongVariants() []string {
+	if x != nil {
+		return x.AnswerWrongVariants
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *CreateQuestionRequest) GetQuestionParts() []*QuestionPart {
+	if x != nil {
+		return x.QuestionParts
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetQuestionMediaClip() *MediaClip {
+	if x != nil {
+		return x.QuestionMediaClip
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetAnswerMediaClip() *MediaClip {
+	if x != nil {
+		return x.AnswerMediaClip
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+type CreateQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId int32 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// Existing questions similar to created one.
+	SimilarQuestions []*SimilarQuestion `protobuf:"bytes,2,rep,name=similar_questions,json=similarQuestions,proto3" json:"similar_questions,omitempty"`
+}
+
+func (x *CreateQuestionResponse) Reset() {
+	*x = CreateQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionResponse) ProtoMessage() {}
+
+func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateQuestionResponse) GetQuestionId() int32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *CreateQuestionResponse) GetSimilarQuestions() []*SimilarQuestion {
+	if x != nil {
+		return x.SimilarQuestions
+	}
+	return nil
+}
+
+type GetQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId int32 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` // required
+	// Share token of unlisted or private pack the question is used in.
+	ShareToken string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+}
+
+func (x *GetQuestionRequest) Reset() {
+	*x = GetQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionRequest) ProtoMessage() {}
+
+func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetQuestionRequest) GetQuestionId() int32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *GetQuestionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type GetQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question *Question `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+}
+
+func (x *GetQuestionResponse) Reset() {
+	*x = GetQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionResponse) ProtoMessage() {}
+
+func (x *GetQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetQuestionResponse) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type GetQuestionUsageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text       string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	Answer     *Answer                `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
-	Author     string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
-	MediaUrl   string                 `protobuf:"bytes,5,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,50,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	QuestionId int32 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` // required
 }
 
-func (x *Question) Reset() {
-	*x = Question{}
+func (x *GetQuestionUsageRequest) Reset() {
+	*x = GetQuestionUsageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_question_proto_msgTypes[1]
+		mi := &file_editor_v1_question_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Question) String() string {
+func (x *GetQuestionUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Question) ProtoMessage() {}
+func (*GetQuestionUsageRequest) ProtoMessage() {}
 
-func (x *Question) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_question_proto_msgTypes[1]
+func (x *GetQuestionUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,81 +859,101 @@ func (x *Question) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Question.ProtoReflect.Descriptor instead.
-func (*Question) Descriptor() ([]byte, []int) {
-	return file_editor_v1_question_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetQuestionUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionUsageRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Question) GetId() int32 {
+func (x *GetQuestionUsageRequest) GetQuestionId() int32 {
 	if x != nil {
-		return x.Id
+		return x.QuestionId
 	}
 	return 0
 }
 
-func (x *Question) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
+type GetQuestionUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usages []*QuestionUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
 }
 
-func (x *Question) GetAnswer() *Answer {
-	if x != nil {
-		return x.Answer
+func (x *GetQuestionUsageResponse) Reset() {
+	*x = GetQuestionUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_question_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Question) GetAuthor() string {
-	if x != nil {
-		return x.Author
-	}
-	return ""
+func (x *GetQuestionUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Question) GetMediaUrl() string {
-	if x != nil {
-		return x.MediaUrl
+func (*GetQuestionUsageResponse) ProtoMessage() {}
+
+func (x *GetQuestionUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Question) GetCreateTime() *timestamppb.Timestamp {
+// Deprecated: Use GetQuestionUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionUsageResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetQuestionUsageResponse) GetUsages() []*QuestionUsage {
 	if x != nil {
-		return x.CreateTime
+		return x.Usages
 	}
 	return nil
 }
 
-type CreateQuestionRequest struct {
+type UpdateQuestionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Question         string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"` // required
-	QuestionMediaUrl string `protobuf:"bytes,2,opt,name=question_media_url,json=questionMediaUrl,proto3" json:"question_media_url,omitempty"`
-	Answer           string `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"` // required
-	AnswerMediaUrl   string `protobuf:"bytes,4,opt,name=answer_media_url,json=answerMediaUrl,proto3" json:"answer_media_url,omitempty"`
+	QuestionId          int32           `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` // required
+	Question            string          `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`                        // required
+	QuestionMediaUrl    string          `protobuf:"bytes,3,opt,name=question_media_url,json=questionMediaUrl,proto3" json:"question_media_url,omitempty"`
+	Answer              string          `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"` // required
+	AnswerMediaUrl      string          `protobuf:"bytes,5,opt,name=answer_media_url,json=answerMediaUrl,proto3" json:"answer_media_url,omitempty"`
+	AnswerAlternatives  []string        `protobuf:"bytes,6,rep,name=answer_alternatives,json=answerAlternatives,proto3" json:"answer_alternatives,omitempty"`
+	AnswerWrongVariants []string        `protobuf:"bytes,7,rep,name=answer_wrong_variants,json=answerWrongVariants,proto3" json:"answer_wrong_variants,omitempty"`
+	QuestionParts       []*QuestionPart `protobuf:"bytes,8,rep,name=question_parts,json=questionParts,proto3" json:"question_parts,omitempty"`
+	QuestionMediaClip   *MediaClip      `protobuf:"bytes,9,opt,name=question_media_clip,json=questionMediaClip,proto3" json:"question_media_clip,omitempty"`
+	AnswerMediaClip     *MediaClip      `protobuf:"bytes,10,opt,name=answer_media_clip,json=answerMediaClip,proto3" json:"answer_media_clip,omitempty"`
+	// Language is not changed if not specified.
+	Language Language `protobuf:"varint,11,opt,name=language,proto3,enum=editor.v1.Language" json:"language,omitempty"`
 }
 
-func (x *CreateQuestionRequest) Reset() {
-	*x = CreateQuestionRequest{}
+func (x *UpdateQuestionRequest) Reset() {
+	*x = UpdateQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_question_proto_msgTypes[2]
+		mi := &file_editor_v1_question_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateQuestionRequest) String() string {
+func (x *UpdateQuestionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuestionRequest) ProtoMessage() {}
+func (*UpdateQuestionRequest) ProtoMessage() {}
 
-func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_question_proto_msgTypes[2]
+func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,64 +964,113 @@ func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
-func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_editor_v1_question_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UpdateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateQuestionRequest) GetQuestion() string {
+func (x *UpdateQuestionRequest) GetQuestionId() int32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *UpdateQuestionRequest) GetQuestion() string {
 	if x != nil {
 		return x.Question
 	}
 	return ""
 }
 
-func (x *CreateQuestionRequest) GetQuestionMediaUrl() string {
+func (x *UpdateQuestionRequest) GetQuestionMediaUrl() string {
 	if x != nil {
 		return x.QuestionMediaUrl
 	}
 	return ""
 }
 
-func (x *CreateQuestionRequest) GetAnswer() string {
+func (x *UpdateQuestionRequest) GetAnswer() string {
 	if x != nil {
 		return x.Answer
 	}
 	return ""
 }
 
-func (x *CreateQuestionRequest) GetAnswerMediaUrl() string {
+func (x *UpdateQuestionRequest) GetAnswerMediaUrl() string {
 	if x != nil {
 		return x.AnswerMediaUrl
 	}
 	return ""
 }
 
-type CreateQuestionResponse struct {
+func (x *UpdateQuestionRequest) GetAnswerAlternatives() []string {
+	if x != nil {
+		return x.AnswerAlternatives
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetAnswerWrongVariants() []string {
+	if x != nil {
+		return x.AnswerWrongVariants
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetQuestionParts() []*QuestionPart {
+	if x != nil {
+		return x.QuestionParts
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetQuestionMediaClip() *MediaClip {
+	if x != nil {
+		return x.QuestionMediaClip
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetAnswerMediaClip() *MediaClip {
+	if x != nil {
+		return x.AnswerMediaClip
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+type DeleteQuestionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId int32 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionId int32 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` // required
 }
 
-func (x *CreateQuestionResponse) Reset() {
-	*x = CreateQuestionResponse{}
+func (x *DeleteQuestionRequest) Reset() {
+	*x = DeleteQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_question_proto_msgTypes[3]
+		mi := &file_editor_v1_question_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateQuestionResponse) String() string {
+func (x *DeleteQuestionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuestionResponse) ProtoMessage() {}
+func (*DeleteQuestionRequest) ProtoMessage() {}
 
-func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_question_proto_msgTypes[3]
+func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,43 +1081,46 @@ func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
-func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_editor_v1_question_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateQuestionResponse) GetQuestionId() int32 {
+func (x *DeleteQuestionRequest) GetQuestionId() int32 {
 	if x != nil {
 		return x.QuestionId
 	}
 	return 0
 }
 
-type GetQuestionRequest struct {
+type SearchQuestionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId int32 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"` // required
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // required
+	// Returns questions of all languages if not specified.
+	Language Language `protobuf:"varint,2,opt,name=language,proto3,enum=editor.v1.Language" json:"language,omitempty"`
+	Limit    int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // required
 }
 
-func (x *GetQuestionRequest) Reset() {
-	*x = GetQuestionRequest{}
+func (x *SearchQuestionsRequest) Reset() {
+	*x = SearchQuestionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_question_proto_msgTypes[4]
+		mi := &file_editor_v1_question_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetQuestionRequest) String() string {
+func (x *SearchQuestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuestionRequest) ProtoMessage() {}
+func (*SearchQuestionsRequest) ProtoMessage() {}
 
-func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_question_proto_msgTypes[4]
+func (x *SearchQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,43 +1131,58 @@ func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuestionRequest.ProtoReflect.Descriptor instead.
-func (*GetQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_editor_v1_question_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SearchQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetQuestionRequest) GetQuestionId() int32 {
+func (x *SearchQuestionsRequest) GetQuery() string {
 	if x != nil {
-		return x.QuestionId
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchQuestionsRequest) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+func (x *SearchQuestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-type GetQuestionResponse struct {
+type SearchQuestionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Question *Question `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	// Questions without answers.
+	Questions []*Question `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
 }
 
-func (x *GetQuestionResponse) Reset() {
-	*x = GetQuestionResponse{}
+func (x *SearchQuestionsResponse) Reset() {
+	*x = SearchQuestionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_question_proto_msgTypes[5]
+		mi := &file_editor_v1_question_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetQuestionResponse) String() string {
+func (x *SearchQuestionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuestionResponse) ProtoMessage() {}
+func (*SearchQuestionsResponse) ProtoMessage() {}
 
-func (x *GetQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_question_proto_msgTypes[5]
+func (x *SearchQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_question_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,14 +1193,14 @@ func (x *GetQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuestionResponse.ProtoReflect.Descriptor instead.
-func (*GetQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_editor_v1_question_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SearchQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_editor_v1_question_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetQuestionResponse) GetQuestion() *Question {
+func (x *SearchQuestionsResponse) GetQuestions() []*Question {
 	if x != nil {
-		return x.Question
+		return x.Questions
 	}
 	return nil
 }
@@ -389,66 +1210,263 @@ var File_editor_v1_question_proto protoreflect.FileDescriptor
 var file_editor_v1_question_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65, 0x64, 0x69, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x49, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x15, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x02,
+	0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x12, 0x3f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x82, 0x01,
+	0x08, 0x18, 0x01, 0x18, 0x02, 0x18, 0x03, 0x18, 0x04, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0xc8, 0x01, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x28, 0x0a,
 	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x22, 0xcb, 0x01, 0x0a, 0x08, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x61,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x64,
-	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x06,
-	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x03, 0x18, 0xc8, 0x01,
-	0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x12, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01,
-	0x88, 0x01, 0x01, 0x52, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64,
-	0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x10, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0x88, 0x01, 0x01, 0x52,
-	0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x22,
-	0x39, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x46, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x64, 0x69,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xb6, 0x01, 0x0a, 0x0f, 0x51, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x20, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x14, 0x5a, 0x12, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0x88, 0x01, 0x01, 0x52, 0x08, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x43, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06, 0x22, 0x02, 0x08, 0x3c,
+	0x32, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6c, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69,
+	0x70, 0x22, 0xc9, 0x01, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x72, 0x6f, 0x6e, 0x67,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x5f, 0x63, 0x6c, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c,
+	0x69, 0x70, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x22, 0x81, 0x03,
+	0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x29,
+	0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x1f,
+	0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2d, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x33,
+	0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6c, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43,
+	0x6c, 0x69, 0x70, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x63, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x88, 0x02, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
+	0x63, 0x6b, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x63, 0x6b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x22, 0x86, 0x05, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x03, 0x18, 0xc8, 0x01, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0x88, 0x01, 0x01, 0x52, 0x10, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x21,
+	0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x03, 0x18, 0x64, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x10, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x72, 0x06, 0xd0, 0x01, 0x01, 0x88, 0x01, 0x01, 0x52, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x43, 0x0a, 0x13, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x5f, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x10, 0x0a, 0x18,
+	0x01, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x12, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x46, 0x0a,
+	0x15, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42,
+	0x0f, 0x92, 0x01, 0x0c, 0x10, 0x0a, 0x18, 0x01, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64,
+	0x52, 0x13, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x48, 0x0a,
+	0x0e, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x0a, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6c, 0x69, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x11, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x12, 0x40, 0x0a,
+	0x11, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6c,
+	0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x0f,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x12,
+	0x39, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61,
+	0x72, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73,
+	0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x56, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x8f, 0x05, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x03, 0x18,
+	0xc8, 0x01, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x12,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0,
+	0x01, 0x01, 0x88, 0x01, 0x01, 0x52, 0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x03,
+	0x18, 0x64, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x10, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x72, 0x06, 0xd0, 0x01, 0x01, 0x88, 0x01,
+	0x01, 0x52, 0x0e, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72,
+	0x6c, 0x12, 0x43, 0x0a, 0x13, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12,
+	0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x10, 0x0a, 0x18, 0x01, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01,
+	0x18, 0x64, 0x52, 0x12, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x15, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x10, 0x0a, 0x18,
+	0x01, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x13, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x48,
+	0x0a, 0x0e, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x0a, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6c, 0x69, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x11, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x12, 0x40,
+	0x0a, 0x11, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63,
+	0x6c, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52,
+	0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70,
+	0x12, 0x39, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x38, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x03, 0x18, 0xc8, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42,
+	0x06, 0x1a, 0x04, 0x18, 0x32, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4c,
+	0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x75, 0x0a, 0x10,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x22, 0x0a, 0x1e, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x54, 0x45, 0x58,
+	0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47,
+	0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x41, 0x55, 0x44, 0x49,
+	0x4f, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x56, 0x49, 0x44, 0x45,
+	0x4f, 0x10, 0x04, 0x32, 0x85, 0x04, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x65, 0x64, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e,
+	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x22, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x14, 0x5a, 0x12, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -463,29 +1481,71 @@ func file_editor_v1_question_proto_rawDescGZIP() []byte {
 	return file_editor_v1_question_proto_rawDescData
 }
 
-var file_editor_v1_question_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_editor_v1_question_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_editor_v1_question_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_editor_v1_question_proto_goTypes = []interface{}{
-	(*Answer)(nil),                 // 0: editor.v1.Answer
-	(*Question)(nil),               // 1: editor.v1.Question
-	(*CreateQuestionRequest)(nil),  // 2: editor.v1.CreateQuestionRequest
-	(*CreateQuestionResponse)(nil), // 3: editor.v1.CreateQuestionResponse
-	(*GetQuestionRequest)(nil),     // 4: editor.v1.GetQuestionRequest
-	(*GetQuestionResponse)(nil),    // 5: editor.v1.GetQuestionResponse
-	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
+	(QuestionPartType)(0),            // 0: editor.v1.QuestionPartType
+	(*QuestionPart)(nil),             // 1: editor.v1.QuestionPart
+	(*Answer)(nil),                   // 2: editor.v1.Answer
+	(*Question)(nil),                 // 3: editor.v1.Question
+	(*SimilarQuestion)(nil),          // 4: editor.v1.SimilarQuestion
+	(*QuestionUsage)(nil),            // 5: editor.v1.QuestionUsage
+	(*CreateQuestionRequest)(nil),    // 6: editor.v1.CreateQuestionRequest
+	(*CreateQuestionResponse)(nil),   // 7: editor.v1.CreateQuestionResponse
+	(*GetQuestionRequest)(nil),       // 8: editor.v1.GetQuestionRequest
+	(*GetQuestionResponse)(nil),      // 9: editor.v1.GetQuestionResponse
+	(*GetQuestionUsageRequest)(nil),  // 10: editor.v1.GetQuestionUsageRequest
+	(*GetQuestionUsageResponse)(nil), // 11: editor.v1.GetQuestionUsageResponse
+	(*UpdateQuestionRequest)(nil),    // 12: editor.v1.UpdateQuestionRequest
+	(*DeleteQuestionRequest)(nil),    // 13: editor.v1.DeleteQuestionRequest
+	(*SearchQuestionsRequest)(nil),   // 14: editor.v1.SearchQuestionsRequest
+	(*SearchQuestionsResponse)(nil),  // 15: editor.v1.SearchQuestionsResponse
+	(*durationpb.Duration)(nil),      // 16: google.protobuf.Duration
+	(*MediaClip)(nil),                // 17: editor.v1.MediaClip
+	(Language)(0),                    // 18: editor.v1.Language
+	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 20: google.protobuf.Empty
 }
 var file_editor_v1_question_proto_depIdxs = []int32{
-	0, // 0: editor.v1.Question.answer:type_name -> editor.v1.Answer
-	6, // 1: editor.v1.Question.create_time:type_name -> google.protobuf.Timestamp
-	1, // 2: editor.v1.GetQuestionResponse.question:type_name -> editor.v1.Question
-	2, // 3: editor.v1.QuestionService.CreateQuestion:input_type -> editor.v1.CreateQuestionRequest
-	4, // 4: editor.v1.QuestionService.GetQuestion:input_type -> editor.v1.GetQuestionRequest
-	3, // 5: editor.v1.QuestionService.CreateQuestion:output_type -> editor.v1.CreateQuestionResponse
-	5, // 6: editor.v1.QuestionService.GetQuestion:output_type -> editor.v1.GetQuestionResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: editor.v1.QuestionPart.type:type_name -> editor.v1.QuestionPartType
+	16, // 1: editor.v1.QuestionPart.duration:type_name -> google.protobuf.Duration
+	17, // 2: editor.v1.QuestionPart.media_clip:type_name -> editor.v1.MediaClip
+	17, // 3: editor.v1.Answer.media_clip:type_name -> editor.v1.MediaClip
+	2,  // 4: editor.v1.Question.answer:type_name -> editor.v1.Answer
+	1,  // 5: editor.v1.Question.parts:type_name -> editor.v1.QuestionPart
+	17, // 6: editor.v1.Question.media_clip:type_name -> editor.v1.MediaClip
+	18, // 7: editor.v1.Question.language:type_name -> editor.v1.Language
+	19, // 8: editor.v1.Question.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 9: editor.v1.CreateQuestionRequest.question_parts:type_name -> editor.v1.QuestionPart
+	17, // 10: editor.v1.CreateQuestionRequest.question_media_clip:type_name -> editor.v1.MediaClip
+	17, // 11: editor.v1.CreateQuestionRequest.answer_media_clip:type_name -> editor.v1.MediaClip
+	18, // 12: editor.v1.CreateQuestionRequest.language:type_name -> editor.v1.Language
+	4,  // 13: editor.v1.CreateQuestionResponse.similar_questions:type_name -> editor.v1.SimilarQuestion
+	3,  // 14: editor.v1.GetQuestionResponse.question:type_name -> editor.v1.Question
+	5,  // 15: editor.v1.GetQuestionUsageResponse.usages:type_name -> editor.v1.QuestionUsage
+	1,  // 16: editor.v1.UpdateQuestionRequest.question_parts:type_name -> editor.v1.QuestionPart
+	17, // 17: editor.v1.UpdateQuestionRequest.question_media_clip:type_name -> editor.v1.MediaClip
+	17, // 18: editor.v1.UpdateQuestionRequest.answer_media_clip:type_name -> editor.v1.MediaClip
+	18, // 19: editor.v1.UpdateQuestionRequest.language:type_name -> editor.v1.Language
+	18, // 20: editor.v1.SearchQuestionsRequest.language:type_name -> editor.v1.Language
+	3,  // 21: editor.v1.SearchQuestionsResponse.questions:type_name -> editor.v1.Question
+	6,  // 22: editor.v1.QuestionService.CreateQuestion:input_type -> editor.v1.CreateQuestionRequest
+	8,  // 23: editor.v1.QuestionService.GetQuestion:input_type -> editor.v1.GetQuestionRequest
+	10, // 24: editor.v1.QuestionService.GetQuestionUsage:input_type -> editor.v1.GetQuestionUsageRequest
+	14, // 25: editor.v1.QuestionService.SearchQuestions:input_type -> editor.v1.SearchQuestionsRequest
+	12, // 26: editor.v1.QuestionService.UpdateQuestion:input_type -> editor.v1.UpdateQuestionRequest
+	13, // 27: editor.v1.QuestionService.DeleteQuestion:input_type -> editor.v1.DeleteQuestionRequest
+	7,  // 28: editor.v1.QuestionService.CreateQuestion:output_type -> editor.v1.CreateQuestionResponse
+	9,  // 29: editor.v1.QuestionService.GetQuestion:output_type -> editor.v1.GetQuestionResponse
+	11, // 30: editor.v1.QuestionService.GetQuestionUsage:output_type -> editor.v1.GetQuestionUsageResponse
+	15, // 31: editor.v1.QuestionService.SearchQuestions:output_type -> editor.v1.SearchQuestionsResponse
+	20, // 32: editor.v1.QuestionService.UpdateQuestion:output_type -> google.protobuf.Empty
+	20, // 33: editor.v1.QuestionService.DeleteQuestion:output_type -> google.protobuf.Empty
+	28, // [28:34] is the sub-list for method output_type
+	22, // [22:28] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_editor_v1_question_proto_init() }
@@ -493,9 +1553,11 @@ func file_editor_v1_question_proto_init() {
 	if File_editor_v1_question_proto != nil {
 		return
 	}
+	file_editor_v1_language_proto_init()
+	file_editor_v1_media_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_editor_v1_question_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Answer); i {
+			switch v := v.(*QuestionPart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -507,7 +1569,7 @@ func file_editor_v1_question_proto_init() {
 			}
 		}
 		file_editor_v1_question_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Question); i {
+			switch v := v.(*Answer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -519,7 +1581,7 @@ func file_editor_v1_question_proto_init() {
 			}
 		}
 		file_editor_v1_question_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateQuestionRequest); i {
+			switch v := v.(*Question); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -531,7 +1593,7 @@ func file_editor_v1_question_proto_init() {
 			}
 		}
 		file_editor_v1_question_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateQuestionResponse); i {
+			switch v := v.(*SimilarQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -543,7 +1605,7 @@ func file_editor_v1_question_proto_init() {
 			}
 		}
 		file_editor_v1_question_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetQuestionRequest); i {
+			switch v := v.(*QuestionUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -555,6 +1617,42 @@ func file_editor_v1_question_proto_init() {
 			}
 		}
 		file_editor_v1_question_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetQuestionResponse); i {
 			case 0:
 				return &v.state
@@ -566,19 +1664,92 @@ func file_editor_v1_question_proto_init() {
 				return nil
 			}
 		}
+		file_editor_v1_question_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuestionUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuestionUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_question_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_editor_v1_question_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_editor_v1_question_proto_goTypes,
 		DependencyIndexes: file_editor_v1_question_proto_depIdxs,
+		EnumInfos:         file_editor_v1_question_proto_enumTypes,
 		MessageInfos:      file_editor_v1_question_proto_msgTypes,
 	}.Build()
 	File_editor_v1_question_proto = out.File
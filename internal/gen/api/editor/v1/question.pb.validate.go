@@ -35,6 +35,219 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on QuestionPart with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuestionPart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuestionPart with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuestionPartMultiError, or
+// nil if none found.
+func (m *QuestionPart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuestionPart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _QuestionPart_Type_InLookup[m.GetType()]; !ok {
+		err := QuestionPartValidationError{
+			field:  "Type",
+			reason: "value must be in list [PART_TEXT PART_IMAGE PART_AUDIO PART_VIDEO]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetText()) > 200 {
+		err := QuestionPartValidationError{
+			field:  "Text",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMediaUrl() != "" {
+
+		if uri, err := url.Parse(m.GetMediaUrl()); err != nil {
+			err = QuestionPartValidationError{
+				field:  "MediaUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := QuestionPartValidationError{
+				field:  "MediaUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = QuestionPartValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(60*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := QuestionPartValidationError{
+					field:  "Duration",
+					reason: "value must be inside range [0s, 1m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuestionPartValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuestionPartValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuestionPartValidationError{
+				field:  "MediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QuestionPartMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuestionPartMultiError is an error wrapping multiple validation errors
+// returned by QuestionPart.ValidateAll() if the designated constraints aren't met.
+type QuestionPartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuestionPartMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuestionPartMultiError) AllErrors() []error { return m }
+
+// QuestionPartValidationError is the validation error returned by
+// QuestionPart.Validate if the designated constraints aren't met.
+type QuestionPartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuestionPartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuestionPartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuestionPartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuestionPartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuestionPartValidationError) ErrorName() string { return "QuestionPartValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuestionPartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuestionPart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuestionPartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuestionPartValidationError{}
+
+var _QuestionPart_Type_InLookup = map[QuestionPartType]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+}
+
 // Validate checks the field values on Answer with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -62,6 +275,35 @@ func (m *Answer) validate(all bool) error {
 
 	// no validation rules for MediaUrl
 
+	if all {
+		switch v := interface{}(m.GetMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnswerValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnswerValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnswerValidationError{
+				field:  "MediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AnswerMultiError(errors)
 	}
@@ -198,6 +440,73 @@ func (m *Question) validate(all bool) error {
 
 	// no validation rules for MediaUrl
 
+	// no validation rules for UsageCount
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuestionValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuestionValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuestionValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuestionValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuestionValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuestionValidationError{
+				field:  "MediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Language
+
 	if all {
 		switch v := interface{}(m.GetCreateTime()).(type) {
 		case interface{ ValidateAll() error }:
@@ -304,32 +613,1317 @@ var _ interface {
 	ErrorName() string
 } = QuestionValidationError{}
 
-// Validate checks the field values on CreateQuestionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateQuestionRequest) Validate() error {
+// Validate checks the field values on SimilarQuestion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimilarQuestion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateQuestionRequest with the rules
+// ValidateAll checks the field values on SimilarQuestion with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateQuestionRequestMultiError, or nil if none found.
-func (m *CreateQuestionRequest) ValidateAll() error {
+// SimilarQuestionMultiError, or nil if none found.
+func (m *SimilarQuestion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateQuestionRequest) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *SimilarQuestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Text
+
+	// no validation rules for Similarity
+
+	if len(errors) > 0 {
+		return SimilarQuestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimilarQuestionMultiError is an error wrapping multiple validation errors
+// returned by SimilarQuestion.ValidateAll() if the designated constraints
+// aren't met.
+type SimilarQuestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimilarQuestionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimilarQuestionMultiError) AllErrors() []error { return m }
+
+// SimilarQuestionValidationError is the validation error returned by
+// SimilarQuestion.Validate if the designated constraints aren't met.
+type SimilarQuestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimilarQuestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimilarQuestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimilarQuestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimilarQuestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimilarQuestionValidationError) ErrorName() string { return "SimilarQuestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SimilarQuestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimilarQuestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimilarQuestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimilarQuestionValidationError{}
+
+// Validate checks the field values on QuestionUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuestionUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuestionUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuestionUsageMultiError, or
+// nil if none found.
+func (m *QuestionUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuestionUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoundQuestionId
+
+	// no validation rules for PackId
+
+	// no validation rules for PackName
+
+	// no validation rules for PackAuthor
+
+	// no validation rules for RoundId
+
+	// no validation rules for RoundName
+
+	// no validation rules for TopicId
+
+	// no validation rules for TopicTitle
+
+	if len(errors) > 0 {
+		return QuestionUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuestionUsageMultiError is an error wrapping multiple validation errors
+// returned by QuestionUsage.ValidateAll() if the designated constraints
+// aren't met.
+type QuestionUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuestionUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuestionUsageMultiError) AllErrors() []error { return m }
+
+// QuestionUsageValidationError is the validation error returned by
+// QuestionUsage.Validate if the designated constraints aren't met.
+type QuestionUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuestionUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuestionUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuestionUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuestionUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuestionUsageValidationError) ErrorName() string { return "QuestionUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuestionUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuestionUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuestionUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuestionUsageValidationError{}
+
+// Validate checks the field values on CreateQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateQuestionRequestMultiError, or nil if none found.
+func (m *CreateQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuestion()); l < 3 || l > 200 {
+		err := CreateQuestionRequestValidationError{
+			field:  "Question",
+			reason: "value length must be between 3 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuestionMediaUrl() != "" {
+
+		if uri, err := url.Parse(m.GetQuestionMediaUrl()); err != nil {
+			err = CreateQuestionRequestValidationError{
+				field:  "QuestionMediaUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := CreateQuestionRequestValidationError{
+				field:  "QuestionMediaUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetAnswer()); l < 3 || l > 100 {
+		err := CreateQuestionRequestValidationError{
+			field:  "Answer",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnswerMediaUrl() != "" {
+
+		if uri, err := url.Parse(m.GetAnswerMediaUrl()); err != nil {
+			err = CreateQuestionRequestValidationError{
+				field:  "AnswerMediaUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := CreateQuestionRequestValidationError{
+				field:  "AnswerMediaUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetAnswerAlternatives()) > 10 {
+		err := CreateQuestionRequestValidationError{
+			field:  "AnswerAlternatives",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateQuestionRequest_AnswerAlternatives_Unique := make(map[string]struct{}, len(m.GetAnswerAlternatives()))
+
+	for idx, item := range m.GetAnswerAlternatives() {
+		_, _ = idx, item
+
+		if _, exists := _CreateQuestionRequest_AnswerAlternatives_Unique[item]; exists {
+			err := CreateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerAlternatives[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateQuestionRequest_AnswerAlternatives_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := CreateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerAlternatives[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetAnswerWrongVariants()) > 10 {
+		err := CreateQuestionRequestValidationError{
+			field:  "AnswerWrongVariants",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateQuestionRequest_AnswerWrongVariants_Unique := make(map[string]struct{}, len(m.GetAnswerWrongVariants()))
+
+	for idx, item := range m.GetAnswerWrongVariants() {
+		_, _ = idx, item
+
+		if _, exists := _CreateQuestionRequest_AnswerWrongVariants_Unique[item]; exists {
+			err := CreateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerWrongVariants[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateQuestionRequest_AnswerWrongVariants_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := CreateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerWrongVariants[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Strict
+
+	if len(m.GetQuestionParts()) > 10 {
+		err := CreateQuestionRequestValidationError{
+			field:  "QuestionParts",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetQuestionParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateQuestionRequestValidationError{
+						field:  fmt.Sprintf("QuestionParts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateQuestionRequestValidationError{
+						field:  fmt.Sprintf("QuestionParts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateQuestionRequestValidationError{
+					field:  fmt.Sprintf("QuestionParts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuestionMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateQuestionRequestValidationError{
+					field:  "QuestionMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateQuestionRequestValidationError{
+					field:  "QuestionMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuestionMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateQuestionRequestValidationError{
+				field:  "QuestionMediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnswerMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateQuestionRequestValidationError{
+					field:  "AnswerMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateQuestionRequestValidationError{
+					field:  "AnswerMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswerMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateQuestionRequestValidationError{
+				field:  "AnswerMediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
+		err := CreateQuestionRequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateQuestionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateQuestionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateQuestionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateQuestionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateQuestionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateQuestionRequestMultiError) AllErrors() []error { return m }
+
+// CreateQuestionRequestValidationError is the validation error returned by
+// CreateQuestionRequest.Validate if the designated constraints aren't met.
+type CreateQuestionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateQuestionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateQuestionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateQuestionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateQuestionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateQuestionRequestValidationError) ErrorName() string {
+	return "CreateQuestionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateQuestionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateQuestionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateQuestionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateQuestionRequestValidationError{}
+
+// Validate checks the field values on CreateQuestionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateQuestionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateQuestionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateQuestionResponseMultiError, or nil if none found.
+func (m *CreateQuestionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateQuestionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuestionId
+
+	for idx, item := range m.GetSimilarQuestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateQuestionResponseValidationError{
+						field:  fmt.Sprintf("SimilarQuestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateQuestionResponseValidationError{
+						field:  fmt.Sprintf("SimilarQuestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateQuestionResponseValidationError{
+					field:  fmt.Sprintf("SimilarQuestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateQuestionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateQuestionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateQuestionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateQuestionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateQuestionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateQuestionResponseMultiError) AllErrors() []error { return m }
+
+// CreateQuestionResponseValidationError is the validation error returned by
+// CreateQuestionResponse.Validate if the designated constraints aren't met.
+type CreateQuestionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateQuestionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateQuestionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateQuestionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateQuestionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateQuestionResponseValidationError) ErrorName() string {
+	return "CreateQuestionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateQuestionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateQuestionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateQuestionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateQuestionResponseValidationError{}
+
+// Validate checks the field values on GetQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuestionRequestMultiError, or nil if none found.
+func (m *GetQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuestionId
+
+	// no validation rules for ShareToken
+
+	if len(errors) > 0 {
+		return GetQuestionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuestionRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQuestionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuestionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuestionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuestionRequestMultiError) AllErrors() []error { return m }
+
+// GetQuestionRequestValidationError is the validation error returned by
+// GetQuestionRequest.Validate if the designated constraints aren't met.
+type GetQuestionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuestionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuestionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuestionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuestionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuestionRequestValidationError) ErrorName() string {
+	return "GetQuestionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQuestionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuestionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuestionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuestionRequestValidationError{}
+
+// Validate checks the field values on GetQuestionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQuestionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuestionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuestionResponseMultiError, or nil if none found.
+func (m *GetQuestionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuestionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuestion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetQuestionResponseValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetQuestionResponseValidationError{
+					field:  "Question",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuestion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetQuestionResponseValidationError{
+				field:  "Question",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetQuestionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuestionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetQuestionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetQuestionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuestionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuestionResponseMultiError) AllErrors() []error { return m }
+
+// GetQuestionResponseValidationError is the validation error returned by
+// GetQuestionResponse.Validate if the designated constraints aren't met.
+type GetQuestionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuestionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuestionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuestionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuestionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuestionResponseValidationError) ErrorName() string {
+	return "GetQuestionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQuestionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuestionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuestionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuestionResponseValidationError{}
+
+// Validate checks the field values on GetQuestionUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQuestionUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuestionUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuestionUsageRequestMultiError, or nil if none found.
+func (m *GetQuestionUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuestionUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuestionId
+
+	if len(errors) > 0 {
+		return GetQuestionUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuestionUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetQuestionUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetQuestionUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuestionUsageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuestionUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetQuestionUsageRequestValidationError is the validation error returned by
+// GetQuestionUsageRequest.Validate if the designated constraints aren't met.
+type GetQuestionUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuestionUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuestionUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuestionUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuestionUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuestionUsageRequestValidationError) ErrorName() string {
+	return "GetQuestionUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQuestionUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuestionUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuestionUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuestionUsageRequestValidationError{}
+
+// Validate checks the field values on GetQuestionUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetQuestionUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuestionUsageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuestionUsageResponseMultiError, or nil if none found.
+func (m *GetQuestionUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuestionUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetQuestionUsageResponseValidationError{
+						field:  fmt.Sprintf("Usages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetQuestionUsageResponseValidationError{
+						field:  fmt.Sprintf("Usages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetQuestionUsageResponseValidationError{
+					field:  fmt.Sprintf("Usages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetQuestionUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuestionUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetQuestionUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetQuestionUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuestionUsageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuestionUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetQuestionUsageResponseValidationError is the validation error returned by
+// GetQuestionUsageResponse.Validate if the designated constraints aren't met.
+type GetQuestionUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuestionUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuestionUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuestionUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuestionUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuestionUsageResponseValidationError) ErrorName() string {
+	return "GetQuestionUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetQuestionUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuestionUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuestionUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuestionUsageResponseValidationError{}
+
+// Validate checks the field values on UpdateQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateQuestionRequestMultiError, or nil if none found.
+func (m *UpdateQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for QuestionId
+
+	if l := utf8.RuneCountInString(m.GetQuestion()); l < 3 || l > 200 {
+		err := UpdateQuestionRequestValidationError{
+			field:  "Question",
+			reason: "value length must be between 3 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuestionMediaUrl() != "" {
+
+		if uri, err := url.Parse(m.GetQuestionMediaUrl()); err != nil {
+			err = UpdateQuestionRequestValidationError{
+				field:  "QuestionMediaUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateQuestionRequestValidationError{
+				field:  "QuestionMediaUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetAnswer()); l < 3 || l > 100 {
+		err := UpdateQuestionRequestValidationError{
+			field:  "Answer",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnswerMediaUrl() != "" {
+
+		if uri, err := url.Parse(m.GetAnswerMediaUrl()); err != nil {
+			err = UpdateQuestionRequestValidationError{
+				field:  "AnswerMediaUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateQuestionRequestValidationError{
+				field:  "AnswerMediaUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
 	}
 
-	var errors []error
-
-	if l := utf8.RuneCountInString(m.GetQuestion()); l < 3 || l > 200 {
-		err := CreateQuestionRequestValidationError{
-			field:  "Question",
-			reason: "value length must be between 3 and 200 runes, inclusive",
+	if len(m.GetAnswerAlternatives()) > 10 {
+		err := UpdateQuestionRequestValidationError{
+			field:  "AnswerAlternatives",
+			reason: "value must contain no more than 10 item(s)",
 		}
 		if !all {
 			return err
@@ -337,22 +1931,28 @@ func (m *CreateQuestionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetQuestionMediaUrl() != "" {
+	_UpdateQuestionRequest_AnswerAlternatives_Unique := make(map[string]struct{}, len(m.GetAnswerAlternatives()))
 
-		if uri, err := url.Parse(m.GetQuestionMediaUrl()); err != nil {
-			err = CreateQuestionRequestValidationError{
-				field:  "QuestionMediaUrl",
-				reason: "value must be a valid URI",
-				cause:  err,
+	for idx, item := range m.GetAnswerAlternatives() {
+		_, _ = idx, item
+
+		if _, exists := _UpdateQuestionRequest_AnswerAlternatives_Unique[item]; exists {
+			err := UpdateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerAlternatives[%v]", idx),
+				reason: "repeated value must contain unique items",
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		} else if !uri.IsAbs() {
-			err := CreateQuestionRequestValidationError{
-				field:  "QuestionMediaUrl",
-				reason: "value must be absolute",
+		} else {
+			_UpdateQuestionRequest_AnswerAlternatives_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := UpdateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerAlternatives[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
 			}
 			if !all {
 				return err
@@ -362,10 +1962,10 @@ func (m *CreateQuestionRequest) validate(all bool) error {
 
 	}
 
-	if l := utf8.RuneCountInString(m.GetAnswer()); l < 3 || l > 100 {
-		err := CreateQuestionRequestValidationError{
-			field:  "Answer",
-			reason: "value length must be between 3 and 100 runes, inclusive",
+	if len(m.GetAnswerWrongVariants()) > 10 {
+		err := UpdateQuestionRequestValidationError{
+			field:  "AnswerWrongVariants",
+			reason: "value must contain no more than 10 item(s)",
 		}
 		if !all {
 			return err
@@ -373,22 +1973,28 @@ func (m *CreateQuestionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetAnswerMediaUrl() != "" {
+	_UpdateQuestionRequest_AnswerWrongVariants_Unique := make(map[string]struct{}, len(m.GetAnswerWrongVariants()))
 
-		if uri, err := url.Parse(m.GetAnswerMediaUrl()); err != nil {
-			err = CreateQuestionRequestValidationError{
-				field:  "AnswerMediaUrl",
-				reason: "value must be a valid URI",
-				cause:  err,
+	for idx, item := range m.GetAnswerWrongVariants() {
+		_, _ = idx, item
+
+		if _, exists := _UpdateQuestionRequest_AnswerWrongVariants_Unique[item]; exists {
+			err := UpdateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerWrongVariants[%v]", idx),
+				reason: "repeated value must contain unique items",
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		} else if !uri.IsAbs() {
-			err := CreateQuestionRequestValidationError{
-				field:  "AnswerMediaUrl",
-				reason: "value must be absolute",
+		} else {
+			_UpdateQuestionRequest_AnswerWrongVariants_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := UpdateQuestionRequestValidationError{
+				field:  fmt.Sprintf("AnswerWrongVariants[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
 			}
 			if !all {
 				return err
@@ -398,20 +2004,134 @@ func (m *CreateQuestionRequest) validate(all bool) error {
 
 	}
 
+	if len(m.GetQuestionParts()) > 10 {
+		err := UpdateQuestionRequestValidationError{
+			field:  "QuestionParts",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetQuestionParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateQuestionRequestValidationError{
+						field:  fmt.Sprintf("QuestionParts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateQuestionRequestValidationError{
+						field:  fmt.Sprintf("QuestionParts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateQuestionRequestValidationError{
+					field:  fmt.Sprintf("QuestionParts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuestionMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateQuestionRequestValidationError{
+					field:  "QuestionMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateQuestionRequestValidationError{
+					field:  "QuestionMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuestionMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateQuestionRequestValidationError{
+				field:  "QuestionMediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnswerMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateQuestionRequestValidationError{
+					field:  "AnswerMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateQuestionRequestValidationError{
+					field:  "AnswerMediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswerMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateQuestionRequestValidationError{
+				field:  "AnswerMediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
+		err := UpdateQuestionRequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return CreateQuestionRequestMultiError(errors)
+		return UpdateQuestionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateQuestionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateQuestionRequest.ValidateAll() if the designated
+// UpdateQuestionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateQuestionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateQuestionRequestMultiError []error
+type UpdateQuestionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateQuestionRequestMultiError) Error() string {
+func (m UpdateQuestionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -420,11 +2140,11 @@ func (m CreateQuestionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateQuestionRequestMultiError) AllErrors() []error { return m }
+func (m UpdateQuestionRequestMultiError) AllErrors() []error { return m }
 
-// CreateQuestionRequestValidationError is the validation error returned by
-// CreateQuestionRequest.Validate if the designated constraints aren't met.
-type CreateQuestionRequestValidationError struct {
+// UpdateQuestionRequestValidationError is the validation error returned by
+// UpdateQuestionRequest.Validate if the designated constraints aren't met.
+type UpdateQuestionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -432,24 +2152,24 @@ type CreateQuestionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateQuestionRequestValidationError) Field() string { return e.field }
+func (e UpdateQuestionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateQuestionRequestValidationError) Reason() string { return e.reason }
+func (e UpdateQuestionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateQuestionRequestValidationError) Cause() error { return e.cause }
+func (e UpdateQuestionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateQuestionRequestValidationError) Key() bool { return e.key }
+func (e UpdateQuestionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateQuestionRequestValidationError) ErrorName() string {
-	return "CreateQuestionRequestValidationError"
+func (e UpdateQuestionRequestValidationError) ErrorName() string {
+	return "UpdateQuestionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateQuestionRequestValidationError) Error() string {
+func (e UpdateQuestionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -461,14 +2181,14 @@ func (e CreateQuestionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateQuestionRequest.%s: %s%s",
+		"invalid %sUpdateQuestionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateQuestionRequestValidationError{}
+var _ error = UpdateQuestionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -476,24 +2196,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateQuestionRequestValidationError{}
+} = UpdateQuestionRequestValidationError{}
 
-// Validate checks the field values on CreateQuestionResponse with the rules
+// Validate checks the field values on DeleteQuestionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateQuestionResponse) Validate() error {
+func (m *DeleteQuestionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateQuestionResponse with the rules
+// ValidateAll checks the field values on DeleteQuestionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateQuestionResponseMultiError, or nil if none found.
-func (m *CreateQuestionResponse) ValidateAll() error {
+// DeleteQuestionRequestMultiError, or nil if none found.
+func (m *DeleteQuestionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateQuestionResponse) validate(all bool) error {
+func (m *DeleteQuestionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -503,19 +2223,19 @@ func (m *CreateQuestionResponse) validate(all bool) error {
 	// no validation rules for QuestionId
 
 	if len(errors) > 0 {
-		return CreateQuestionResponseMultiError(errors)
+		return DeleteQuestionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateQuestionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateQuestionResponse.ValidateAll() if the designated
+// DeleteQuestionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteQuestionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateQuestionResponseMultiError []error
+type DeleteQuestionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateQuestionResponseMultiError) Error() string {
+func (m DeleteQuestionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -524,11 +2244,11 @@ func (m CreateQuestionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateQuestionResponseMultiError) AllErrors() []error { return m }
+func (m DeleteQuestionRequestMultiError) AllErrors() []error { return m }
 
-// CreateQuestionResponseValidationError is the validation error returned by
-// CreateQuestionResponse.Validate if the designated constraints aren't met.
-type CreateQuestionResponseValidationError struct {
+// DeleteQuestionRequestValidationError is the validation error returned by
+// DeleteQuestionRequest.Validate if the designated constraints aren't met.
+type DeleteQuestionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -536,24 +2256,24 @@ type CreateQuestionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateQuestionResponseValidationError) Field() string { return e.field }
+func (e DeleteQuestionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateQuestionResponseValidationError) Reason() string { return e.reason }
+func (e DeleteQuestionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateQuestionResponseValidationError) Cause() error { return e.cause }
+func (e DeleteQuestionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateQuestionResponseValidationError) Key() bool { return e.key }
+func (e DeleteQuestionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateQuestionResponseValidationError) ErrorName() string {
-	return "CreateQuestionResponseValidationError"
+func (e DeleteQuestionRequestValidationError) ErrorName() string {
+	return "DeleteQuestionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateQuestionResponseValidationError) Error() string {
+func (e DeleteQuestionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -565,14 +2285,14 @@ func (e CreateQuestionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateQuestionResponse.%s: %s%s",
+		"invalid %sDeleteQuestionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateQuestionResponseValidationError{}
+var _ error = DeleteQuestionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -580,46 +2300,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateQuestionResponseValidationError{}
+} = DeleteQuestionRequestValidationError{}
 
-// Validate checks the field values on GetQuestionRequest with the rules
+// Validate checks the field values on SearchQuestionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetQuestionRequest) Validate() error {
+func (m *SearchQuestionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetQuestionRequest with the rules
+// ValidateAll checks the field values on SearchQuestionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetQuestionRequestMultiError, or nil if none found.
-func (m *GetQuestionRequest) ValidateAll() error {
+// SearchQuestionsRequestMultiError, or nil if none found.
+func (m *SearchQuestionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetQuestionRequest) validate(all bool) error {
+func (m *SearchQuestionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for QuestionId
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 3 || l > 200 {
+		err := SearchQuestionsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 3 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
+		err := SearchQuestionsRequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 50 {
+		err := SearchQuestionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetQuestionRequestMultiError(errors)
+		return SearchQuestionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetQuestionRequestMultiError is an error wrapping multiple validation errors
-// returned by GetQuestionRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetQuestionRequestMultiError []error
+// SearchQuestionsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchQuestionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchQuestionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetQuestionRequestMultiError) Error() string {
+func (m SearchQuestionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -628,11 +2379,11 @@ func (m GetQuestionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetQuestionRequestMultiError) AllErrors() []error { return m }
+func (m SearchQuestionsRequestMultiError) AllErrors() []error { return m }
 
-// GetQuestionRequestValidationError is the validation error returned by
-// GetQuestionRequest.Validate if the designated constraints aren't met.
-type GetQuestionRequestValidationError struct {
+// SearchQuestionsRequestValidationError is the validation error returned by
+// SearchQuestionsRequest.Validate if the designated constraints aren't met.
+type SearchQuestionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -640,24 +2391,24 @@ type GetQuestionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetQuestionRequestValidationError) Field() string { return e.field }
+func (e SearchQuestionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetQuestionRequestValidationError) Reason() string { return e.reason }
+func (e SearchQuestionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetQuestionRequestValidationError) Cause() error { return e.cause }
+func (e SearchQuestionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetQuestionRequestValidationError) Key() bool { return e.key }
+func (e SearchQuestionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetQuestionRequestValidationError) ErrorName() string {
-	return "GetQuestionRequestValidationError"
+func (e SearchQuestionsRequestValidationError) ErrorName() string {
+	return "SearchQuestionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetQuestionRequestValidationError) Error() string {
+func (e SearchQuestionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -669,14 +2420,14 @@ func (e GetQuestionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetQuestionRequest.%s: %s%s",
+		"invalid %sSearchQuestionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetQuestionRequestValidationError{}
+var _ error = SearchQuestionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -684,73 +2435,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetQuestionRequestValidationError{}
+} = SearchQuestionsRequestValidationError{}
 
-// Validate checks the field values on GetQuestionResponse with the rules
+// Validate checks the field values on SearchQuestionsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetQuestionResponse) Validate() error {
+func (m *SearchQuestionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetQuestionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchQuestionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetQuestionResponseMultiError, or nil if none found.
-func (m *GetQuestionResponse) ValidateAll() error {
+// SearchQuestionsResponseMultiError, or nil if none found.
+func (m *SearchQuestionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetQuestionResponse) validate(all bool) error {
+func (m *SearchQuestionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetQuestion()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetQuestionResponseValidationError{
-					field:  "Question",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetQuestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchQuestionsResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchQuestionsResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetQuestionResponseValidationError{
-					field:  "Question",
+				return SearchQuestionsResponseValidationError{
+					field:  fmt.Sprintf("Questions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuestion()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetQuestionResponseValidationError{
-				field:  "Question",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetQuestionResponseMultiError(errors)
+		return SearchQuestionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetQuestionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetQuestionResponse.ValidateAll() if the designated
+// SearchQuestionsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchQuestionsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetQuestionResponseMultiError []error
+type SearchQuestionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetQuestionResponseMultiError) Error() string {
+func (m SearchQuestionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -759,11 +2515,11 @@ func (m GetQuestionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetQuestionResponseMultiError) AllErrors() []error { return m }
+func (m SearchQuestionsResponseMultiError) AllErrors() []error { return m }
 
-// GetQuestionResponseValidationError is the validation error returned by
-// GetQuestionResponse.Validate if the designated constraints aren't met.
-type GetQuestionResponseValidationError struct {
+// SearchQuestionsResponseValidationError is the validation error returned by
+// SearchQuestionsResponse.Validate if the designated constraints aren't met.
+type SearchQuestionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -771,24 +2527,24 @@ type GetQuestionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetQuestionResponseValidationError) Field() string { return e.field }
+func (e SearchQuestionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetQuestionResponseValidationError) Reason() string { return e.reason }
+func (e SearchQuestionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetQuestionResponseValidationError) Cause() error { return e.cause }
+func (e SearchQuestionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetQuestionResponseValidationError) Key() bool { return e.key }
+func (e SearchQuestionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetQuestionResponseValidationError) ErrorName() string {
-	return "GetQuestionResponseValidationError"
+func (e SearchQuestionsResponseValidationError) ErrorName() string {
+	return "SearchQuestionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetQuestionResponseValidationError) Error() string {
+func (e SearchQuestionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -800,14 +2556,14 @@ func (e GetQuestionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetQuestionResponse.%s: %s%s",
+		"invalid %sSearchQuestionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetQuestionResponseValidationError{}
+var _ error = SearchQuestionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -815,4 +2571,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetQuestionResponseValidationError{}
+} = SearchQuestionsResponseValidationError{}
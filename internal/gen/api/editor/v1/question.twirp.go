@@ -16,6 +16,8 @@ import proto "google.golang.org/protobuf/proto"
 import twirp "github.com/twitchtv/twirp"
 import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
 
+import google_protobuf3 "google.golang.org/protobuf/types/known/emptypb"
+
 // Version compatibility assertion.
 // If the constant is not defined in the package, that likely means
 // the package needs to be updated to work with this generated code.
@@ -29,7 +31,22 @@ const _ = twirp.TwirpPackageMinVersion_8_1_0
 type QuestionService interface {
 	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
 
+	// GetQuestion returns question to its author or to players who can read any pack the question is used in.
+	// Answer is returned only to author of the question.
 	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
+
+	GetQuestionUsage(context.Context, *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error)
+
+	// SearchQuestions searches questions by text, most relevant first.
+	// Only own questions and questions used in public packs are searched.
+	SearchQuestions(context.Context, *SearchQuestionsRequest) (*SearchQuestionsResponse, error)
+
+	// UpdateQuestion updates question and its answer.
+	// Question used in published pack cannot be updated.
+	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*google_protobuf3.Empty, error)
+
+	// DeleteQuestion deletes question which is not used in any pack.
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*google_protobuf3.Empty, error)
 }
 
 // ===============================
@@ -38,7 +55,7 @@ type QuestionService interface {
 
 type questionServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [6]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -66,9 +83,13 @@ func NewQuestionServiceProtobufClient(baseURL string, client HTTPClient, opts ..
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "QuestionService")
-	urls := [2]string{
+	urls := [6]string{
 		serviceURL + "CreateQuestion",
 		serviceURL + "GetQuestion",
+		serviceURL + "GetQuestionUsage",
+		serviceURL + "SearchQuestions",
+		serviceURL + "UpdateQuestion",
+		serviceURL + "DeleteQuestion",
 	}
 
 	return &questionServiceProtobufClient{
@@ -171,13 +192,197 @@ func (c *questionServiceProtobufClient) callGetQuestion(ctx context.Context, in
 	return out, nil
 }
 
+func (c *questionServiceProtobufClient) GetQuestionUsage(ctx context.Context, in *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetQuestionUsage")
+	caller := c.callGetQuestionUsage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQuestionUsageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionUsageRequest) when calling interceptor")
+					}
+					return c.callGetQuestionUsage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQuestionUsageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionUsageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceProtobufClient) callGetQuestionUsage(ctx context.Context, in *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+	out := new(GetQuestionUsageResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceProtobufClient) SearchQuestions(ctx context.Context, in *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchQuestions")
+	caller := c.callSearchQuestions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchQuestionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchQuestionsRequest) when calling interceptor")
+					}
+					return c.callSearchQuestions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchQuestionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchQuestionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceProtobufClient) callSearchQuestions(ctx context.Context, in *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+	out := new(SearchQuestionsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceProtobufClient) UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuestion")
+	caller := c.callUpdateQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuestionRequest) when calling interceptor")
+					}
+					return c.callUpdateQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceProtobufClient) callUpdateQuestion(ctx context.Context, in *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceProtobufClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteQuestion")
+	caller := c.callDeleteQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteQuestionRequest) when calling interceptor")
+					}
+					return c.callDeleteQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceProtobufClient) callDeleteQuestion(ctx context.Context, in *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===========================
 // QuestionService JSON Client
 // ===========================
 
 type questionServiceJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [6]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -205,9 +410,13 @@ func NewQuestionServiceJSONClient(baseURL string, client HTTPClient, opts ...twi
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "QuestionService")
-	urls := [2]string{
+	urls := [6]string{
 		serviceURL + "CreateQuestion",
 		serviceURL + "GetQuestion",
+		serviceURL + "GetQuestionUsage",
+		serviceURL + "SearchQuestions",
+		serviceURL + "UpdateQuestion",
+		serviceURL + "DeleteQuestion",
 	}
 
 	return &questionServiceJSONClient{
@@ -259,168 +468,1084 @@ func (c *questionServiceJSONClient) callCreateQuestion(ctx context.Context, in *
 		return nil, err
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceJSONClient) GetQuestion(ctx context.Context, in *GetQuestionRequest) (*GetQuestionResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetQuestion")
+	caller := c.callGetQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetQuestionRequest) (*GetQuestionResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionRequest) when calling interceptor")
+					}
+					return c.callGetQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceJSONClient) callGetQuestion(ctx context.Context, in *GetQuestionRequest) (*GetQuestionResponse, error) {
+	out := new(GetQuestionResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceJSONClient) GetQuestionUsage(ctx context.Context, in *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetQuestionUsage")
+	caller := c.callGetQuestionUsage
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQuestionUsageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionUsageRequest) when calling interceptor")
+					}
+					return c.callGetQuestionUsage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQuestionUsageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionUsageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceJSONClient) callGetQuestionUsage(ctx context.Context, in *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+	out := new(GetQuestionUsageResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceJSONClient) SearchQuestions(ctx context.Context, in *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchQuestions")
+	caller := c.callSearchQuestions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchQuestionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchQuestionsRequest) when calling interceptor")
+					}
+					return c.callSearchQuestions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchQuestionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchQuestionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceJSONClient) callSearchQuestions(ctx context.Context, in *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+	out := new(SearchQuestionsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceJSONClient) UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuestion")
+	caller := c.callUpdateQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuestionRequest) when calling interceptor")
+					}
+					return c.callUpdateQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceJSONClient) callUpdateQuestion(ctx context.Context, in *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionServiceJSONClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteQuestion")
+	caller := c.callDeleteQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteQuestionRequest) when calling interceptor")
+					}
+					return c.callDeleteQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionServiceJSONClient) callDeleteQuestion(ctx context.Context, in *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==============================
+// QuestionService Server Handler
+// ==============================
+
+type questionServiceServer struct {
+	QuestionService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewQuestionServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewQuestionServiceServer(svc QuestionService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &questionServiceServer{
+		QuestionService:  svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *questionServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *questionServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// QuestionServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const QuestionServicePathPrefix = "/twirp/editor.v1.QuestionService/"
+
+func (s *questionServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "editor.v1.QuestionService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateQuestion":
+		s.serveCreateQuestion(ctx, resp, req)
+		return
+	case "GetQuestion":
+		s.serveGetQuestion(ctx, resp, req)
+		return
+	case "GetQuestionUsage":
+		s.serveGetQuestionUsage(ctx, resp, req)
+		return
+	case "SearchQuestions":
+		s.serveSearchQuestions(ctx, resp, req)
+		return
+	case "UpdateQuestion":
+		s.serveUpdateQuestion(ctx, resp, req)
+		return
+	case "DeleteQuestion":
+		s.serveDeleteQuestion(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *questionServiceServer) serveCreateQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateQuestionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateQuestionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *questionServiceServer) serveCreateQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateQuestionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuestionService.CreateQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateQuestionRequest) when calling interceptor")
+					}
+					return s.QuestionService.CreateQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateQuestionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateQuestionResponse and nil error while calling CreateQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *questionServiceServer) serveCreateQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateQuestionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuestionService.CreateQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateQuestionRequest) when calling interceptor")
+					}
+					return s.QuestionService.CreateQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateQuestionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateQuestionResponse and nil error while calling CreateQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *questionServiceServer) serveGetQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetQuestionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetQuestionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *questionServiceServer) serveGetQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetQuestionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuestionService.GetQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQuestionRequest) (*GetQuestionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionRequest) when calling interceptor")
+					}
+					return s.QuestionService.GetQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetQuestionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQuestionResponse and nil error while calling GetQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *questionServiceServer) serveGetQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetQuestionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuestionService.GetQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQuestionRequest) (*GetQuestionResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionRequest) when calling interceptor")
+					}
+					return s.QuestionService.GetQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQuestionResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetQuestionResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQuestionResponse and nil error while calling GetQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *questionServiceServer) serveGetQuestionUsage(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetQuestionUsageJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetQuestionUsageProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *questionServiceServer) serveGetQuestionUsageJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQuestionUsage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetQuestionUsageRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuestionService.GetQuestionUsage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQuestionUsageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionUsageRequest) when calling interceptor")
+					}
+					return s.QuestionService.GetQuestionUsage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQuestionUsageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionUsageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetQuestionUsageResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQuestionUsageResponse and nil error while calling GetQuestionUsage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *questionServiceServer) serveGetQuestionUsageProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetQuestionUsage")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetQuestionUsageRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuestionService.GetQuestionUsage
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetQuestionUsageRequest) (*GetQuestionUsageResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetQuestionUsageRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionUsageRequest) when calling interceptor")
+					}
+					return s.QuestionService.GetQuestionUsage(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetQuestionUsageResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionUsageResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetQuestionUsageResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQuestionUsageResponse and nil error while calling GetQuestionUsage. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *questionServiceServer) serveSearchQuestions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSearchQuestionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSearchQuestionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *questionServiceServer) serveSearchQuestionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SearchQuestions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SearchQuestionsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
 
-func (c *questionServiceJSONClient) GetQuestion(ctx context.Context, in *GetQuestionRequest) (*GetQuestionResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
-	ctx = ctxsetters.WithMethodName(ctx, "GetQuestion")
-	caller := c.callGetQuestion
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *GetQuestionRequest) (*GetQuestionResponse, error) {
-			resp, err := c.interceptor(
+	handler := s.QuestionService.SearchQuestions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetQuestionRequest)
+					typedReq, ok := req.(*SearchQuestionsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SearchQuestionsRequest) when calling interceptor")
 					}
-					return c.callGetQuestion(ctx, typedReq)
+					return s.QuestionService.SearchQuestions(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetQuestionResponse)
+				typedResp, ok := resp.(*SearchQuestionsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchQuestionsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *questionServiceJSONClient) callGetQuestion(ctx context.Context, in *GetQuestionRequest) (*GetQuestionResponse, error) {
-	out := new(GetQuestionResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *SearchQuestionsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchQuestionsResponse and nil error while calling SearchQuestions. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
-
-// ==============================
-// QuestionService Server Handler
-// ==============================
-
-type questionServiceServer struct {
-	QuestionService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewQuestionServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewQuestionServiceServer(svc QuestionService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
 	}
 
-	return &questionServiceServer{
-		QuestionService:  svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *questionServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+func (s *questionServiceServer) serveSearchQuestionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SearchQuestions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *questionServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(SearchQuestionsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// QuestionServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const QuestionServicePathPrefix = "/twirp/editor.v1.QuestionService/"
+	handler := s.QuestionService.SearchQuestions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchQuestionsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchQuestionsRequest) when calling interceptor")
+					}
+					return s.QuestionService.SearchQuestions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchQuestionsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchQuestionsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *questionServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "QuestionService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *SearchQuestionsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchQuestionsResponse and nil error while calling SearchQuestions. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "editor.v1.QuestionService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "CreateQuestion":
-		s.serveCreateQuestion(ctx, resp, req)
-		return
-	case "GetQuestion":
-		s.serveGetQuestion(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *questionServiceServer) serveCreateQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *questionServiceServer) serveUpdateQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -428,9 +1553,9 @@ func (s *questionServiceServer) serveCreateQuestion(ctx context.Context, resp ht
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateQuestionJSON(ctx, resp, req)
+		s.serveUpdateQuestionJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateQuestionProtobuf(ctx, resp, req)
+		s.serveUpdateQuestionProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -438,9 +1563,9 @@ func (s *questionServiceServer) serveCreateQuestion(ctx context.Context, resp ht
 	}
 }
 
-func (s *questionServiceServer) serveCreateQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *questionServiceServer) serveUpdateQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateQuestion")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuestion")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -453,29 +1578,29 @@ func (s *questionServiceServer) serveCreateQuestionJSON(ctx context.Context, res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateQuestionRequest)
+	reqContent := new(UpdateQuestionRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.QuestionService.CreateQuestion
+	handler := s.QuestionService.UpdateQuestion
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+		handler = func(ctx context.Context, req *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateQuestionRequest)
+					typedReq, ok := req.(*UpdateQuestionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateQuestionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuestionRequest) when calling interceptor")
 					}
-					return s.QuestionService.CreateQuestion(ctx, typedReq)
+					return s.QuestionService.UpdateQuestion(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateQuestionResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuestionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -484,7 +1609,7 @@ func (s *questionServiceServer) serveCreateQuestionJSON(ctx context.Context, res
 	}
 
 	// Call service method
-	var respContent *CreateQuestionResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -495,7 +1620,7 @@ func (s *questionServiceServer) serveCreateQuestionJSON(ctx context.Context, res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateQuestionResponse and nil error while calling CreateQuestion. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling UpdateQuestion. nil responses are not supported"))
 		return
 	}
 
@@ -521,9 +1646,9 @@ func (s *questionServiceServer) serveCreateQuestionJSON(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *questionServiceServer) serveCreateQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *questionServiceServer) serveUpdateQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateQuestion")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateQuestion")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -535,28 +1660,28 @@ func (s *questionServiceServer) serveCreateQuestionProtobuf(ctx context.Context,
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateQuestionRequest)
+	reqContent := new(UpdateQuestionRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.QuestionService.CreateQuestion
+	handler := s.QuestionService.UpdateQuestion
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+		handler = func(ctx context.Context, req *UpdateQuestionRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateQuestionRequest)
+					typedReq, ok := req.(*UpdateQuestionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateQuestionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateQuestionRequest) when calling interceptor")
 					}
-					return s.QuestionService.CreateQuestion(ctx, typedReq)
+					return s.QuestionService.UpdateQuestion(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateQuestionResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateQuestionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -565,7 +1690,7 @@ func (s *questionServiceServer) serveCreateQuestionProtobuf(ctx context.Context,
 	}
 
 	// Call service method
-	var respContent *CreateQuestionResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -576,7 +1701,7 @@ func (s *questionServiceServer) serveCreateQuestionProtobuf(ctx context.Context,
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateQuestionResponse and nil error while calling CreateQuestion. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling UpdateQuestion. nil responses are not supported"))
 		return
 	}
 
@@ -600,7 +1725,7 @@ func (s *questionServiceServer) serveCreateQuestionProtobuf(ctx context.Context,
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *questionServiceServer) serveGetQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *questionServiceServer) serveDeleteQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -608,9 +1733,9 @@ func (s *questionServiceServer) serveGetQuestion(ctx context.Context, resp http.
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetQuestionJSON(ctx, resp, req)
+		s.serveDeleteQuestionJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetQuestionProtobuf(ctx, resp, req)
+		s.serveDeleteQuestionProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -618,9 +1743,9 @@ func (s *questionServiceServer) serveGetQuestion(ctx context.Context, resp http.
 	}
 }
 
-func (s *questionServiceServer) serveGetQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *questionServiceServer) serveDeleteQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetQuestion")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteQuestion")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -633,29 +1758,29 @@ func (s *questionServiceServer) serveGetQuestionJSON(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(GetQuestionRequest)
+	reqContent := new(DeleteQuestionRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.QuestionService.GetQuestion
+	handler := s.QuestionService.DeleteQuestion
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetQuestionRequest) (*GetQuestionResponse, error) {
+		handler = func(ctx context.Context, req *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetQuestionRequest)
+					typedReq, ok := req.(*DeleteQuestionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteQuestionRequest) when calling interceptor")
 					}
-					return s.QuestionService.GetQuestion(ctx, typedReq)
+					return s.QuestionService.DeleteQuestion(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetQuestionResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -664,7 +1789,7 @@ func (s *questionServiceServer) serveGetQuestionJSON(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *GetQuestionResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -675,7 +1800,7 @@ func (s *questionServiceServer) serveGetQuestionJSON(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQuestionResponse and nil error while calling GetQuestion. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling DeleteQuestion. nil responses are not supported"))
 		return
 	}
 
@@ -701,9 +1826,9 @@ func (s *questionServiceServer) serveGetQuestionJSON(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *questionServiceServer) serveGetQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *questionServiceServer) serveDeleteQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetQuestion")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteQuestion")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -715,28 +1840,28 @@ func (s *questionServiceServer) serveGetQuestionProtobuf(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(GetQuestionRequest)
+	reqContent := new(DeleteQuestionRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.QuestionService.GetQuestion
+	handler := s.QuestionService.DeleteQuestion
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *GetQuestionRequest) (*GetQuestionResponse, error) {
+		handler = func(ctx context.Context, req *DeleteQuestionRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*GetQuestionRequest)
+					typedReq, ok := req.(*DeleteQuestionRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*GetQuestionRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteQuestionRequest) when calling interceptor")
 					}
-					return s.QuestionService.GetQuestion(ctx, typedReq)
+					return s.QuestionService.DeleteQuestion(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*GetQuestionResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*GetQuestionResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -745,7 +1870,7 @@ func (s *questionServiceServer) serveGetQuestionProtobuf(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *GetQuestionResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -756,7 +1881,7 @@ func (s *questionServiceServer) serveGetQuestionProtobuf(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetQuestionResponse and nil error while calling GetQuestion. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling DeleteQuestion. nil responses are not supported"))
 		return
 	}
 
@@ -781,7 +1906,7 @@ func (s *questionServiceServer) serveGetQuestionProtobuf(ctx context.Context, re
 }
 
 func (s *questionServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor2, 0
+	return twirpFileDescriptor3, 0
 }
 
 func (s *questionServiceServer) ProtocGenTwirpVersion() string {
@@ -795,35 +1920,93 @@ func (s *questionServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "editor.v1", "QuestionService")
 }
 
-var twirpFileDescriptor2 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xd5, 0xe6, 0xc3, 0xc4, 0x63, 0x29, 0x84, 0x29, 0x94, 0x95, 0x11, 0x34, 0xf5, 0x01, 0x95,
-	0x8b, 0xad, 0x04, 0xf5, 0x50, 0xf5, 0x84, 0x91, 0x40, 0x95, 0xe0, 0x80, 0xa1, 0x17, 0x2e, 0x91,
-	0x5b, 0x2f, 0x65, 0xa5, 0x24, 0x1b, 0xd6, 0x1b, 0xc3, 0x4f, 0xe0, 0x17, 0xf1, 0x1b, 0x90, 0xb8,
-	0x70, 0xe0, 0xd7, 0xe4, 0x84, 0xba, 0xeb, 0x5d, 0xec, 0x84, 0xaf, 0xdb, 0xec, 0xcc, 0xdb, 0xb7,
-	0xef, 0xbd, 0xb1, 0x81, 0xb2, 0x82, 0x2b, 0x21, 0x93, 0x6a, 0x92, 0x7c, 0x58, 0xb3, 0x52, 0x71,
-	0xb1, 0x8c, 0x57, 0x52, 0x28, 0x81, 0xbe, 0x99, 0xc4, 0xd5, 0x24, 0xbc, 0x5b, 0xe5, 0x73, 0x5e,
-	0xe4, 0x8a, 0x25, 0xb6, 0x30, 0x98, 0xf0, 0xe0, 0x4a, 0x88, 0xab, 0x39, 0x4b, 0xf4, 0xe9, 0x62,
-	0xfd, 0x2e, 0x51, 0x7c, 0xc1, 0x4a, 0x95, 0x2f, 0x56, 0x06, 0x10, 0x9d, 0x81, 0xf7, 0x64, 0x59,
-	0x7e, 0x64, 0x12, 0x87, 0xd0, 0xe1, 0x05, 0x25, 0x63, 0x72, 0xd4, 0xcf, 0x3a, 0xbc, 0x40, 0x84,
-	0x9e, 0x62, 0x9f, 0x14, 0xed, 0x8c, 0xc9, 0x91, 0x9f, 0xe9, 0x1a, 0xef, 0x81, 0xbf, 0x60, 0x05,
-	0xcf, 0x67, 0x6b, 0x39, 0xa7, 0x5d, 0x3d, 0x18, 0xe8, 0xc6, 0xb9, 0x9c, 0x47, 0xdf, 0x08, 0x0c,
-	0x5e, 0xd5, 0x12, 0xff, 0x8b, 0xed, 0x11, 0x78, 0xb9, 0x7e, 0x5b, 0x53, 0x05, 0xd3, 0x5b, 0xb1,
-	0x73, 0x14, 0x1b, 0x51, 0x59, 0x0d, 0xc0, 0x7d, 0xf0, 0xf2, 0xb5, 0x7a, 0x2f, 0x24, 0xed, 0x69,
-	0x82, 0xfa, 0xd4, 0x16, 0xd4, 0x6f, 0x0b, 0xc2, 0x53, 0x08, 0x2e, 0x25, 0xcb, 0x15, 0x9b, 0x5d,
-	0xbb, 0xa6, 0x53, 0xfd, 0x48, 0x18, 0x9b, 0x48, 0x62, 0x1b, 0x49, 0xfc, 0xc6, 0x46, 0x92, 0x81,
-	0x81, 0x5f, 0x37, 0xa2, 0x1f, 0x04, 0xee, 0x3c, 0xd5, 0x47, 0xeb, 0x29, 0x63, 0x7a, 0x01, 0xf8,
-	0x10, 0x06, 0x76, 0x13, 0xda, 0xa0, 0x9f, 0xc2, 0x26, 0xbd, 0x21, 0xfb, 0xa3, 0x2e, 0xfd, 0x4a,
-	0x32, 0x37, 0xc3, 0x13, 0x40, 0x5b, 0xcf, 0x7e, 0x89, 0xd4, 0x01, 0xa4, 0xc1, 0x26, 0x1d, 0x48,
-	0xef, 0x33, 0x21, 0xdf, 0x09, 0xc9, 0x46, 0x16, 0xf6, 0xd2, 0x2a, 0x3f, 0x6c, 0x25, 0xe3, 0xa7,
-	0xfe, 0x26, 0xf5, 0x64, 0x6f, 0xd4, 0xa5, 0x85, 0x4b, 0xe4, 0x18, 0x46, 0xa6, 0x6a, 0x70, 0xf7,
-	0x76, 0xb9, 0x87, 0x06, 0x64, 0x99, 0xa3, 0x13, 0xd8, 0xdf, 0x76, 0x55, 0xae, 0xc4, 0xb2, 0x64,
-	0x78, 0x00, 0x81, 0x93, 0xeb, 0x56, 0x07, 0xb6, 0x75, 0x56, 0x44, 0xc7, 0x80, 0xcf, 0x99, 0xda,
-	0x4e, 0xe3, 0x9f, 0xd7, 0x9e, 0xc1, 0x5e, 0xeb, 0x5a, 0xfd, 0x5c, 0xb2, 0x95, 0x62, 0x30, 0xdd,
-	0x6b, 0xac, 0xdf, 0xc1, 0x1d, 0x68, 0xfa, 0x85, 0xc0, 0x4d, 0xdb, 0x7e, 0xcd, 0x64, 0xc5, 0x2f,
-	0x19, 0x9e, 0xc3, 0xb0, 0xed, 0x06, 0xc7, 0x0d, 0x92, 0xdf, 0xae, 0x2f, 0x3c, 0xfc, 0x0b, 0xa2,
-	0xd6, 0xf6, 0x02, 0x82, 0x86, 0x64, 0xbc, 0xdf, 0xb8, 0xb1, 0x9b, 0x40, 0xf8, 0xe0, 0x4f, 0x63,
-	0xc3, 0x96, 0xde, 0x7e, 0x8b, 0xee, 0x1f, 0x3e, 0x35, 0x55, 0x35, 0xb9, 0xf0, 0xf4, 0xf7, 0xf7,
-	0xf8, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x15, 0x05, 0xd1, 0xe0, 0x03, 0x00, 0x00,
+var twirpFileDescriptor3 = []byte{
+	// 1385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0xce, 0x52, 0xa2, 0x4c, 0x8e, 0x6c, 0x99, 0x5e, 0xc7, 0x36, 0xa3, 0xbc, 0xb1, 0x15, 0xbe,
+	0x68, 0xe1, 0x06, 0xa8, 0x54, 0x2b, 0x28, 0xd0, 0x34, 0x05, 0x5a, 0xd3, 0x56, 0x52, 0x05, 0x76,
+	0xe2, 0xd0, 0x52, 0x9a, 0xb6, 0x07, 0x81, 0x11, 0xb7, 0x0e, 0x11, 0x7d, 0x99, 0x5c, 0x29, 0xf5,
+	0xb1, 0x01, 0x5a, 0xe4, 0x56, 0x34, 0x87, 0xfe, 0x88, 0xfe, 0x92, 0xf4, 0x96, 0xdf, 0xa3, 0x53,
+	0xc1, 0xe1, 0x87, 0x48, 0x4a, 0x96, 0xed, 0x9e, 0x7b, 0xe3, 0xce, 0x3c, 0x33, 0xbb, 0xf3, 0xec,
+	0xcc, 0x43, 0x12, 0x54, 0x66, 0xd9, 0xbc, 0xef, 0x54, 0x46, 0x3b, 0x95, 0xd3, 0x21, 0x73, 0xb9,
+	0xdd, 0xef, 0x95, 0x07, 0x4e, 0x9f, 0xf7, 0xa9, 0xec, 0x7b, 0xca, 0xa3, 0x9d, 0x62, 0x0c, 0xd4,
+	0x31, 0x7b, 0x27, 0x43, 0xf3, 0x84, 0xf9, 0xa0, 0xe2, 0xda, 0xc4, 0xd3, 0x65, 0x96, 0x6d, 0x06,
+	0xe6, 0x8d, 0x91, 0xd9, 0xb1, 0x2d, 0x93, 0xb3, 0x4a, 0xf8, 0x10, 0x38, 0xb6, 0x4e, 0xfa, 0xfd,
+	0x93, 0x0e, 0xab, 0xe0, 0xea, 0xc5, 0xf0, 0xa7, 0x0a, 0xb7, 0xbb, 0xcc, 0xe5, 0x66, 0x77, 0x10,
+	0x00, 0x6e, 0xa6, 0x01, 0xac, 0x3b, 0xe0, 0x67, 0x81, 0x73, 0x33, 0xed, 0xb4, 0x86, 0x8e, 0x39,
+	0x39, 0xb2, 0xf6, 0x87, 0x00, 0x8b, 0x4f, 0x83, 0x2a, 0x8e, 0x4c, 0x87, 0xd3, 0xaf, 0x21, 0xcb,
+	0xcf, 0x06, 0x4c, 0x25, 0x25, 0xb2, 0x5d, 0xa8, 0xde, 0x2c, 0x47, 0x25, 0x95, 0xe3, 0xb0, 0xc6,
+	0xd9, 0x80, 0xe9, 0x85, 0xb1, 0x9e, 0x7f, 0x43, 0x24, 0x95, 0xa8, 0x82, 0x9a, 0x51, 0xb3, 0x06,
+	0x06, 0xd2, 0xff, 0x41, 0x96, 0xb3, 0x9f, 0xb9, 0x2a, 0x94, 0xc8, 0xb6, 0xac, 0x4b, 0x63, 0x5d,
+	0x74, 0x32, 0xea, 0x7b, 0x62, 0xa0, 0x95, 0x6e, 0x83, 0x8c, 0x55, 0xb7, 0x86, 0x4e, 0x47, 0xcd,
+	0x20, 0x24, 0x3f, 0xd6, 0x25, 0x27, 0xf7, 0x96, 0x90, 0x0f, 0x84, 0x18, 0x12, 0x7a, 0x9b, 0x4e,
+	0x87, 0xee, 0x81, 0x14, 0x9e, 0x55, 0xcd, 0x96, 0xc8, 0x76, 0xbe, 0x7a, 0xa3, 0xec, 0x17, 0x53,
+	0x0e, 0x8b, 0x29, 0xef, 0x07, 0x00, 0x7d, 0x71, 0xac, 0xcb, 0x7f, 0x91, 0x9c, 0x26, 0x48, 0x5f,
+	0x55, 0xaf, 0x19, 0x51, 0x20, 0xbd, 0x0b, 0xe0, 0x6f, 0xd7, 0xee, 0xd8, 0x03, 0x55, 0xc4, 0x34,
+	0xd7, 0x63, 0x35, 0x1d, 0x7a, 0xce, 0xbd, 0x8e, 0x3d, 0x30, 0xe4, 0x6e, 0xf8, 0xa8, 0xfd, 0x4d,
+	0x20, 0xb7, 0xdb, 0x73, 0x5f, 0x33, 0x87, 0x16, 0x40, 0xb0, 0x2d, 0xe4, 0x42, 0x34, 0x04, 0xdb,
+	0xa2, 0x34, 0x5e, 0x5c, 0x50, 0xd2, 0xcd, 0xa9, 0x92, 0x62, 0x55, 0x68, 0xb0, 0x68, 0x76, 0x38,
+	0x73, 0x7a, 0x26, 0xb7, 0x47, 0xcc, 0x55, 0xb3, 0xa5, 0xcc, 0xb6, 0x6c, 0x24, 0x6c, 0xf4, 0x23,
+	0x28, 0xbc, 0x76, 0xfa, 0xbd, 0x93, 0xd6, 0xc8, 0x74, 0x6c, 0xb3, 0xc7, 0x5d, 0x55, 0x44, 0xd4,
+	0x12, 0x5a, 0x9f, 0x05, 0xc6, 0x54, 0x2d, 0xb9, 0xcb, 0xd5, 0xf2, 0x4b, 0x06, 0xa4, 0xf0, 0xe2,
+	0x2e, 0x55, 0xcd, 0x27, 0x90, 0x33, 0xb1, 0x76, 0x2c, 0x25, 0x5f, 0x5d, 0x89, 0xed, 0xe0, 0x93,
+	0x62, 0x04, 0x00, 0xba, 0x0e, 0x39, 0x73, 0xc8, 0x5f, 0xf6, 0x1d, 0xbc, 0x1f, 0xd9, 0x08, 0x56,
+	0x49, 0x42, 0xc4, 0x14, 0x21, 0x5b, 0x90, 0x1f, 0xba, 0xe6, 0x09, 0x6b, 0xb5, 0xfb, 0xc3, 0x1e,
+	0xc7, 0x32, 0x44, 0x03, 0xd0, 0xb4, 0xe7, 0x59, 0xe8, 0xa7, 0x20, 0x0e, 0x4c, 0x87, 0xbb, 0xea,
+	0x42, 0x29, 0xb3, 0x9d, 0xaf, 0x6e, 0x9c, 0xd3, 0x81, 0x86, 0x8f, 0x4a, 0xb1, 0x22, 0x5d, 0x8a,
+	0x15, 0x5a, 0x01, 0x29, 0x9c, 0x4a, 0x55, 0xc6, 0x46, 0x5f, 0x8d, 0x85, 0x1c, 0x04, 0x2e, 0x23,
+	0x02, 0xd1, 0xfb, 0x90, 0x6f, 0x3b, 0xcc, 0xe4, 0xac, 0xe5, 0x4d, 0x9f, 0x5a, 0xc5, 0x6d, 0x8a,
+	0x53, 0xfd, 0xd8, 0x08, 0x47, 0xd3, 0x00, 0x1f, 0xee, 0x19, 0xb4, 0x36, 0x2c, 0x1f, 0xdb, 0x5d,
+	0xbb, 0x63, 0x3a, 0x57, 0xba, 0x89, 0x4d, 0x00, 0xd7, 0x0f, 0xb3, 0xf9, 0x19, 0x52, 0x2c, 0x18,
+	0x31, 0xcb, 0xa3, 0xac, 0x94, 0x51, 0xb2, 0xe1, 0x65, 0x68, 0x6f, 0x05, 0x58, 0x0a, 0xd3, 0x37,
+	0x3d, 0x36, 0xe9, 0x1d, 0x58, 0x71, 0xfa, 0xc3, 0x9e, 0xd5, 0x0a, 0x55, 0xaa, 0x15, 0x6d, 0xb9,
+	0x8c, 0x8e, 0x10, 0x5e, 0xb7, 0xe8, 0x06, 0x2c, 0x0c, 0xcc, 0xf6, 0x2b, 0x0f, 0x21, 0x20, 0x22,
+	0xe7, 0x2d, 0xeb, 0x96, 0x77, 0x97, 0xe8, 0xe8, 0x99, 0x5d, 0x16, 0x36, 0xb7, 0x67, 0x78, 0x6c,
+	0x76, 0x99, 0x77, 0x97, 0xe8, 0x4c, 0x74, 0x01, 0x78, 0xa6, 0x5d, 0xb4, 0xd0, 0x1b, 0x20, 0xf9,
+	0x47, 0xb0, 0x2d, 0x6c, 0x04, 0xd1, 0x58, 0xc0, 0x75, 0xdd, 0xa2, 0xb7, 0x00, 0x7c, 0x17, 0x66,
+	0xce, 0x61, 0xa8, 0x8c, 0x16, 0x4c, 0x7d, 0x03, 0x24, 0xde, 0x1f, 0xd8, 0x6d, 0x2f, 0x72, 0xc1,
+	0x8f, 0xc4, 0x75, 0xdd, 0xf2, 0x76, 0xf5, 0x5d, 0xdc, 0xe6, 0x1d, 0x86, 0x57, 0x2e, 0x1b, 0x80,
+	0xa6, 0x86, 0x67, 0xd1, 0x7e, 0x13, 0x61, 0x6d, 0x0f, 0xe9, 0x0f, 0x2b, 0x34, 0x18, 0x72, 0x40,
+	0x3f, 0x06, 0x29, 0x24, 0x03, 0x99, 0x90, 0x75, 0x18, 0xeb, 0x0b, 0x8e, 0xa8, 0xa0, 0x42, 0x45,
+	0x3e, 0x7a, 0x0f, 0x68, 0x44, 0xda, 0xa4, 0x95, 0x85, 0x98, 0x5c, 0x7d, 0x20, 0xe4, 0x2d, 0x21,
+	0x86, 0x12, 0xc2, 0x0e, 0xc3, 0xfe, 0xbe, 0x9d, 0x98, 0x1f, 0x59, 0x97, 0xc7, 0x7a, 0xce, 0xc9,
+	0x2a, 0x19, 0xd5, 0x8a, 0xe6, 0xe6, 0x73, 0x50, 0xfc, 0xa7, 0x58, 0xee, 0xec, 0xb4, 0x14, 0x16,
+	0x7c, 0xd0, 0xe1, 0x44, 0x10, 0x57, 0x83, 0xb0, 0x84, 0xa2, 0xa0, 0x56, 0xe8, 0x74, 0xac, 0x2f,
+	0xbf, 0x23, 0x8b, 0x0a, 0x68, 0xde, 0x6e, 0x44, 0xb5, 0x54, 0x62, 0x50, 0x1f, 0xbe, 0x1b, 0x43,
+	0xd3, 0x07, 0xb0, 0x16, 0x24, 0x49, 0x49, 0x4e, 0x2e, 0x9e, 0x46, 0x25, 0x93, 0x44, 0x46, 0xb0,
+	0xeb, 0x77, 0x09, 0x31, 0x5a, 0x87, 0x9c, 0xcb, 0x1d, 0xbb, 0xcd, 0xf1, 0x76, 0x24, 0x23, 0x58,
+	0xd1, 0x6f, 0xa1, 0x10, 0x31, 0xe7, 0x8f, 0xb1, 0x34, 0x77, 0x8c, 0xf1, 0x05, 0xf1, 0x8e, 0x08,
+	0x0a, 0x18, 0x4b, 0xa7, 0x31, 0xbb, 0x4b, 0xf7, 0x61, 0x35, 0x75, 0x07, 0x38, 0xe1, 0xf2, 0x9c,
+	0x09, 0x5f, 0x49, 0xdc, 0x86, 0x67, 0xa2, 0xdf, 0xc0, 0x4a, 0x82, 0x6b, 0xcc, 0x01, 0x73, 0x72,
+	0x2c, 0xc7, 0x58, 0xc7, 0x0c, 0xf7, 0x62, 0x5a, 0x91, 0x3f, 0x57, 0x2b, 0xb0, 0x8e, 0x37, 0x44,
+	0x50, 0xc8, 0x44, 0x35, 0xb4, 0x37, 0x04, 0xd6, 0xd3, 0x8d, 0xe8, 0x0e, 0xfa, 0x3d, 0x17, 0x47,
+	0x67, 0x7a, 0x2c, 0xe1, 0x74, 0x32, 0x91, 0x0f, 0x61, 0x25, 0x98, 0xf5, 0x68, 0x7e, 0x5d, 0x55,
+	0x40, 0x2e, 0x8b, 0xb1, 0xfd, 0x53, 0xc2, 0x62, 0x28, 0x6e, 0xd2, 0xe0, 0x6a, 0xcf, 0x80, 0x3e,
+	0x64, 0x3c, 0x3d, 0x09, 0x17, 0xee, 0xbf, 0x05, 0x79, 0xf7, 0xa5, 0xe9, 0xb0, 0x16, 0xef, 0xbf,
+	0x62, 0xbd, 0x40, 0x98, 0x00, 0x4d, 0x0d, 0xcf, 0xa2, 0x3d, 0x80, 0xd5, 0x44, 0xde, 0xa0, 0xb0,
+	0x4a, 0x6a, 0xc4, 0xf2, 0x09, 0xba, 0x22, 0x78, 0x04, 0xd2, 0xbe, 0x84, 0x8d, 0x58, 0x1e, 0x94,
+	0xae, 0xcb, 0x1e, 0x52, 0x3b, 0x00, 0x75, 0x3a, 0x36, 0x38, 0xc8, 0x67, 0x90, 0xc3, 0xb7, 0x8a,
+	0xab, 0x12, 0x64, 0x4d, 0x9d, 0x71, 0x0c, 0x3f, 0x22, 0xc0, 0x69, 0xbf, 0x8b, 0xb0, 0xd6, 0x1c,
+	0x58, 0x33, 0x74, 0xe3, 0x42, 0xb6, 0xe2, 0xc2, 0x22, 0xc4, 0x84, 0x45, 0x7d, 0x4f, 0x94, 0xcc,
+	0x85, 0xc2, 0x32, 0xe3, 0x3b, 0x68, 0x9e, 0xb0, 0x64, 0x63, 0xc2, 0xa2, 0x5a, 0x4a, 0x66, 0xae,
+	0xb0, 0x88, 0xff, 0x5a, 0x58, 0x12, 0x8a, 0x30, 0x91, 0x15, 0x05, 0xae, 0x26, 0x2c, 0x0b, 0xe7,
+	0xa6, 0x99, 0x29, 0x2c, 0xff, 0x09, 0x48, 0x4c, 0x40, 0xbe, 0x80, 0xb5, 0x7d, 0xd6, 0x61, 0x57,
+	0x6f, 0x48, 0xed, 0x4f, 0x02, 0xeb, 0xc7, 0xcc, 0x74, 0xda, 0x2f, 0xc3, 0x50, 0x37, 0x8c, 0x2d,
+	0x81, 0x78, 0x3a, 0x64, 0xce, 0xd9, 0x8c, 0x37, 0xa0, 0xef, 0x48, 0x9c, 0x58, 0xb8, 0xd2, 0x89,
+	0xe9, 0x16, 0x88, 0x1d, 0xbb, 0x6b, 0x73, 0xec, 0x69, 0x11, 0x9b, 0xb4, 0x98, 0x2d, 0x5d, 0x53,
+	0xab, 0x86, 0x6f, 0xd7, 0x0e, 0x60, 0x63, 0xea, 0x5c, 0xc1, 0xc4, 0xee, 0x80, 0x3c, 0x91, 0x3a,
+	0x7f, 0x68, 0x67, 0x6a, 0xc7, 0x04, 0x75, 0x67, 0x08, 0x4a, 0xfa, 0xb7, 0x84, 0x6a, 0xb0, 0xf9,
+	0xb4, 0x59, 0x3b, 0x6e, 0xd4, 0x9f, 0x3c, 0x6e, 0x1d, 0xed, 0x1a, 0x8d, 0x56, 0xe3, 0xfb, 0xa3,
+	0x5a, 0xab, 0xf9, 0xf8, 0xf8, 0xa8, 0xb6, 0x57, 0x7f, 0x50, 0xaf, 0xed, 0x2b, 0xd7, 0xe8, 0x12,
+	0xc8, 0xbe, 0xab, 0xf6, 0xbc, 0xa1, 0x10, 0x5a, 0x00, 0xc0, 0x65, 0xfd, 0x70, 0xf7, 0x61, 0x4d,
+	0x11, 0xa2, 0xf5, 0x6e, 0x73, 0xbf, 0xfe, 0x44, 0xc9, 0x44, 0xeb, 0x67, 0xf5, 0xfd, 0xda, 0x13,
+	0x25, 0x5b, 0xfd, 0x35, 0x0b, 0xcb, 0xe1, 0xbe, 0xc7, 0xcc, 0x19, 0xd9, 0x6d, 0x46, 0x9b, 0x50,
+	0x48, 0x6a, 0x3d, 0x2d, 0xc5, 0x0e, 0x3f, 0xf3, 0x7b, 0xa4, 0x78, 0x7b, 0x0e, 0x22, 0x20, 0xe5,
+	0x00, 0xf2, 0x31, 0x89, 0xa3, 0xb7, 0x62, 0x11, 0xd3, 0xb2, 0x5e, 0xdc, 0x3c, 0xcf, 0x1d, 0x64,
+	0xfb, 0x11, 0x94, 0xb4, 0x60, 0x52, 0x6d, 0x76, 0x4c, 0x5c, 0x89, 0x8b, 0xff, 0x9f, 0x8b, 0x09,
+	0x92, 0x3f, 0x87, 0xe5, 0xd4, 0xd5, 0xd2, 0x78, 0x81, 0xb3, 0xdb, 0xb1, 0xa8, 0xcd, 0x83, 0x04,
+	0x99, 0x1f, 0x41, 0x21, 0x29, 0xcc, 0x09, 0x6e, 0x67, 0x6a, 0x76, 0x71, 0x7d, 0xea, 0xeb, 0xbc,
+	0xe6, 0xfd, 0x17, 0x7b, 0xb9, 0x92, 0x33, 0x95, 0xc8, 0x35, 0x73, 0xdc, 0xce, 0xcb, 0xa5, 0x5f,
+	0xff, 0x81, 0x46, 0x7f, 0xf3, 0xf7, 0xfd, 0xa7, 0xd1, 0xce, 0x8b, 0x1c, 0xa2, 0xee, 0xfe, 0x33,
+	0x00, 0x90, 0x10, 0x02, 0x02, 0x29, 0x10, 0x00, 0x00,
 }
@@ -557,6 +557,8 @@ func (m *ListRoundsRequest) validate(all bool) error {
 
 	// no validation rules for PackId
 
+	// no validation rules for ShareToken
+
 	if len(errors) > 0 {
 		return ListRoundsRequestMultiError(errors)
 	}
@@ -1191,6 +1193,123 @@ var _ interface {
 	ErrorName() string
 } = RemoveTopicRequestValidationError{}
 
+// Validate checks the field values on MoveTopicRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveTopicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveTopicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveTopicRequestMultiError, or nil if none found.
+func (m *MoveTopicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveTopicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoundId
+
+	// no validation rules for TopicId
+
+	// no validation rules for DstRoundId
+
+	if val := m.GetPosition(); val < 1 || val > 10 {
+		err := MoveTopicRequestValidationError{
+			field:  "Position",
+			reason: "value must be inside range [1, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveTopicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveTopicRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveTopicRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveTopicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveTopicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveTopicRequestMultiError) AllErrors() []error { return m }
+
+// MoveTopicRequestValidationError is the validation error returned by
+// MoveTopicRequest.Validate if the designated constraints aren't met.
+type MoveTopicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveTopicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveTopicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveTopicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveTopicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveTopicRequestValidationError) ErrorName() string { return "MoveTopicRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveTopicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveTopicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveTopicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveTopicRequestValidationError{}
+
 // Validate checks the field values on GetQuestionGridRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -35,7 +35,7 @@ type RoundService interface {
 	// UpdateRound updates round position in the pack.
 	UpdateRound(context.Context, *UpdateRoundRequest) (*UpdateRoundResponse, error)
 
-	// ListRounds returns list of pack rounds.
+	// ListRounds returns list of pack rounds, pack visibility rules are the same as in GetPack.
 	ListRounds(context.Context, *ListRoundsRequest) (*ListRoundsResponse, error)
 
 	// AddTopic adds topic to pack rounds.
@@ -44,6 +44,11 @@ type RoundService interface {
 	// RemoveTopic removes topic from pack round (not actually deleting it from DB).
 	RemoveTopic(context.Context, *RemoveTopicRequest) (*google_protobuf3.Empty, error)
 
+	// MoveTopic moves topic to position in the same or another pack round.
+	// If position is greater than amount of topics in destination round, topic is moved to the end.
+	// Topics of published packs cannot be moved.
+	MoveTopic(context.Context, *MoveTopicRequest) (*google_protobuf3.Empty, error)
+
 	// GetQuestionGrid returns grid of question topics as headers and questions as cells.
 	GetQuestionGrid(context.Context, *GetQuestionGridRequest) (*GetQuestionGridResponse, error)
 }
@@ -54,7 +59,7 @@ type RoundService interface {
 
 type roundServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [6]string
+	urls        [7]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -82,12 +87,13 @@ func NewRoundServiceProtobufClient(baseURL string, client HTTPClient, opts ...tw
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "RoundService")
-	urls := [6]string{
+	urls := [7]string{
 		serviceURL + "CreateRound",
 		serviceURL + "UpdateRound",
 		serviceURL + "ListRounds",
 		serviceURL + "AddTopic",
 		serviceURL + "RemoveTopic",
+		serviceURL + "MoveTopic",
 		serviceURL + "GetQuestionGrid",
 	}
 
@@ -329,6 +335,52 @@ func (c *roundServiceProtobufClient) callRemoveTopic(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *roundServiceProtobufClient) MoveTopic(ctx context.Context, in *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoundService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveTopic")
+	caller := c.callMoveTopic
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveTopicRequest) when calling interceptor")
+					}
+					return c.callMoveTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roundServiceProtobufClient) callMoveTopic(ctx context.Context, in *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *roundServiceProtobufClient) GetQuestionGrid(ctx context.Context, in *GetQuestionGridRequest) (*GetQuestionGridResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "RoundService")
@@ -360,7 +412,7 @@ func (c *roundServiceProtobufClient) GetQuestionGrid(ctx context.Context, in *Ge
 
 func (c *roundServiceProtobufClient) callGetQuestionGrid(ctx context.Context, in *GetQuestionGridRequest) (*GetQuestionGridResponse, error) {
 	out := new(GetQuestionGridResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -381,7 +433,7 @@ func (c *roundServiceProtobufClient) callGetQuestionGrid(ctx context.Context, in
 
 type roundServiceJSONClient struct {
 	client      HTTPClient
-	urls        [6]string
+	urls        [7]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -409,12 +461,13 @@ func NewRoundServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "RoundService")
-	urls := [6]string{
+	urls := [7]string{
 		serviceURL + "CreateRound",
 		serviceURL + "UpdateRound",
 		serviceURL + "ListRounds",
 		serviceURL + "AddTopic",
 		serviceURL + "RemoveTopic",
+		serviceURL + "MoveTopic",
 		serviceURL + "GetQuestionGrid",
 	}
 
@@ -656,6 +709,52 @@ func (c *roundServiceJSONClient) callRemoveTopic(ctx context.Context, in *Remove
 	return out, nil
 }
 
+func (c *roundServiceJSONClient) MoveTopic(ctx context.Context, in *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoundService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveTopic")
+	caller := c.callMoveTopic
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveTopicRequest) when calling interceptor")
+					}
+					return c.callMoveTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roundServiceJSONClient) callMoveTopic(ctx context.Context, in *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 func (c *roundServiceJSONClient) GetQuestionGrid(ctx context.Context, in *GetQuestionGridRequest) (*GetQuestionGridResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
 	ctx = ctxsetters.WithServiceName(ctx, "RoundService")
@@ -687,7 +786,7 @@ func (c *roundServiceJSONClient) GetQuestionGrid(ctx context.Context, in *GetQue
 
 func (c *roundServiceJSONClient) callGetQuestionGrid(ctx context.Context, in *GetQuestionGridRequest) (*GetQuestionGridResponse, error) {
 	out := new(GetQuestionGridResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -814,6 +913,9 @@ func (s *roundServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Reque
 	case "RemoveTopic":
 		s.serveRemoveTopic(ctx, resp, req)
 		return
+	case "MoveTopic":
+		s.serveMoveTopic(ctx, resp, req)
+		return
 	case "GetQuestionGrid":
 		s.serveGetQuestionGrid(ctx, resp, req)
 		return
@@ -1724,6 +1826,186 @@ func (s *roundServiceServer) serveRemoveTopicProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *roundServiceServer) serveMoveTopic(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMoveTopicJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMoveTopicProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roundServiceServer) serveMoveTopicJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveTopic")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MoveTopicRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoundService.MoveTopic
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveTopicRequest) when calling interceptor")
+					}
+					return s.RoundService.MoveTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling MoveTopic. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roundServiceServer) serveMoveTopicProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveTopic")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MoveTopicRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoundService.MoveTopic
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveTopicRequest) when calling interceptor")
+					}
+					return s.RoundService.MoveTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling MoveTopic. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *roundServiceServer) serveGetQuestionGrid(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
@@ -1905,7 +2187,7 @@ func (s *roundServiceServer) serveGetQuestionGridProtobuf(ctx context.Context, r
 }
 
 func (s *roundServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor3, 0
+	return twirpFileDescriptor4, 0
 }
 
 func (s *roundServiceServer) ProtocGenTwirpVersion() string {
@@ -1919,47 +2201,51 @@ func (s *roundServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "editor.v1", "RoundService")
 }
 
-var twirpFileDescriptor3 = []byte{
-	// 643 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5f, 0x6f, 0xd3, 0x3e,
-	0x14, 0x55, 0xff, 0xae, 0xb9, 0xdd, 0x6f, 0xeb, 0xcf, 0x94, 0x35, 0xcb, 0x58, 0x55, 0x22, 0x40,
-	0x7d, 0x98, 0x52, 0xda, 0x09, 0x09, 0x09, 0x81, 0x44, 0x07, 0xaa, 0x8a, 0x2a, 0x04, 0xa1, 0x48,
-	0x88, 0x97, 0x91, 0x35, 0x66, 0x44, 0xb4, 0x75, 0x48, 0xdc, 0x68, 0x7d, 0xe1, 0x7b, 0xf0, 0xd5,
-	0xf8, 0x28, 0x3c, 0x21, 0xff, 0x49, 0xea, 0x26, 0x2d, 0x0c, 0x09, 0xde, 0x6c, 0xdf, 0xe3, 0x73,
-	0xef, 0xf1, 0xf1, 0xb5, 0xe1, 0x26, 0x76, 0x3d, 0x4a, 0x82, 0x4e, 0xd4, 0xed, 0x04, 0x64, 0x31,
-	0x77, 0x2d, 0x3f, 0x20, 0x94, 0x20, 0x4d, 0x2c, 0x5b, 0x51, 0xd7, 0x68, 0xa6, 0x10, 0xe7, 0x5f,
-	0x16, 0x38, 0xa4, 0x1e, 0x99, 0x0b, 0xa8, 0xd1, 0x88, 0x9c, 0xa9, 0xe7, 0x3a, 0x14, 0x77, 0xe2,
-	0x81, 0x0c, 0x1c, 0x5d, 0x12, 0x72, 0x39, 0xc5, 0x1d, 0x3e, 0xbb, 0x58, 0x7c, 0xec, 0xe0, 0x99,
-	0x4f, 0x97, 0x22, 0x68, 0x7e, 0x05, 0x74, 0x16, 0x60, 0x87, 0x62, 0x9b, 0x71, 0xda, 0x98, 0x93,
-	0xa2, 0x06, 0xec, 0xf8, 0xce, 0xe4, 0xf3, 0xb9, 0xe7, 0xea, 0xb9, 0x56, 0xae, 0x5d, 0xb2, 0xcb,
-	0x6c, 0x3a, 0x74, 0x51, 0x1b, 0x40, 0x24, 0x9f, 0x3b, 0x33, 0xac, 0xe7, 0x5b, 0xb9, 0xb6, 0xd6,
-	0xd7, 0x7e, 0xf4, 0xcb, 0x41, 0xb1, 0x56, 0xd0, 0x9b, 0xb6, 0xc6, 0x83, 0x2f, 0x9d, 0x19, 0x46,
-	0x77, 0x61, 0x4f, 0x20, 0x7d, 0x12, 0x7a, 0xac, 0x4c, 0xbd, 0xc0, 0x99, 0xfe, 0xe3, 0xab, 0xaf,
-	0xe4, 0xa2, 0xf9, 0x18, 0x6e, 0xac, 0xe5, 0x0f, 0x7d, 0x32, 0x0f, 0x31, 0xba, 0x07, 0x25, 0x8e,
-	0xe3, 0xe9, 0xab, 0xbd, 0x9a, 0x95, 0x9c, 0x83, 0x25, 0x80, 0x22, 0x6c, 0x7e, 0xcb, 0x01, 0x7a,
-	0xeb, 0xbb, 0xe9, 0xfa, 0x0f, 0xa1, 0x22, 0x92, 0x27, 0x02, 0x76, 0xf8, 0xfc, 0x1f, 0x28, 0x50,
-	0xcf, 0xaa, 0xa8, 0x9e, 0x15, 0x93, 0xb6, 0x56, 0xda, 0x1f, 0x4a, 0x3b, 0x81, 0xff, 0x47, 0x5e,
-	0x48, 0xf9, 0x5a, 0xf8, 0x3b, 0x63, 0xcc, 0x0f, 0x50, 0xe2, 0x48, 0xb4, 0x07, 0xf9, 0x24, 0x98,
-	0xf7, 0x5c, 0x84, 0xa0, 0xb8, 0x52, 0x6a, 0xf3, 0x31, 0x32, 0xa0, 0x92, 0xd2, 0x94, 0xcc, 0xb7,
-	0xcb, 0x79, 0x02, 0x48, 0xad, 0x47, 0xaa, 0x69, 0x43, 0x99, 0x97, 0x1b, 0xea, 0xb9, 0x56, 0x61,
-	0xa3, 0x1c, 0x19, 0x37, 0x07, 0xb0, 0xff, 0xd4, 0x75, 0xc7, 0xc4, 0xf7, 0x26, 0xd7, 0xb0, 0xe9,
-	0x10, 0x2a, 0x94, 0x41, 0x59, 0x28, 0x2f, 0x42, 0x7c, 0x3e, 0x74, 0xcd, 0x87, 0x50, 0x5b, 0x11,
-	0xc9, 0x32, 0xee, 0xc4, 0x5e, 0x25, 0x9b, 0x04, 0xdf, 0x2e, 0x5f, 0x1d, 0xcb, 0x9d, 0x2f, 0x00,
-	0xd9, 0x78, 0x46, 0x22, 0xfc, 0x17, 0xaa, 0x38, 0x85, 0x83, 0x01, 0xa6, 0xaf, 0x65, 0x0f, 0x0e,
-	0x02, 0xef, 0x1a, 0x97, 0xcf, 0xbc, 0x82, 0x5d, 0x86, 0x8c, 0x77, 0x6d, 0x32, 0x8b, 0xe2, 0x2b,
-	0x1a, 0x9b, 0xc5, 0xc6, 0xe8, 0x3e, 0x14, 0xe9, 0xd2, 0xc7, 0xdc, 0xa8, 0xbd, 0xde, 0xad, 0xf4,
-	0xf9, 0xc6, 0x5c, 0xe3, 0xa5, 0x8f, 0x6d, 0x8e, 0x64, 0x2c, 0x13, 0x12, 0x52, 0xe9, 0x1f, 0x1f,
-	0x9b, 0x9f, 0x40, 0x63, 0x99, 0xb9, 0xf0, 0x4c, 0xda, 0x3a, 0x94, 0xa8, 0x47, 0xa7, 0xf1, 0x25,
-	0x11, 0x13, 0xf4, 0x00, 0xb4, 0xf8, 0x89, 0x09, 0xf5, 0x02, 0x77, 0xb7, 0xa1, 0x64, 0x57, 0x85,
-	0xd8, 0x2b, 0xa4, 0x39, 0x80, 0x46, 0xe6, 0x60, 0xa4, 0x4b, 0x27, 0x50, 0xe6, 0xc7, 0x17, 0x5f,
-	0x96, 0x7a, 0x8a, 0x4e, 0xd8, 0x22, 0x31, 0xbd, 0xef, 0x05, 0xd8, 0xe5, 0x12, 0xdf, 0xe0, 0x20,
-	0xf2, 0x26, 0x18, 0x8d, 0xa0, 0xaa, 0xbc, 0x15, 0xe8, 0x58, 0xd9, 0x9d, 0x7d, 0xc3, 0x8c, 0xe6,
-	0xb6, 0xb0, 0x2c, 0x66, 0x04, 0x55, 0xa5, 0x3d, 0xd7, 0xd8, 0xb2, 0x2f, 0xca, 0x1a, 0xdb, 0xa6,
-	0xae, 0x1e, 0x02, 0xac, 0xba, 0x03, 0xa9, 0x2e, 0x65, 0x9a, 0xd8, 0x38, 0xde, 0x12, 0x95, 0x54,
-	0x67, 0x50, 0x89, 0xef, 0x37, 0x32, 0x14, 0x68, 0xaa, 0x7b, 0x8c, 0xa3, 0x8d, 0x31, 0x49, 0xf2,
-	0x0c, 0xaa, 0xca, 0x55, 0x5f, 0x53, 0x97, 0x6d, 0x01, 0xe3, 0xc0, 0x12, 0x7f, 0x84, 0x15, 0xff,
-	0x11, 0xd6, 0x73, 0xf6, 0x47, 0xa0, 0x77, 0xb0, 0x9f, 0xf2, 0x12, 0xdd, 0x56, 0x3d, 0xdb, 0xd8,
-	0x00, 0x86, 0xf9, 0x2b, 0x88, 0xa8, 0xaf, 0x5f, 0x7f, 0x8f, 0x92, 0xff, 0xec, 0x91, 0x18, 0x45,
-	0xdd, 0x8b, 0x32, 0xcf, 0x7f, 0xfa, 0x33, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xdf, 0x6d, 0x17, 0x0e,
-	0x07, 0x00, 0x00,
+var twirpFileDescriptor4 = []byte{
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0x96, 0xf3, 0xd5, 0x78, 0x92, 0xb7, 0xcd, 0xbb, 0x94, 0xc6, 0x75, 0x69, 0x08, 0x16, 0x45,
+	0x39, 0x20, 0x87, 0xb6, 0x42, 0x42, 0x42, 0x20, 0x68, 0x41, 0x51, 0x50, 0x8b, 0xc0, 0x04, 0x09,
+	0x71, 0x09, 0x6e, 0xbc, 0xb4, 0x56, 0x9b, 0xac, 0xb1, 0xb7, 0x51, 0x7b, 0xe1, 0x3f, 0x70, 0x44,
+	0xe2, 0x97, 0x72, 0x42, 0x9e, 0x5d, 0x3b, 0x1b, 0x27, 0x81, 0x22, 0x95, 0xdb, 0xee, 0xcc, 0xec,
+	0x33, 0xf3, 0xcc, 0xd7, 0xc2, 0x4d, 0xea, 0xf9, 0x9c, 0x85, 0xed, 0xf1, 0x76, 0x3b, 0x64, 0xe7,
+	0x23, 0xcf, 0x0e, 0x42, 0xc6, 0x19, 0xd1, 0x85, 0xd8, 0x1e, 0x6f, 0x9b, 0x8d, 0x8c, 0x45, 0xff,
+	0xcb, 0x39, 0x8d, 0xb8, 0xcf, 0x46, 0xc2, 0xd4, 0xac, 0x8f, 0xdd, 0x33, 0xdf, 0x73, 0x39, 0x6d,
+	0x27, 0x07, 0xa9, 0xd8, 0x38, 0x66, 0xec, 0xf8, 0x8c, 0xb6, 0xf1, 0x76, 0x74, 0xfe, 0xb9, 0x4d,
+	0x87, 0x01, 0xbf, 0x14, 0x4a, 0xeb, 0x2b, 0x90, 0xfd, 0x90, 0xba, 0x9c, 0x3a, 0x31, 0xa6, 0x43,
+	0x11, 0x94, 0xd4, 0x61, 0x29, 0x70, 0x07, 0xa7, 0x7d, 0xdf, 0x33, 0xb4, 0xa6, 0xd6, 0x2a, 0x3a,
+	0xa5, 0xf8, 0xda, 0xf5, 0x48, 0x0b, 0x40, 0x38, 0x1f, 0xb9, 0x43, 0x6a, 0xe4, 0x9a, 0x5a, 0x4b,
+	0xdf, 0xd3, 0x7f, 0xee, 0x95, 0xc2, 0x82, 0xd1, 0xa8, 0xe5, 0x1d, 0x1d, 0x95, 0xaf, 0xdd, 0x21,
+	0x25, 0x5b, 0xb0, 0x2c, 0x2c, 0x03, 0x16, 0xf9, 0x71, 0x98, 0x46, 0x1e, 0x91, 0xfe, 0x43, 0xe9,
+	0x1b, 0x29, 0xb4, 0x9e, 0xc0, 0x8d, 0x29, 0xff, 0x51, 0xc0, 0x46, 0x11, 0x25, 0xf7, 0xa0, 0x88,
+	0x76, 0xe8, 0xbe, 0xb2, 0x53, 0xb3, 0xd3, 0x3c, 0xd8, 0xc2, 0x50, 0xa8, 0xad, 0xef, 0x1a, 0x90,
+	0xf7, 0x81, 0x97, 0x8d, 0x7f, 0x1d, 0xca, 0xc2, 0x79, 0x4a, 0x60, 0x09, 0xef, 0xff, 0x80, 0x81,
+	0x9a, 0xab, 0x82, 0x9a, 0xab, 0x98, 0xda, 0x54, 0x68, 0x7f, 0x49, 0xed, 0x10, 0xfe, 0x3f, 0xf0,
+	0x23, 0x8e, 0xb2, 0xe8, 0x8f, 0x85, 0xb9, 0x0d, 0x95, 0xe8, 0xc4, 0x0d, 0x69, 0x9f, 0xb3, 0x53,
+	0x3a, 0x12, 0xbc, 0x1c, 0x40, 0x51, 0x2f, 0x96, 0x58, 0x9f, 0xa0, 0x88, 0x50, 0x64, 0x19, 0x72,
+	0xe9, 0xeb, 0x9c, 0xef, 0x11, 0x02, 0x85, 0x49, 0x2a, 0x1c, 0x3c, 0x13, 0x13, 0xca, 0x19, 0xd2,
+	0xe9, 0x7d, 0x31, 0xdf, 0xa7, 0x40, 0xd4, 0x80, 0x25, 0xdd, 0x16, 0x94, 0x90, 0x4f, 0x64, 0x68,
+	0xcd, 0xfc, 0x5c, 0xbe, 0x52, 0x6f, 0x75, 0x60, 0xe5, 0xb9, 0xe7, 0xf5, 0x58, 0xe0, 0x0f, 0xae,
+	0x50, 0xc7, 0x75, 0x28, 0xf3, 0xd8, 0x34, 0x56, 0xe5, 0x84, 0x0a, 0xef, 0x5d, 0xcf, 0x7a, 0x04,
+	0xb5, 0x09, 0x90, 0x0c, 0xe3, 0x6e, 0x52, 0xcc, 0xf4, 0x91, 0xc0, 0xab, 0xa2, 0xb4, 0x27, 0x5f,
+	0xbe, 0x02, 0xe2, 0xd0, 0x21, 0x1b, 0xd3, 0x6b, 0x88, 0xe2, 0x9b, 0x06, 0xb5, 0xc3, 0x6b, 0x81,
+	0x22, 0x4d, 0xa8, 0x7a, 0x11, 0xef, 0xa7, 0x2f, 0x45, 0x49, 0xc0, 0x93, 0xc9, 0xee, 0x7a, 0x64,
+	0x4b, 0x29, 0x18, 0x56, 0x05, 0x7b, 0xda, 0x2c, 0xb4, 0x34, 0x03, 0x26, 0xb5, 0xb3, 0x76, 0x61,
+	0xad, 0x43, 0xf9, 0x5b, 0xb9, 0x38, 0x3a, 0xa1, 0x7f, 0x85, 0x89, 0xb1, 0x2e, 0xa0, 0x1a, 0x5b,
+	0x26, 0xaf, 0xe6, 0x35, 0x10, 0xa7, 0x17, 0x3c, 0x69, 0xa0, 0xf8, 0x4c, 0x1e, 0x40, 0x81, 0x5f,
+	0x06, 0x14, 0x23, 0x5d, 0xde, 0xb9, 0x95, 0xad, 0x79, 0x82, 0xd5, 0xbb, 0x0c, 0xa8, 0x83, 0x96,
+	0x31, 0xca, 0x80, 0x45, 0x5c, 0xf6, 0x14, 0x9e, 0xad, 0x13, 0xd0, 0x63, 0xcf, 0x98, 0xc1, 0x19,
+	0xb7, 0xab, 0x50, 0xe4, 0x3e, 0x3f, 0x4b, 0x1a, 0x57, 0x5c, 0xc8, 0x43, 0xd0, 0x93, 0xbd, 0x18,
+	0x19, 0x79, 0xec, 0xb8, 0xba, 0xe2, 0x5d, 0x25, 0xe2, 0x4c, 0x2c, 0xad, 0x0e, 0xd4, 0x67, 0x12,
+	0x23, 0x3b, 0xe7, 0x3e, 0x94, 0xb0, 0x0e, 0x49, 0x03, 0xaf, 0x66, 0xe0, 0x44, 0x7d, 0xa5, 0xcd,
+	0xce, 0x8f, 0x02, 0x54, 0x91, 0xe2, 0x3b, 0x1a, 0x8e, 0xfd, 0x01, 0x25, 0x07, 0x50, 0x51, 0x16,
+	0x1c, 0xd9, 0x54, 0x5e, 0xcf, 0x2e, 0x5e, 0xb3, 0xb1, 0x48, 0x2d, 0x83, 0x39, 0x80, 0x8a, 0xb2,
+	0x53, 0xa6, 0xd0, 0x66, 0xd7, 0xa0, 0xd9, 0x58, 0xa4, 0x96, 0x68, 0x5d, 0x80, 0xc9, 0xc4, 0x12,
+	0xb5, 0x4a, 0x33, 0x9b, 0xc7, 0xdc, 0x5c, 0xa0, 0x95, 0x50, 0xfb, 0x50, 0x4e, 0x66, 0x8e, 0x98,
+	0x8a, 0x69, 0x66, 0xa2, 0xcd, 0x8d, 0xb9, 0x3a, 0x09, 0xf2, 0x02, 0x2a, 0xca, 0xf8, 0x4d, 0xb1,
+	0x9b, 0x1d, 0x4b, 0x73, 0xcd, 0x16, 0x1f, 0x9b, 0x9d, 0x7c, 0x6c, 0xf6, 0xcb, 0xf8, 0x63, 0x23,
+	0xcf, 0x40, 0x4f, 0xe7, 0x8e, 0xa8, 0xfe, 0x0e, 0xaf, 0x8a, 0xf0, 0x01, 0x56, 0x32, 0xdd, 0x40,
+	0xee, 0xa8, 0x55, 0x9f, 0x3b, 0x42, 0xa6, 0xf5, 0x3b, 0x13, 0xc1, 0x70, 0x6f, 0xf5, 0x23, 0x49,
+	0xbf, 0xf1, 0xc7, 0xe2, 0x34, 0xde, 0x3e, 0x2a, 0xa1, 0xff, 0xdd, 0x5f, 0x03, 0x00, 0x71, 0xe0,
+	0x90, 0x29, 0x05, 0x08, 0x00, 0x00,
 }
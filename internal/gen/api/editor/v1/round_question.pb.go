@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -132,6 +133,71 @@ func (RoundQuestionType) EnumDescriptor() ([]byte, []int) {
 	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{1}
 }
 
+// SecretCostRange is a range [min;max] with step player who got secret question chooses its cost from.
+// If step is not set only min or max can be chosen.
+type SecretCostRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min  int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max  int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Step int32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+}
+
+func (x *SecretCostRange) Reset() {
+	*x = SecretCostRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_round_question_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretCostRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretCostRange) ProtoMessage() {}
+
+func (x *SecretCostRange) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_round_question_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretCostRange.ProtoReflect.Descriptor instead.
+func (*SecretCostRange) Descriptor() ([]byte, []int) {
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecretCostRange) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *SecretCostRange) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *SecretCostRange) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
 type RoundQuestion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -143,19 +209,22 @@ type RoundQuestion struct {
 	Question     *RoundQuestion_Question `protobuf:"bytes,4,opt,name=question,proto3" json:"question,omitempty"`
 	QuestionType RoundQuestionType       `protobuf:"varint,5,opt,name=question_type,json=questionType,proto3,enum=editor.v1.RoundQuestionType" json:"question_type,omitempty"`
 	QuestionCost int32                   `protobuf:"varint,6,opt,name=question_cost,json=questionCost,proto3" json:"question_cost,omitempty"`
-	Answer       *RoundQuestion_Answer   `protobuf:"bytes,7,opt,name=answer,proto3" json:"answer,omitempty"`
-	AnswerTime   *durationpb.Duration    `protobuf:"bytes,8,opt,name=answer_time,json=answerTime,proto3" json:"answer_time,omitempty"`
-	HostComment  string                  `protobuf:"bytes,9,opt,name=host_comment,json=hostComment,proto3" json:"host_comment,omitempty"`
-	SecretTopic  string                  `protobuf:"bytes,10,opt,name=secret_topic,json=secretTopic,proto3" json:"secret_topic,omitempty"`
-	SecretCost   int32                   `protobuf:"varint,11,opt,name=secret_cost,json=secretCost,proto3" json:"secret_cost,omitempty"`
-	TransferType TransferType            `protobuf:"varint,12,opt,name=transfer_type,json=transferType,proto3,enum=editor.v1.TransferType" json:"transfer_type,omitempty"`
-	IsKeepable   bool                    `protobuf:"varint,13,opt,name=is_keepable,json=isKeepable,proto3" json:"is_keepable,omitempty"`
+	// Answer and host comment are empty if caller is not author of the pack.
+	Answer          *RoundQuestion_Answer `protobuf:"bytes,7,opt,name=answer,proto3" json:"answer,omitempty"`
+	AnswerTime      *durationpb.Duration  `protobuf:"bytes,8,opt,name=answer_time,json=answerTime,proto3" json:"answer_time,omitempty"`
+	HostComment     string                `protobuf:"bytes,9,opt,name=host_comment,json=hostComment,proto3" json:"host_comment,omitempty"`
+	SecretTopic     string                `protobuf:"bytes,10,opt,name=secret_topic,json=secretTopic,proto3" json:"secret_topic,omitempty"`
+	SecretCost      int32                 `protobuf:"varint,11,opt,name=secret_cost,json=secretCost,proto3" json:"secret_cost,omitempty"`
+	TransferType    TransferType          `protobuf:"varint,12,opt,name=transfer_type,json=transferType,proto3,enum=editor.v1.TransferType" json:"transfer_type,omitempty"`
+	IsKeepable      bool                  `protobuf:"varint,13,opt,name=is_keepable,json=isKeepable,proto3" json:"is_keepable,omitempty"`
+	TopicTitle      string                `protobuf:"bytes,14,opt,name=topic_title,json=topicTitle,proto3" json:"topic_title,omitempty"`
+	SecretCostRange *SecretCostRange      `protobuf:"bytes,15,opt,name=secret_cost_range,json=secretCostRange,proto3" json:"secret_cost_range,omitempty"`
 }
 
 func (x *RoundQuestion) Reset() {
 	*x = RoundQuestion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_round_question_proto_msgTypes[0]
+		mi := &file_editor_v1_round_question_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -168,7 +237,7 @@ func (x *RoundQuestion) String() string {
 func (*RoundQuestion) ProtoMessage() {}
 
 func (x *RoundQuestion) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_round_question_proto_msgTypes[0]
+	mi := &file_editor_v1_round_question_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +250,7 @@ func (x *RoundQuestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundQuestion.ProtoReflect.Descriptor instead.
 func (*RoundQuestion) Descriptor() ([]byte, []int) {
-	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{0}
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *RoundQuestion) GetId() int32 {
@@ -275,6 +344,20 @@ func (x *RoundQuestion) GetIsKeepable() bool {
 	return false
 }
 
+func (x *RoundQuestion) GetTopicTitle() string {
+	if x != nil {
+		return x.TopicTitle
+	}
+	return ""
+}
+
+func (x *RoundQuestion) GetSecretCostRange() *SecretCostRange {
+	if x != nil {
+		return x.SecretCostRange
+	}
+	return nil
+}
+
 type CreateRoundQuestionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -288,15 +371,19 @@ type CreateRoundQuestionRequest struct {
 	AnswerTime   *durationpb.Duration `protobuf:"bytes,6,opt,name=answer_time,json=answerTime,proto3" json:"answer_time,omitempty"`                                         // required
 	HostComment  string               `protobuf:"bytes,7,opt,name=host_comment,json=hostComment,proto3" json:"host_comment,omitempty"`
 	SecretTopic  string               `protobuf:"bytes,8,opt,name=secret_topic,json=secretTopic,proto3" json:"secret_topic,omitempty"`
-	SecretCost   int32                `protobuf:"varint,9,opt,name=secret_cost,json=secretCost,proto3" json:"secret_cost,omitempty"`
-	IsKeepable   bool                 `protobuf:"varint,10,opt,name=is_keepable,json=isKeepable,proto3" json:"is_keepable,omitempty"`
-	TransferType TransferType         `protobuf:"varint,11,opt,name=transfer_type,json=transferType,proto3,enum=editor.v1.TransferType" json:"transfer_type,omitempty"`
+	// Cost of secret question, if neither secret cost nor secret cost range is set
+	// player who got the question chooses minimal or maximal cost of the round.
+	SecretCost   int32        `protobuf:"varint,9,opt,name=secret_cost,json=secretCost,proto3" json:"secret_cost,omitempty"`
+	IsKeepable   bool         `protobuf:"varint,10,opt,name=is_keepable,json=isKeepable,proto3" json:"is_keepable,omitempty"`
+	TransferType TransferType `protobuf:"varint,11,opt,name=transfer_type,json=transferType,proto3,enum=editor.v1.TransferType" json:"transfer_type,omitempty"`
+	// Range player who got the question chooses its cost from, must not be set with secret cost.
+	SecretCostRange *SecretCostRange `protobuf:"bytes,12,opt,name=secret_cost_range,json=secretCostRange,proto3" json:"secret_cost_range,omitempty"`
 }
 
 func (x *CreateRoundQuestionRequest) Reset() {
 	*x = CreateRoundQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_round_question_proto_msgTypes[1]
+		mi := &file_editor_v1_round_question_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -309,7 +396,7 @@ func (x *CreateRoundQuestionRequest) String() string {
 func (*CreateRoundQuestionRequest) ProtoMessage() {}
 
 func (x *CreateRoundQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_round_question_proto_msgTypes[1]
+	mi := &file_editor_v1_round_question_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +409,7 @@ func (x *CreateRoundQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoundQuestionRequest.ProtoReflect.Descriptor instead.
 func (*CreateRoundQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{1}
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateRoundQuestionRequest) GetQuestionId() int32 {
@@ -402,6 +489,13 @@ func (x *CreateRoundQuestionRequest) GetTransferType() TransferType {
 	return TransferType_TRANSFER_TYPE_UNSPECIFIED
 }
 
+func (x *CreateRoundQuestionRequest) GetSecretCostRange() *SecretCostRange {
+	if x != nil {
+		return x.SecretCostRange
+	}
+	return nil
+}
+
 type CreateRoundQuestionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -413,7 +507,7 @@ type CreateRoundQuestionResponse struct {
 func (x *CreateRoundQuestionResponse) Reset() {
 	*x = CreateRoundQuestionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_round_question_proto_msgTypes[2]
+		mi := &file_editor_v1_round_question_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -426,7 +520,7 @@ func (x *CreateRoundQuestionResponse) String() string {
 func (*CreateRoundQuestionResponse) ProtoMessage() {}
 
 func (x *CreateRoundQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_round_question_proto_msgTypes[2]
+	mi := &file_editor_v1_round_question_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +533,7 @@ func (x *CreateRoundQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoundQuestionResponse.ProtoReflect.Descriptor instead.
 func (*CreateRoundQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{2}
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateRoundQuestionResponse) GetRoundQuestionId() int32 {
@@ -455,12 +549,14 @@ type GetRoundQuestionRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	RoundQuestionId int32 `protobuf:"varint,1,opt,name=round_question_id,json=roundQuestionId,proto3" json:"round_question_id,omitempty"` // required
+	// Share token of unlisted or private pack.
+	ShareToken string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 }
 
 func (x *GetRoundQuestionRequest) Reset() {
 	*x = GetRoundQuestionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_round_question_proto_msgTypes[3]
+		mi := &file_editor_v1_round_question_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -473,7 +569,7 @@ func (x *GetRoundQuestionRequest) String() string {
 func (*GetRoundQuestionRequest) ProtoMessage() {}
 
 func (x *GetRoundQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_round_question_proto_msgTypes[3]
+	mi := &file_editor_v1_round_question_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +582,7 @@ func (x *GetRoundQuestionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoundQuestionRequest.ProtoReflect.Descriptor instead.
 func (*GetRoundQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{3}
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetRoundQuestionRequest) GetRoundQuestionId() int32 {
@@ -496,6 +592,13 @@ func (x *GetRoundQuestionRequest) GetRoundQuestionId() int32 {
 	return 0
 }
 
+func (x *GetRoundQuestionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
 type GetRoundQuestionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -507,7 +610,7 @@ type GetRoundQuestionResponse struct {
 func (x *GetRoundQuestionResponse) Reset() {
 	*x = GetRoundQuestionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_round_question_proto_msgTypes[4]
+		mi := &file_editor_v1_round_question_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -520,7 +623,7 @@ func (x *GetRoundQuestionResponse) String() string {
 func (*GetRoundQuestionResponse) ProtoMessage() {}
 
 func (x *GetRoundQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_round_question_proto_msgTypes[4]
+	mi := &file_editor_v1_round_question_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +636,7 @@ func (x *GetRoundQuestionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoundQuestionResponse.ProtoReflect.Descriptor instead.
 func (*GetRoundQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{4}
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetRoundQuestionResponse) GetRoundQuestion() *RoundQuestion {
@@ -543,20 +646,92 @@ func (x *GetRoundQuestionResponse) GetRoundQuestion() *RoundQuestion {
 	return nil
 }
 
+type MoveRoundQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundQuestionId int32 `protobuf:"varint,1,opt,name=round_question_id,json=roundQuestionId,proto3" json:"round_question_id,omitempty"` // required
+	DstRoundId      int32 `protobuf:"varint,2,opt,name=dst_round_id,json=dstRoundId,proto3" json:"dst_round_id,omitempty"`                // required
+	DstTopicId      int32 `protobuf:"varint,3,opt,name=dst_topic_id,json=dstTopicId,proto3" json:"dst_topic_id,omitempty"`                // required
+	Position        int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`                                        // required
+}
+
+func (x *MoveRoundQuestionRequest) Reset() {
+	*x = MoveRoundQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editor_v1_round_question_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveRoundQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRoundQuestionRequest) ProtoMessage() {}
+
+func (x *MoveRoundQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_editor_v1_round_question_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRoundQuestionRequest.ProtoReflect.Descriptor instead.
+func (*MoveRoundQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MoveRoundQuestionRequest) GetRoundQuestionId() int32 {
+	if x != nil {
+		return x.RoundQuestionId
+	}
+	return 0
+}
+
+func (x *MoveRoundQuestionRequest) GetDstRoundId() int32 {
+	if x != nil {
+		return x.DstRoundId
+	}
+	return 0
+}
+
+func (x *MoveRoundQuestionRequest) GetDstTopicId() int32 {
+	if x != nil {
+		return x.DstTopicId
+	}
+	return 0
+}
+
+func (x *MoveRoundQuestionRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 type RoundQuestion_Question struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	MediaUrl string `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Id        int32      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string     `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	MediaUrl  string     `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	MediaClip *MediaClip `protobuf:"bytes,4,opt,name=media_clip,json=mediaClip,proto3" json:"media_clip,omitempty"`
 }
 
 func (x *RoundQuestion_Question) Reset() {
 	*x = RoundQuestion_Question{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_round_question_proto_msgTypes[5]
+		mi := &file_editor_v1_round_question_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +744,7 @@ func (x *RoundQuestion_Question) String() string {
 func (*RoundQuestion_Question) ProtoMessage() {}
 
 func (x *RoundQuestion_Question) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_round_question_proto_msgTypes[5]
+	mi := &file_editor_v1_round_question_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +757,7 @@ func (x *RoundQuestion_Question) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundQuestion_Question.ProtoReflect.Descriptor instead.
 func (*RoundQuestion_Question) Descriptor() ([]byte, []int) {
-	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{0, 0}
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{1, 0}
 }
 
 func (x *RoundQuestion_Question) GetId() int32 {
@@ -606,20 +781,28 @@ func (x *RoundQuestion_Question) GetMediaUrl() string {
 	return ""
 }
 
+func (x *RoundQuestion_Question) GetMediaClip() *MediaClip {
+	if x != nil {
+		return x.MediaClip
+	}
+	return nil
+}
+
 type RoundQuestion_Answer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	MediaUrl string `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	Id        int32      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string     `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	MediaUrl  string     `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	MediaClip *MediaClip `protobuf:"bytes,4,opt,name=media_clip,json=mediaClip,proto3" json:"media_clip,omitempty"`
 }
 
 func (x *RoundQuestion_Answer) Reset() {
 	*x = RoundQuestion_Answer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_editor_v1_round_question_proto_msgTypes[6]
+		mi := &file_editor_v1_round_question_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -632,7 +815,7 @@ func (x *RoundQuestion_Answer) String() string {
 func (*RoundQuestion_Answer) ProtoMessage() {}
 
 func (x *RoundQuestion_Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_editor_v1_round_question_proto_msgTypes[6]
+	mi := &file_editor_v1_round_question_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +828,7 @@ func (x *RoundQuestion_Answer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundQuestion_Answer.ProtoReflect.Descriptor instead.
 func (*RoundQuestion_Answer) Descriptor() ([]byte, []int) {
-	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{0, 1}
+	return file_editor_v1_round_question_proto_rawDescGZIP(), []int{1, 1}
 }
 
 func (x *RoundQuestion_Answer) GetId() int32 {
@@ -669,109 +852,155 @@ func (x *RoundQuestion_Answer) GetMediaUrl() string {
 	return ""
 }
 
+func (x *RoundQuestion_Answer) GetMediaClip() *MediaClip {
+	if x != nil {
+		return x.MediaClip
+	}
+	return nil
+}
+
 var File_editor_v1_round_question_proto protoreflect.FileDescriptor
 
 var file_editor_v1_round_question_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x75, 0x6e,
 	0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x09, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x05, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x08,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0b,
-	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x68, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12,
-	0x3c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x73, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x4b,
-	0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x1a, 0x49, 0x0a, 0x06, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x55, 0x72, 0x6c, 0x22, 0x9a, 0x04, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x0d,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x82, 0x01, 0x0a, 0x18, 0x01, 0x18, 0x02, 0x18, 0x03, 0x18,
-	0x04, 0x18, 0x05, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
-	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
-	0x01, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12,
-	0x4c, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x10, 0xfa, 0x42, 0x0d, 0xaa, 0x01, 0x0a, 0x08, 0x01, 0x22, 0x02, 0x08, 0x3c, 0x32, 0x02, 0x08,
-	0x05, 0x52, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x63, 0x6f,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x43, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4b, 0x65, 0x65,
-	0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65,
-	0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x82, 0x01, 0x08, 0x18, 0x00, 0x18,
-	0x01, 0x18, 0x02, 0x18, 0x03, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0x49, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x45,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x69, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x12, 0x09, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x15, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
+	0x74, 0x65, 0x70, 0x22, 0xa3, 0x07, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0d, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68,
+	0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x73, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x73, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x46,
+	0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x64, 0x69, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x73,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x80, 0x01, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6c,
+	0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x1a, 0x7e, 0x0a, 0x06, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x55, 0x72, 0x6c, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6c,
+	0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6c, 0x69, 0x70, 0x22, 0xe2, 0x04, 0x0a, 0x1a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12,
+	0x53, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x82, 0x01, 0x0a, 0x18, 0x01, 0x18, 0x02,
+	0x18, 0x03, 0x18, 0x04, 0x18, 0x05, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x28, 0x01, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0xaa, 0x01, 0x0a, 0x08, 0x01, 0x22, 0x02, 0x08, 0x3c,
+	0x32, 0x02, 0x08, 0x05, 0x52, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6b, 0x65,
+	0x65, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x4b, 0x65, 0x65, 0x70, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x82, 0x01, 0x08,
+	0x18, 0x00, 0x18, 0x01, 0x18, 0x02, 0x18, 0x03, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x49,
+	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x5b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1,
+	0x01, 0x0a, 0x18, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x73, 0x74, 0x5f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64,
+	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x73, 0x74,
+	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x64, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa,
+	0x42, 0x06, 0x1a, 0x04, 0x18, 0x0a, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
 	0x6f, 0x6e, 0x2a, 0x4f, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x79,
 	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x54,
 	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
@@ -785,7 +1014,7 @@ var file_editor_v1_round_question_proto_rawDesc = []byte{
 	0x41, 0x46, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10,
 	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x55, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45,
 	0x54, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05,
-	0x32, 0xd9, 0x01, 0x0a, 0x14, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x32, 0xab, 0x02, 0x0a, 0x14, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
 	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x25, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
@@ -798,9 +1027,14 @@ var file_editor_v1_round_question_proto_rawDesc = []byte{
 	0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
 	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x14, 0x5a, 0x12,
-	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11,
+	0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x14,
+	0x5a, 0x12, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x64, 0x69, 0x74,
+	0x6f, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -816,38 +1050,48 @@ func file_editor_v1_round_question_proto_rawDescGZIP() []byte {
 }
 
 var file_editor_v1_round_question_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_editor_v1_round_question_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_editor_v1_round_question_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_editor_v1_round_question_proto_goTypes = []interface{}{
 	(TransferType)(0),                   // 0: editor.v1.TransferType
 	(RoundQuestionType)(0),              // 1: editor.v1.RoundQuestionType
-	(*RoundQuestion)(nil),               // 2: editor.v1.RoundQuestion
-	(*CreateRoundQuestionRequest)(nil),  // 3: editor.v1.CreateRoundQuestionRequest
-	(*CreateRoundQuestionResponse)(nil), // 4: editor.v1.CreateRoundQuestionResponse
-	(*GetRoundQuestionRequest)(nil),     // 5: editor.v1.GetRoundQuestionRequest
-	(*GetRoundQuestionResponse)(nil),    // 6: editor.v1.GetRoundQuestionResponse
-	(*RoundQuestion_Question)(nil),      // 7: editor.v1.RoundQuestion.Question
-	(*RoundQuestion_Answer)(nil),        // 8: editor.v1.RoundQuestion.Answer
-	(*durationpb.Duration)(nil),         // 9: google.protobuf.Duration
+	(*SecretCostRange)(nil),             // 2: editor.v1.SecretCostRange
+	(*RoundQuestion)(nil),               // 3: editor.v1.RoundQuestion
+	(*CreateRoundQuestionRequest)(nil),  // 4: editor.v1.CreateRoundQuestionRequest
+	(*CreateRoundQuestionResponse)(nil), // 5: editor.v1.CreateRoundQuestionResponse
+	(*GetRoundQuestionRequest)(nil),     // 6: editor.v1.GetRoundQuestionRequest
+	(*GetRoundQuestionResponse)(nil),    // 7: editor.v1.GetRoundQuestionResponse
+	(*MoveRoundQuestionRequest)(nil),    // 8: editor.v1.MoveRoundQuestionRequest
+	(*RoundQuestion_Question)(nil),      // 9: editor.v1.RoundQuestion.Question
+	(*RoundQuestion_Answer)(nil),        // 10: editor.v1.RoundQuestion.Answer
+	(*durationpb.Duration)(nil),         // 11: google.protobuf.Duration
+	(*MediaClip)(nil),                   // 12: editor.v1.MediaClip
+	(*emptypb.Empty)(nil),               // 13: google.protobuf.Empty
 }
 var file_editor_v1_round_question_proto_depIdxs = []int32{
-	7,  // 0: editor.v1.RoundQuestion.question:type_name -> editor.v1.RoundQuestion.Question
+	9,  // 0: editor.v1.RoundQuestion.question:type_name -> editor.v1.RoundQuestion.Question
 	1,  // 1: editor.v1.RoundQuestion.question_type:type_name -> editor.v1.RoundQuestionType
-	8,  // 2: editor.v1.RoundQuestion.answer:type_name -> editor.v1.RoundQuestion.Answer
-	9,  // 3: editor.v1.RoundQuestion.answer_time:type_name -> google.protobuf.Duration
+	10, // 2: editor.v1.RoundQuestion.answer:type_name -> editor.v1.RoundQuestion.Answer
+	11, // 3: editor.v1.RoundQuestion.answer_time:type_name -> google.protobuf.Duration
 	0,  // 4: editor.v1.RoundQuestion.transfer_type:type_name -> editor.v1.TransferType
-	1,  // 5: editor.v1.CreateRoundQuestionRequest.question_type:type_name -> editor.v1.RoundQuestionType
-	9,  // 6: editor.v1.CreateRoundQuestionRequest.answer_time:type_name -> google.protobuf.Duration
-	0,  // 7: editor.v1.CreateRoundQuestionRequest.transfer_type:type_name -> editor.v1.TransferType
-	2,  // 8: editor.v1.GetRoundQuestionResponse.round_question:type_name -> editor.v1.RoundQuestion
-	3,  // 9: editor.v1.RoundQuestionService.CreateRoundQuestion:input_type -> editor.v1.CreateRoundQuestionRequest
-	5,  // 10: editor.v1.RoundQuestionService.GetRoundQuestion:input_type -> editor.v1.GetRoundQuestionRequest
-	4,  // 11: editor.v1.RoundQuestionService.CreateRoundQuestion:output_type -> editor.v1.CreateRoundQuestionResponse
-	6,  // 12: editor.v1.RoundQuestionService.GetRoundQuestion:output_type -> editor.v1.GetRoundQuestionResponse
-	11, // [11:13] is the sub-list for method output_type
-	9,  // [9:11] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	2,  // 5: editor.v1.RoundQuestion.secret_cost_range:type_name -> editor.v1.SecretCostRange
+	1,  // 6: editor.v1.CreateRoundQuestionRequest.question_type:type_name -> editor.v1.RoundQuestionType
+	11, // 7: editor.v1.CreateRoundQuestionRequest.answer_time:type_name -> google.protobuf.Duration
+	0,  // 8: editor.v1.CreateRoundQuestionRequest.transfer_type:type_name -> editor.v1.TransferType
+	2,  // 9: editor.v1.CreateRoundQuestionRequest.secret_cost_range:type_name -> editor.v1.SecretCostRange
+	3,  // 10: editor.v1.GetRoundQuestionResponse.round_question:type_name -> editor.v1.RoundQuestion
+	12, // 11: editor.v1.RoundQuestion.Question.media_clip:type_name -> editor.v1.MediaClip
+	12, // 12: editor.v1.RoundQuestion.Answer.media_clip:type_name -> editor.v1.MediaClip
+	4,  // 13: editor.v1.RoundQuestionService.CreateRoundQuestion:input_type -> editor.v1.CreateRoundQuestionRequest
+	6,  // 14: editor.v1.RoundQuestionService.GetRoundQuestion:input_type -> editor.v1.GetRoundQuestionRequest
+	8,  // 15: editor.v1.RoundQuestionService.MoveRoundQuestion:input_type -> editor.v1.MoveRoundQuestionRequest
+	5,  // 16: editor.v1.RoundQuestionService.CreateRoundQuestion:output_type -> editor.v1.CreateRoundQuestionResponse
+	7,  // 17: editor.v1.RoundQuestionService.GetRoundQuestion:output_type -> editor.v1.GetRoundQuestionResponse
+	13, // 18: editor.v1.RoundQuestionService.MoveRoundQuestion:output_type -> google.protobuf.Empty
+	16, // [16:19] is the sub-list for method output_type
+	13, // [13:16] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_editor_v1_round_question_proto_init() }
@@ -855,9 +1099,10 @@ func file_editor_v1_round_question_proto_init() {
 	if File_editor_v1_round_question_proto != nil {
 		return
 	}
+	file_editor_v1_media_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_editor_v1_round_question_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundQuestion); i {
+			switch v := v.(*SecretCostRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,7 +1114,7 @@ func file_editor_v1_round_question_proto_init() {
 			}
 		}
 		file_editor_v1_round_question_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoundQuestionRequest); i {
+			switch v := v.(*RoundQuestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -881,7 +1126,7 @@ func file_editor_v1_round_question_proto_init() {
 			}
 		}
 		file_editor_v1_round_question_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoundQuestionResponse); i {
+			switch v := v.(*CreateRoundQuestionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -893,7 +1138,7 @@ func file_editor_v1_round_question_proto_init() {
 			}
 		}
 		file_editor_v1_round_question_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoundQuestionRequest); i {
+			switch v := v.(*CreateRoundQuestionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -905,7 +1150,7 @@ func file_editor_v1_round_question_proto_init() {
 			}
 		}
 		file_editor_v1_round_question_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoundQuestionResponse); i {
+			switch v := v.(*GetRoundQuestionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -917,7 +1162,7 @@ func file_editor_v1_round_question_proto_init() {
 			}
 		}
 		file_editor_v1_round_question_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundQuestion_Question); i {
+			switch v := v.(*GetRoundQuestionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -929,6 +1174,30 @@ func file_editor_v1_round_question_proto_init() {
 			}
 		}
 		file_editor_v1_round_question_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveRoundQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_round_question_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundQuestion_Question); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editor_v1_round_question_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoundQuestion_Answer); i {
 			case 0:
 				return &v.state
@@ -947,7 +1216,7 @@ func file_editor_v1_round_question_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_editor_v1_round_question_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,112 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on SecretCostRange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SecretCostRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretCostRange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecretCostRangeMultiError, or nil if none found.
+func (m *SecretCostRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretCostRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Step
+
+	if len(errors) > 0 {
+		return SecretCostRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretCostRangeMultiError is an error wrapping multiple validation errors
+// returned by SecretCostRange.ValidateAll() if the designated constraints
+// aren't met.
+type SecretCostRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretCostRangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretCostRangeMultiError) AllErrors() []error { return m }
+
+// SecretCostRangeValidationError is the validation error returned by
+// SecretCostRange.Validate if the designated constraints aren't met.
+type SecretCostRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretCostRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretCostRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretCostRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretCostRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretCostRangeValidationError) ErrorName() string { return "SecretCostRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretCostRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretCostRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretCostRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretCostRangeValidationError{}
+
 // Validate checks the field values on RoundQuestion with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -164,6 +270,37 @@ func (m *RoundQuestion) validate(all bool) error {
 
 	// no validation rules for IsKeepable
 
+	// no validation rules for TopicTitle
+
+	if all {
+		switch v := interface{}(m.GetSecretCostRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoundQuestionValidationError{
+					field:  "SecretCostRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoundQuestionValidationError{
+					field:  "SecretCostRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecretCostRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoundQuestionValidationError{
+				field:  "SecretCostRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RoundQuestionMultiError(errors)
 	}
@@ -353,6 +490,35 @@ func (m *CreateRoundQuestionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSecretCostRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoundQuestionRequestValidationError{
+					field:  "SecretCostRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoundQuestionRequestValidationError{
+					field:  "SecretCostRange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecretCostRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoundQuestionRequestValidationError{
+				field:  "SecretCostRange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateRoundQuestionRequestMultiError(errors)
 	}
@@ -577,6 +743,8 @@ func (m *GetRoundQuestionRequest) validate(all bool) error {
 
 	// no validation rules for RoundQuestionId
 
+	// no validation rules for ShareToken
+
 	if len(errors) > 0 {
 		return GetRoundQuestionRequestMultiError(errors)
 	}
@@ -788,6 +956,125 @@ var _ interface {
 	ErrorName() string
 } = GetRoundQuestionResponseValidationError{}
 
+// Validate checks the field values on MoveRoundQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveRoundQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveRoundQuestionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveRoundQuestionRequestMultiError, or nil if none found.
+func (m *MoveRoundQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveRoundQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoundQuestionId
+
+	// no validation rules for DstRoundId
+
+	// no validation rules for DstTopicId
+
+	if val := m.GetPosition(); val < 1 || val > 10 {
+		err := MoveRoundQuestionRequestValidationError{
+			field:  "Position",
+			reason: "value must be inside range [1, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveRoundQuestionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveRoundQuestionRequestMultiError is an error wrapping multiple validation
+// errors returned by MoveRoundQuestionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MoveRoundQuestionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveRoundQuestionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveRoundQuestionRequestMultiError) AllErrors() []error { return m }
+
+// MoveRoundQuestionRequestValidationError is the validation error returned by
+// MoveRoundQuestionRequest.Validate if the designated constraints aren't met.
+type MoveRoundQuestionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveRoundQuestionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveRoundQuestionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveRoundQuestionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveRoundQuestionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveRoundQuestionRequestValidationError) ErrorName() string {
+	return "MoveRoundQuestionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveRoundQuestionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveRoundQuestionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveRoundQuestionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveRoundQuestionRequestValidationError{}
+
 // Validate checks the field values on RoundQuestion_Question with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -816,6 +1103,35 @@ func (m *RoundQuestion_Question) validate(all bool) error {
 
 	// no validation rules for MediaUrl
 
+	if all {
+		switch v := interface{}(m.GetMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoundQuestion_QuestionValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoundQuestion_QuestionValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoundQuestion_QuestionValidationError{
+				field:  "MediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RoundQuestion_QuestionMultiError(errors)
 	}
@@ -924,6 +1240,35 @@ func (m *RoundQuestion_Answer) validate(all bool) error {
 
 	// no validation rules for MediaUrl
 
+	if all {
+		switch v := interface{}(m.GetMediaClip()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoundQuestion_AnswerValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoundQuestion_AnswerValidationError{
+					field:  "MediaClip",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMediaClip()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoundQuestion_AnswerValidationError{
+				field:  "MediaClip",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RoundQuestion_AnswerMultiError(errors)
 	}
@@ -16,6 +16,8 @@ import proto "google.golang.org/protobuf/proto"
 import twirp "github.com/twitchtv/twirp"
 import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
 
+import google_protobuf3 "google.golang.org/protobuf/types/known/emptypb"
+
 // Version compatibility assertion.
 // If the constant is not defined in the package, that likely means
 // the package needs to be updated to work with this generated code.
@@ -30,8 +32,14 @@ type RoundQuestionService interface {
 	// CreateRoundQuestion adds question for topic in pack round.
 	CreateRoundQuestion(context.Context, *CreateRoundQuestionRequest) (*CreateRoundQuestionResponse, error)
 
-	// GetRoundQuestion returns round question.
+	// GetRoundQuestion returns round question, pack visibility rules are the same as in GetPack.
+	// Answer and host comment are returned only to author of the pack.
 	GetRoundQuestion(context.Context, *GetRoundQuestionRequest) (*GetRoundQuestionResponse, error)
+
+	// MoveRoundQuestion moves round question to position in topic of the same or another pack round.
+	// If position is greater than amount of questions in destination topic, question is moved to the end.
+	// Questions of published packs cannot be moved.
+	MoveRoundQuestion(context.Context, *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error)
 }
 
 // ====================================
@@ -40,7 +48,7 @@ type RoundQuestionService interface {
 
 type roundQuestionServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -68,9 +76,10 @@ func NewRoundQuestionServiceProtobufClient(baseURL string, client HTTPClient, op
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "RoundQuestionService")
-	urls := [2]string{
+	urls := [3]string{
 		serviceURL + "CreateRoundQuestion",
 		serviceURL + "GetRoundQuestion",
+		serviceURL + "MoveRoundQuestion",
 	}
 
 	return &roundQuestionServiceProtobufClient{
@@ -173,13 +182,59 @@ func (c *roundQuestionServiceProtobufClient) callGetRoundQuestion(ctx context.Co
 	return out, nil
 }
 
+func (c *roundQuestionServiceProtobufClient) MoveRoundQuestion(ctx context.Context, in *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoundQuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveRoundQuestion")
+	caller := c.callMoveRoundQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveRoundQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveRoundQuestionRequest) when calling interceptor")
+					}
+					return c.callMoveRoundQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roundQuestionServiceProtobufClient) callMoveRoundQuestion(ctx context.Context, in *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ================================
 // RoundQuestionService JSON Client
 // ================================
 
 type roundQuestionServiceJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -207,9 +262,10 @@ func NewRoundQuestionServiceJSONClient(baseURL string, client HTTPClient, opts .
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "RoundQuestionService")
-	urls := [2]string{
+	urls := [3]string{
 		serviceURL + "CreateRoundQuestion",
 		serviceURL + "GetRoundQuestion",
+		serviceURL + "MoveRoundQuestion",
 	}
 
 	return &roundQuestionServiceJSONClient{
@@ -312,6 +368,52 @@ func (c *roundQuestionServiceJSONClient) callGetRoundQuestion(ctx context.Contex
 	return out, nil
 }
 
+func (c *roundQuestionServiceJSONClient) MoveRoundQuestion(ctx context.Context, in *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoundQuestionService")
+	ctx = ctxsetters.WithMethodName(ctx, "MoveRoundQuestion")
+	caller := c.callMoveRoundQuestion
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveRoundQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveRoundQuestionRequest) when calling interceptor")
+					}
+					return c.callMoveRoundQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roundQuestionServiceJSONClient) callMoveRoundQuestion(ctx context.Context, in *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===================================
 // RoundQuestionService Server Handler
 // ===================================
@@ -415,6 +517,9 @@ func (s *roundQuestionServiceServer) ServeHTTP(resp http.ResponseWriter, req *ht
 	case "GetRoundQuestion":
 		s.serveGetRoundQuestion(ctx, resp, req)
 		return
+	case "MoveRoundQuestion":
+		s.serveMoveRoundQuestion(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -782,8 +887,188 @@ func (s *roundQuestionServiceServer) serveGetRoundQuestionProtobuf(ctx context.C
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *roundQuestionServiceServer) serveMoveRoundQuestion(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMoveRoundQuestionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMoveRoundQuestionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roundQuestionServiceServer) serveMoveRoundQuestionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveRoundQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MoveRoundQuestionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoundQuestionService.MoveRoundQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveRoundQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveRoundQuestionRequest) when calling interceptor")
+					}
+					return s.RoundQuestionService.MoveRoundQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling MoveRoundQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roundQuestionServiceServer) serveMoveRoundQuestionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MoveRoundQuestion")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(MoveRoundQuestionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoundQuestionService.MoveRoundQuestion
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MoveRoundQuestionRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MoveRoundQuestionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MoveRoundQuestionRequest) when calling interceptor")
+					}
+					return s.RoundQuestionService.MoveRoundQuestion(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling MoveRoundQuestion. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *roundQuestionServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor4, 0
+	return twirpFileDescriptor5, 0
 }
 
 func (s *roundQuestionServiceServer) ProtocGenTwirpVersion() string {
@@ -797,59 +1082,72 @@ func (s *roundQuestionServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "editor.v1", "RoundQuestionService")
 }
 
-var twirpFileDescriptor4 = []byte{
-	// 833 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xc7, 0x6b, 0xe7, 0xcb, 0x39, 0x49, 0x16, 0x77, 0xa8, 0xb4, 0xb3, 0x29, 0x74, 0xd3, 0xac,
-	0x40, 0xd1, 0x0a, 0x39, 0xda, 0x70, 0x81, 0x04, 0x45, 0xc8, 0x4e, 0x1c, 0x64, 0xb5, 0xca, 0x6e,
-	0xc7, 0x0e, 0x12, 0xf4, 0xc2, 0xf2, 0xc6, 0xd3, 0x62, 0x91, 0xc4, 0xa9, 0x3d, 0x09, 0x54, 0xdc,
-	0xf5, 0x31, 0x78, 0x04, 0x5e, 0x86, 0x6b, 0x5e, 0x65, 0xaf, 0x90, 0x67, 0x9c, 0xd4, 0xf6, 0x6e,
-	0x36, 0x42, 0xbd, 0x3b, 0xe7, 0xcc, 0x99, 0xff, 0x39, 0x73, 0xe6, 0x37, 0x36, 0x3c, 0xa1, 0x7e,
-	0xc0, 0xc2, 0xa8, 0xbf, 0xb9, 0xe8, 0x47, 0xe1, 0x7a, 0xe9, 0xbb, 0x6f, 0xd7, 0x34, 0x66, 0x41,
-	0xb8, 0xd4, 0x56, 0x51, 0xc8, 0x42, 0x54, 0x17, 0xeb, 0xda, 0xe6, 0xa2, 0x7d, 0xbc, 0xf1, 0xe6,
-	0x81, 0xef, 0x31, 0xda, 0xdf, 0x1a, 0x22, 0xa7, 0xfd, 0xe4, 0x4d, 0x18, 0xbe, 0x99, 0xd3, 0x3e,
-	0xf7, 0xae, 0xd7, 0xaf, 0xfb, 0xfe, 0x3a, 0xf2, 0x3e, 0x68, 0x74, 0xff, 0xa9, 0x40, 0x8b, 0x24,
-	0xe2, 0x2f, 0x53, 0x6d, 0x74, 0x04, 0x72, 0xe0, 0x63, 0xa9, 0x23, 0xf5, 0x2a, 0x44, 0x0e, 0x7c,
-	0x74, 0x02, 0x8a, 0xa8, 0x1e, 0xf8, 0x58, 0xe6, 0xd1, 0x1a, 0xf7, 0x2d, 0xbe, 0xc4, 0xc2, 0x55,
-	0x30, 0x4b, 0x96, 0x4a, 0x62, 0x89, 0xfb, 0x96, 0x8f, 0xbe, 0x07, 0x65, 0xdb, 0x2d, 0x2e, 0x77,
-	0xa4, 0x5e, 0x63, 0xf0, 0x54, 0xdb, 0xb5, 0xab, 0xe5, 0x2a, 0x6a, 0x5b, 0x83, 0xec, 0xb6, 0x20,
-	0x1d, 0x5a, 0x5b, 0xdb, 0x65, 0xef, 0x56, 0x14, 0x57, 0x3a, 0x52, 0xef, 0x68, 0xf0, 0xd9, 0x3e,
-	0x0d, 0xe7, 0xdd, 0x8a, 0x92, 0xe6, 0xdb, 0x8c, 0x87, 0xce, 0x32, 0x12, 0xb3, 0x30, 0x66, 0xb8,
-	0xca, 0x3b, 0xdc, 0x25, 0x0d, 0xc3, 0x98, 0xa1, 0x6f, 0xa0, 0xea, 0x2d, 0xe3, 0xdf, 0x69, 0x84,
-	0x6b, 0xbc, 0xc9, 0xd3, 0xbd, 0x4d, 0xea, 0x3c, 0x8d, 0xa4, 0xe9, 0xe8, 0x5b, 0x68, 0x08, 0xcb,
-	0x65, 0xc1, 0x82, 0x62, 0x85, 0xef, 0x3e, 0xd1, 0xc4, 0xb4, 0xb5, 0xed, 0xb4, 0xb5, 0x51, 0x3a,
-	0x6d, 0x02, 0x22, 0xdb, 0x09, 0x16, 0x14, 0x3d, 0x85, 0xe6, 0xaf, 0x61, 0xcc, 0xdc, 0x59, 0xb8,
-	0x58, 0xd0, 0x25, 0xc3, 0xf5, 0x8e, 0xd4, 0xab, 0x93, 0x46, 0x12, 0x1b, 0x8a, 0x50, 0x92, 0x12,
-	0xd3, 0x59, 0x44, 0x99, 0xcb, 0x07, 0x8a, 0x41, 0xa4, 0x88, 0x98, 0x93, 0x84, 0xd0, 0x29, 0xa4,
-	0xae, 0x38, 0x5d, 0x83, 0x9f, 0x0e, 0x44, 0x88, 0x9f, 0xed, 0x19, 0xb4, 0x58, 0xe4, 0x2d, 0xe3,
-	0xd7, 0x49, 0x93, 0xc9, 0x0c, 0x9b, 0x7c, 0x86, 0xc7, 0x99, 0x23, 0x3a, 0xe9, 0xba, 0x18, 0x1f,
-	0xcb, 0x78, 0x89, 0x7c, 0x10, 0xbb, 0xbf, 0x51, 0xba, 0xf2, 0xae, 0xe7, 0x14, 0xb7, 0x3a, 0x52,
-	0x4f, 0x21, 0x10, 0xc4, 0xcf, 0xd3, 0x48, 0xfb, 0x39, 0x28, 0x7b, 0x99, 0x41, 0x50, 0x66, 0xf4,
-	0x0f, 0xc6, 0x79, 0xa9, 0x13, 0x6e, 0xa3, 0xc7, 0x50, 0x5f, 0x50, 0x3f, 0xf0, 0xdc, 0x75, 0x34,
-	0xe7, 0xb4, 0xd4, 0x89, 0xc2, 0x03, 0xd3, 0x68, 0xde, 0xb6, 0xa0, 0x2a, 0x06, 0xfc, 0xd1, 0x52,
-	0xdd, 0xbf, 0xca, 0xd0, 0x1e, 0x46, 0xd4, 0x63, 0x34, 0x77, 0x81, 0x84, 0xf2, 0x8b, 0x4f, 0xce,
-	0xb5, 0xc3, 0x62, 0x57, 0x08, 0xb6, 0xa1, 0x02, 0xd4, 0x72, 0x1e, 0xea, 0xec, 0x53, 0x28, 0xe5,
-	0x9f, 0x82, 0x5d, 0x04, 0xb6, 0x7c, 0x18, 0x58, 0x43, 0xbd, 0x31, 0x5a, 0xef, 0x25, 0xc0, 0x12,
-	0x96, 0x71, 0x09, 0x97, 0x71, 0xa5, 0x80, 0xf0, 0x57, 0x45, 0x84, 0x93, 0x57, 0x50, 0x31, 0x6a,
-	0x37, 0x46, 0xb9, 0x2d, 0xf7, 0xa4, 0x02, 0xcb, 0x2f, 0xf2, 0x48, 0x56, 0x0f, 0x20, 0xc9, 0xab,
-	0xff, 0x2d, 0x81, 0x22, 0x75, 0x65, 0xe5, 0xd9, 0x40, 0x56, 0x2a, 0xf7, 0x42, 0x5a, 0x3b, 0x0c,
-	0xa9, 0x72, 0x10, 0xd2, 0xfa, 0x2d, 0x48, 0x0b, 0x98, 0x41, 0x11, 0x33, 0xf4, 0xa2, 0x48, 0x71,
-	0xe3, 0x5e, 0x8a, 0x8d, 0xa3, 0x1b, 0xa3, 0xf1, 0x5e, 0x52, 0xf0, 0x03, 0x31, 0xd5, 0x3c, 0xd5,
-	0x5d, 0x0b, 0x1e, 0xdf, 0xc9, 0x46, 0xbc, 0x0a, 0x97, 0x31, 0x45, 0xe7, 0xf0, 0x30, 0xff, 0xa5,
-	0xfd, 0x80, 0xc8, 0x27, 0x51, 0x76, 0x87, 0xe5, 0x77, 0x4d, 0x38, 0xfe, 0x91, 0xb2, 0x3b, 0x19,
-	0xfb, 0x3f, 0x32, 0xaf, 0x00, 0xdf, 0x96, 0x49, 0xdb, 0xf9, 0x01, 0x8e, 0xf2, 0x3a, 0x5c, 0xa4,
-	0x31, 0xc0, 0xfb, 0xa8, 0x22, 0xad, 0x9c, 0xfc, 0xf9, 0x25, 0x34, 0xb3, 0xc3, 0x41, 0x9f, 0xc3,
-	0x89, 0x43, 0xf4, 0x89, 0x3d, 0x36, 0x89, 0xeb, 0xfc, 0x7c, 0x65, 0xba, 0xd3, 0x89, 0x7d, 0x65,
-	0x0e, 0xad, 0xb1, 0x65, 0x8e, 0xd4, 0x07, 0x08, 0xa0, 0x6a, 0x98, 0xe3, 0x4b, 0x62, 0xaa, 0x12,
-	0xaa, 0x43, 0x45, 0x1f, 0x3b, 0x26, 0x51, 0xe5, 0xc4, 0x9c, 0x98, 0x3f, 0x99, 0x44, 0x2d, 0x9d,
-	0xff, 0x09, 0x0f, 0x6f, 0x61, 0x8c, 0xce, 0xe0, 0x94, 0x5c, 0x4e, 0x27, 0x23, 0xf7, 0xe5, 0xd4,
-	0xb4, 0x1d, 0xeb, 0x72, 0x72, 0x97, 0x76, 0x13, 0x14, 0xdb, 0xd1, 0x27, 0x23, 0x9d, 0x8c, 0x54,
-	0x09, 0x29, 0x50, 0xb6, 0xf5, 0xb1, 0xa9, 0xca, 0x49, 0x4d, 0xdb, 0x1c, 0x12, 0xd3, 0x51, 0x4b,
-	0x48, 0x85, 0xa6, 0x3d, 0xbd, 0x32, 0x89, 0x9b, 0x46, 0xca, 0xa8, 0x01, 0x35, 0x7d, 0x3a, 0x4c,
-	0x34, 0xd5, 0xca, 0xe0, 0x5f, 0x09, 0x1e, 0xe5, 0xaa, 0xdb, 0x34, 0xda, 0x04, 0x33, 0x8a, 0x7c,
-	0xf8, 0xf4, 0x8e, 0x5b, 0x45, 0x5f, 0x64, 0xc6, 0xb4, 0xff, 0x8b, 0xd0, 0xfe, 0xf2, 0x50, 0x5a,
-	0x7a, 0x1b, 0xaf, 0x40, 0x2d, 0xde, 0x14, 0xea, 0x66, 0xf6, 0xee, 0xa1, 0xa1, 0x7d, 0x76, 0x6f,
-	0x8e, 0x10, 0x37, 0x1e, 0xfd, 0x82, 0x76, 0x7f, 0xfb, 0xef, 0x84, 0xb5, 0xb9, 0xb8, 0xae, 0xf2,
-	0x57, 0xfb, 0xf5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x22, 0xbf, 0x0f, 0x0a, 0x08, 0x00,
-	0x00,
+var twirpFileDescriptor5 = []byte{
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0xa9, 0x3f, 0x6a, 0x24, 0xd9, 0xf4, 0xd6, 0xad, 0xd7, 0x74, 0x1b, 0x2b, 0x32, 0x52,
+	0x08, 0x46, 0x21, 0xc3, 0xce, 0xa1, 0x40, 0x9b, 0xa2, 0x90, 0x64, 0xaa, 0x10, 0x9a, 0xc8, 0xce,
+	0x92, 0x2a, 0xd0, 0xe6, 0x40, 0xd0, 0xe2, 0xda, 0x21, 0x22, 0x89, 0x0c, 0xb9, 0x56, 0x6d, 0x14,
+	0x28, 0x8a, 0xbc, 0x4a, 0x6f, 0xed, 0xa9, 0xaf, 0xd4, 0xc7, 0xf0, 0xa9, 0xd8, 0x25, 0x25, 0x93,
+	0x94, 0x65, 0x23, 0xe8, 0x21, 0xb7, 0xd9, 0x6f, 0x66, 0x67, 0x66, 0x67, 0xbe, 0x4f, 0x14, 0x3c,
+	0xa6, 0x8e, 0xcb, 0xbc, 0xe0, 0x60, 0x76, 0x78, 0x10, 0x78, 0x97, 0x53, 0xc7, 0x7a, 0x77, 0x49,
+	0x43, 0xe6, 0x7a, 0xd3, 0x96, 0x1f, 0x78, 0xcc, 0x43, 0xe5, 0xc8, 0xdf, 0x9a, 0x1d, 0x6a, 0x9f,
+	0xde, 0x86, 0x4e, 0xa8, 0xe3, 0xda, 0x51, 0x84, 0xb6, 0x35, 0xb3, 0xc7, 0xae, 0x63, 0x33, 0x7a,
+	0x30, 0x37, 0x62, 0xc7, 0xe3, 0x0b, 0xcf, 0xbb, 0x18, 0xd3, 0x03, 0x71, 0x3a, 0xbb, 0x3c, 0x3f,
+	0x70, 0x2e, 0x03, 0xfb, 0x36, 0xb5, 0xb6, 0x93, 0xf5, 0xd3, 0x89, 0xcf, 0xae, 0x23, 0x67, 0xa3,
+	0x0f, 0xeb, 0x06, 0x1d, 0x05, 0x94, 0x75, 0xbd, 0x90, 0x11, 0x7b, 0x7a, 0x41, 0x91, 0x0a, 0xb9,
+	0x89, 0x3b, 0xc5, 0x52, 0x5d, 0x6a, 0x16, 0x08, 0x37, 0x05, 0x62, 0x5f, 0x61, 0x39, 0x46, 0xec,
+	0x2b, 0x84, 0x20, 0x1f, 0x32, 0xea, 0xe3, 0x9c, 0x80, 0x84, 0xdd, 0xf8, 0xb3, 0x04, 0x35, 0xc2,
+	0xdf, 0xf6, 0x2a, 0x7e, 0x1a, 0x5a, 0x03, 0xd9, 0x75, 0xe2, 0x44, 0xb2, 0xeb, 0xa0, 0x6d, 0x50,
+	0xa2, 0xc7, 0xbb, 0x4e, 0x9c, 0xac, 0x24, 0xce, 0x7d, 0xe1, 0x62, 0x9e, 0xef, 0x8e, 0xb8, 0x2b,
+	0x4a, 0x5a, 0x12, 0xe7, 0xbe, 0x83, 0xbe, 0x03, 0x65, 0x3e, 0x2c, 0x9c, 0xaf, 0x4b, 0xcd, 0xca,
+	0xd1, 0x93, 0xd6, 0x62, 0x5a, 0xad, 0x54, 0xc5, 0xd6, 0xdc, 0x20, 0x8b, 0x2b, 0xa8, 0x0d, 0xb5,
+	0xb9, 0x6d, 0xb1, 0x6b, 0x9f, 0xe2, 0x42, 0x5d, 0x6a, 0xae, 0x1d, 0x7d, 0xbe, 0x2a, 0x87, 0x79,
+	0xed, 0x53, 0x52, 0x7d, 0x97, 0x38, 0xa1, 0xbd, 0x44, 0x8a, 0x91, 0x17, 0x32, 0x5c, 0x14, 0x1d,
+	0x2e, 0x82, 0xf8, 0xec, 0xd0, 0xd7, 0x50, 0xb4, 0xa7, 0xe1, 0xaf, 0x34, 0xc0, 0x25, 0xd1, 0xe4,
+	0xee, 0xca, 0x26, 0xdb, 0x22, 0x8c, 0xc4, 0xe1, 0xe8, 0x1b, 0xa8, 0x44, 0x96, 0xc5, 0xdc, 0x09,
+	0xc5, 0x8a, 0xb8, 0xbd, 0xdd, 0x8a, 0xb6, 0xd6, 0x9a, 0x6f, 0xad, 0x75, 0x1c, 0x6f, 0x95, 0x40,
+	0x14, 0x6d, 0xba, 0x13, 0x8a, 0x9e, 0x40, 0xf5, 0x8d, 0x17, 0x32, 0x6b, 0xe4, 0x4d, 0x26, 0x74,
+	0xca, 0x70, 0xb9, 0x2e, 0x35, 0xcb, 0xa4, 0xc2, 0xb1, 0x6e, 0x04, 0xf1, 0x90, 0x50, 0x6c, 0xd8,
+	0x12, 0x03, 0xc5, 0x10, 0x85, 0x44, 0x98, 0xc9, 0x21, 0xb4, 0x0b, 0xf1, 0x31, 0x7a, 0x5d, 0x45,
+	0xbc, 0x0e, 0xc2, 0x05, 0x2f, 0xd0, 0x73, 0xa8, 0xb1, 0xc0, 0x9e, 0x86, 0xe7, 0x34, 0x88, 0x66,
+	0x58, 0x15, 0x33, 0xdc, 0x4a, 0x3c, 0xd1, 0x8c, 0xfd, 0xd1, 0xf8, 0x58, 0xe2, 0xc4, 0xd3, 0xbb,
+	0xa1, 0xf5, 0x96, 0x52, 0xdf, 0x3e, 0x1b, 0x53, 0x5c, 0xab, 0x4b, 0x4d, 0x85, 0x80, 0x1b, 0xfe,
+	0x18, 0x23, 0x3c, 0x20, 0x5a, 0x3e, 0x73, 0xd9, 0x98, 0xe2, 0x35, 0xd1, 0x21, 0x08, 0xc8, 0xe4,
+	0x08, 0xea, 0xc1, 0x46, 0xa2, 0x41, 0x2b, 0xe0, 0x3c, 0xc5, 0xeb, 0x62, 0x50, 0x5a, 0xa2, 0x87,
+	0x0c, 0x93, 0xc9, 0x7a, 0x98, 0x06, 0xb4, 0x3f, 0x24, 0x50, 0x56, 0xb2, 0x13, 0x41, 0x9e, 0xd1,
+	0x2b, 0x26, 0x98, 0x59, 0x26, 0xc2, 0x46, 0x3b, 0x50, 0x16, 0x1a, 0xb4, 0x2e, 0x83, 0xb1, 0xe0,
+	0x65, 0x99, 0x28, 0x02, 0x18, 0x06, 0x63, 0xf4, 0x0c, 0x20, 0x72, 0x8e, 0xc6, 0xae, 0x1f, 0x53,
+	0x73, 0x33, 0xd1, 0xce, 0x4b, 0xee, 0xec, 0x8e, 0x5d, 0x9f, 0x94, 0x27, 0x73, 0x53, 0xfb, 0x1d,
+	0x8a, 0xd1, 0xfe, 0x3f, 0x4e, 0xfd, 0xc6, 0xbf, 0x79, 0xd0, 0xba, 0x01, 0xb5, 0x19, 0x4d, 0x91,
+	0x92, 0x50, 0x41, 0x66, 0xbe, 0x8a, 0x05, 0xd5, 0x17, 0xdd, 0xc1, 0x1c, 0xca, 0x08, 0x55, 0x4e,
+	0x0b, 0x35, 0x29, 0xef, 0x5c, 0x5a, 0xde, 0x46, 0x56, 0x84, 0xf9, 0x87, 0x45, 0xd8, 0x51, 0x6f,
+	0x3a, 0xb5, 0xf7, 0x12, 0x60, 0x09, 0xcb, 0x38, 0x87, 0xf3, 0xb8, 0x90, 0x91, 0xe5, 0x57, 0x59,
+	0x59, 0x72, 0x65, 0x17, 0x3a, 0xa5, 0x9b, 0x4e, 0x5e, 0x93, 0x9b, 0x52, 0x46, 0x9f, 0x2f, 0xd2,
+	0x32, 0x2b, 0x3e, 0x20, 0x33, 0x51, 0xfd, 0x2f, 0x09, 0x8e, 0x64, 0xa5, 0xa0, 0x48, 0x0d, 0x59,
+	0x79, 0x7e, 0xaf, 0xf0, 0x4a, 0x0f, 0x0b, 0x4f, 0x79, 0x50, 0x78, 0xe5, 0x25, 0xe1, 0x65, 0xa4,
+	0x03, 0x4b, 0xd2, 0x79, 0x91, 0x55, 0x66, 0xe5, 0x5e, 0x65, 0x76, 0xd6, 0x6e, 0x3a, 0x95, 0xf7,
+	0x92, 0x82, 0x1f, 0x45, 0x53, 0xcd, 0x28, 0xf5, 0x4e, 0x9d, 0x55, 0x3f, 0x58, 0x67, 0x8d, 0x3e,
+	0xec, 0xdc, 0xc9, 0xb1, 0xd0, 0xf7, 0xa6, 0x21, 0x45, 0xfb, 0xb0, 0x91, 0xfe, 0x08, 0xde, 0x52,
+	0x6d, 0x3d, 0x48, 0xde, 0xe8, 0x3b, 0x8d, 0x73, 0xd8, 0xfa, 0x81, 0xb2, 0x3b, 0xb9, 0xfa, 0x01,
+	0x69, 0xc4, 0xa4, 0xdf, 0xd8, 0x01, 0xb5, 0x98, 0xf7, 0x96, 0x4e, 0x63, 0x8d, 0x81, 0x80, 0x4c,
+	0x8e, 0x34, 0x5e, 0x03, 0x5e, 0xae, 0x13, 0xf7, 0xfb, 0x3d, 0xac, 0xa5, 0x0b, 0x89, 0x2a, 0x95,
+	0x23, 0xbc, 0x8a, 0xbe, 0xa4, 0x96, 0xaa, 0xdf, 0xf8, 0x47, 0x02, 0xfc, 0xd2, 0x9b, 0xd1, 0xff,
+	0xfd, 0x8c, 0x3a, 0x54, 0x1d, 0xbe, 0x98, 0xf4, 0x57, 0x14, 0x9c, 0x30, 0xea, 0xfc, 0x36, 0x22,
+	0xf3, 0x31, 0xe5, 0x11, 0x66, 0x2c, 0xd3, 0xa7, 0xa0, 0xf8, 0x5e, 0xe8, 0x2e, 0xbe, 0xa7, 0x85,
+	0x4e, 0xf9, 0xa6, 0x53, 0xd4, 0xf2, 0x4d, 0x09, 0x03, 0x59, 0xb8, 0xf6, 0x4f, 0xa0, 0x9a, 0x64,
+	0x0e, 0xfa, 0x02, 0xb6, 0x4d, 0xd2, 0x1e, 0x18, 0x3d, 0x9d, 0x58, 0xe6, 0xcf, 0xa7, 0xba, 0x35,
+	0x1c, 0x18, 0xa7, 0x7a, 0xb7, 0xdf, 0xeb, 0xeb, 0xc7, 0xea, 0x23, 0x04, 0x50, 0xec, 0xe8, 0xbd,
+	0x13, 0xa2, 0xab, 0x12, 0x2a, 0x43, 0xa1, 0xdd, 0x33, 0x75, 0xa2, 0xca, 0xdc, 0x1c, 0xe8, 0x3f,
+	0xe9, 0x44, 0xcd, 0xed, 0xff, 0x06, 0x1b, 0x4b, 0x1a, 0x47, 0x7b, 0xb0, 0x4b, 0x4e, 0x86, 0x83,
+	0x63, 0xeb, 0xd5, 0x50, 0x37, 0xcc, 0xfe, 0xc9, 0xe0, 0xae, 0xdc, 0x55, 0x50, 0x0c, 0xb3, 0x3d,
+	0x38, 0x6e, 0x93, 0x63, 0x55, 0x42, 0x0a, 0xe4, 0x8d, 0x76, 0x4f, 0x57, 0x65, 0x5e, 0xd3, 0xd0,
+	0xbb, 0x44, 0x37, 0xd5, 0x1c, 0x52, 0xa1, 0x6a, 0x0c, 0x4f, 0x75, 0x62, 0xc5, 0x48, 0x1e, 0x55,
+	0xa0, 0xd4, 0x1e, 0x76, 0x79, 0x4e, 0xb5, 0x70, 0xf4, 0xb7, 0x0c, 0x9b, 0xa9, 0xea, 0x06, 0x0d,
+	0x66, 0xee, 0x88, 0x22, 0x07, 0x3e, 0xb9, 0x83, 0xaa, 0xe8, 0x69, 0x62, 0xb5, 0xab, 0x7f, 0x2e,
+	0xb5, 0x2f, 0x1f, 0x0a, 0x8b, 0x19, 0xf4, 0x1a, 0xd4, 0x2c, 0xbb, 0x50, 0x23, 0x71, 0x77, 0x05,
+	0xc5, 0xb5, 0xbd, 0x7b, 0x63, 0xe2, 0xe4, 0xa7, 0xb0, 0xb1, 0x44, 0x2e, 0x94, 0xbc, 0xb9, 0x8a,
+	0x7a, 0xda, 0x67, 0x4b, 0x3f, 0x7f, 0x3a, 0xff, 0x6f, 0xd8, 0xd9, 0xfc, 0x05, 0x2d, 0xfe, 0x84,
+	0x7e, 0x1b, 0x59, 0xb3, 0xc3, 0xb3, 0xa2, 0x88, 0x7a, 0xf6, 0xdf, 0x00, 0xde, 0x67, 0x52, 0xec,
+	0xcc, 0x0a, 0x00, 0x00,
 }
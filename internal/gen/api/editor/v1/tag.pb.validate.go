@@ -60,6 +60,8 @@ func (m *Tag) validate(all bool) error {
 
 	// no validation rules for Author
 
+	// no validation rules for UsageCount
+
 	if all {
 		switch v := interface{}(m.GetCreateTime()).(type) {
 		case interface{ ValidateAll() error }:
@@ -658,3 +660,723 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListTagsResponseValidationError{}
+
+// Validate checks the field values on UpdateTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagRequestMultiError, or nil if none found.
+func (m *UpdateTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TagName
+
+	if l := utf8.RuneCountInString(m.GetNewTagName()); l < 3 || l > 15 {
+		err := UpdateTagRequestValidationError{
+			field:  "NewTagName",
+			reason: "value length must be between 3 and 15 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTagRequestValidationError is the validation error returned by
+// UpdateTagRequest.Validate if the designated constraints aren't met.
+type UpdateTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagRequestValidationError) ErrorName() string { return "UpdateTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagRequestValidationError{}
+
+// Validate checks the field values on DeleteTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagRequestMultiError, or nil if none found.
+func (m *DeleteTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TagName
+
+	if len(errors) > 0 {
+		return DeleteTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTagRequestValidationError is the validation error returned by
+// DeleteTagRequest.Validate if the designated constraints aren't met.
+type DeleteTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagRequestValidationError) ErrorName() string { return "DeleteTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagRequestValidationError{}
+
+// Validate checks the field values on SearchTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchTagsRequestMultiError, or nil if none found.
+func (m *SearchTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQuery()) > 15 {
+		err := SearchTagsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 15 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 50 {
+		err := SearchTagsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchTagsRequestMultiError) AllErrors() []error { return m }
+
+// SearchTagsRequestValidationError is the validation error returned by
+// SearchTagsRequest.Validate if the designated constraints aren't met.
+type SearchTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchTagsRequestValidationError) ErrorName() string {
+	return "SearchTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchTagsRequestValidationError{}
+
+// Validate checks the field values on SearchTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchTagsResponseMultiError, or nil if none found.
+func (m *SearchTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchTagsResponseMultiError is an error wrapping multiple validation errors
+// returned by SearchTagsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SearchTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchTagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchTagsResponseMultiError) AllErrors() []error { return m }
+
+// SearchTagsResponseValidationError is the validation error returned by
+// SearchTagsResponse.Validate if the designated constraints aren't met.
+type SearchTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchTagsResponseValidationError) ErrorName() string {
+	return "SearchTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchTagsResponseValidationError{}
+
+// Validate checks the field values on MergeTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeTagsRequestMultiError, or nil if none found.
+func (m *MergeTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetSrcTagNames()); l < 1 || l > 10 {
+		err := MergeTagsRequestValidationError{
+			field:  "SrcTagNames",
+			reason: "value must contain between 1 and 10 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MergeTagsRequest_SrcTagNames_Unique := make(map[string]struct{}, len(m.GetSrcTagNames()))
+
+	for idx, item := range m.GetSrcTagNames() {
+		_, _ = idx, item
+
+		if _, exists := _MergeTagsRequest_SrcTagNames_Unique[item]; exists {
+			err := MergeTagsRequestValidationError{
+				field:  fmt.Sprintf("SrcTagNames[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MergeTagsRequest_SrcTagNames_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for SrcTagNames[idx]
+	}
+
+	// no validation rules for DstTagName
+
+	if len(errors) > 0 {
+		return MergeTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeTagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeTagsRequestMultiError) AllErrors() []error { return m }
+
+// MergeTagsRequestValidationError is the validation error returned by
+// MergeTagsRequest.Validate if the designated constraints aren't met.
+type MergeTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeTagsRequestValidationError) ErrorName() string { return "MergeTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeTagsRequestValidationError{}
+
+// Validate checks the field values on BanTagRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BanTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BanTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BanTagRequestMultiError, or
+// nil if none found.
+func (m *BanTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BanTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTagName()); l < 3 || l > 15 {
+		err := BanTagRequestValidationError{
+			field:  "TagName",
+			reason: "value length must be between 3 and 15 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BanTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BanTagRequestMultiError is an error wrapping multiple validation errors
+// returned by BanTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BanTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BanTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BanTagRequestMultiError) AllErrors() []error { return m }
+
+// BanTagRequestValidationError is the validation error returned by
+// BanTagRequest.Validate if the designated constraints aren't met.
+type BanTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BanTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BanTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BanTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BanTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BanTagRequestValidationError) ErrorName() string { return "BanTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BanTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBanTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BanTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BanTagRequestValidationError{}
@@ -16,6 +16,8 @@ import proto "google.golang.org/protobuf/proto"
 import twirp "github.com/twitchtv/twirp"
 import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
 
+import google_protobuf3 "google.golang.org/protobuf/types/known/emptypb"
+
 // Version compatibility assertion.
 // If the constant is not defined in the package, that likely means
 // the package needs to be updated to work with this generated code.
@@ -30,6 +32,26 @@ type TagService interface {
 	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
 
 	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+
+	// SearchTags returns tags which names start with query or similar to it.
+	// Most popular tags are returned if query is empty.
+	SearchTags(context.Context, *SearchTagsRequest) (*SearchTagsResponse, error)
+
+	// UpdateTag renames tag.
+	// Tag used in published pack cannot be renamed.
+	UpdateTag(context.Context, *UpdateTagRequest) (*google_protobuf3.Empty, error)
+
+	// DeleteTag deletes tag which is not used in any pack.
+	DeleteTag(context.Context, *DeleteTagRequest) (*google_protobuf3.Empty, error)
+
+	// MergeTags merges source tags into destination tag,
+	// packs are retagged and source tag names become aliases of destination tag.
+	// Available only for admins.
+	MergeTags(context.Context, *MergeTagsRequest) (*google_protobuf3.Empty, error)
+
+	// BanTag bans tag name, banned tags cannot be created or added to packs.
+	// Available only for admins.
+	BanTag(context.Context, *BanTagRequest) (*google_protobuf3.Empty, error)
 }
 
 // ==========================
@@ -38,7 +60,7 @@ type TagService interface {
 
 type tagServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [7]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -66,9 +88,14 @@ func NewTagServiceProtobufClient(baseURL string, client HTTPClient, opts ...twir
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "TagService")
-	urls := [2]string{
+	urls := [7]string{
 		serviceURL + "CreateTag",
 		serviceURL + "ListTags",
+		serviceURL + "SearchTags",
+		serviceURL + "UpdateTag",
+		serviceURL + "DeleteTag",
+		serviceURL + "MergeTags",
+		serviceURL + "BanTag",
 	}
 
 	return &tagServiceProtobufClient{
@@ -171,13 +198,243 @@ func (c *tagServiceProtobufClient) callListTags(ctx context.Context, in *ListTag
 	return out, nil
 }
 
+func (c *tagServiceProtobufClient) SearchTags(ctx context.Context, in *SearchTagsRequest) (*SearchTagsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchTags")
+	caller := c.callSearchTags
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchTagsRequest) (*SearchTagsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchTagsRequest) when calling interceptor")
+					}
+					return c.callSearchTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchTagsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchTagsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceProtobufClient) callSearchTags(ctx context.Context, in *SearchTagsRequest) (*SearchTagsResponse, error) {
+	out := new(SearchTagsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceProtobufClient) UpdateTag(ctx context.Context, in *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTag")
+	caller := c.callUpdateTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTagRequest) when calling interceptor")
+					}
+					return c.callUpdateTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceProtobufClient) callUpdateTag(ctx context.Context, in *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceProtobufClient) DeleteTag(ctx context.Context, in *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
+	caller := c.callDeleteTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTagRequest) when calling interceptor")
+					}
+					return c.callDeleteTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceProtobufClient) callDeleteTag(ctx context.Context, in *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceProtobufClient) MergeTags(ctx context.Context, in *MergeTagsRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeTags")
+	caller := c.callMergeTags
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MergeTagsRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergeTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergeTagsRequest) when calling interceptor")
+					}
+					return c.callMergeTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceProtobufClient) callMergeTags(ctx context.Context, in *MergeTagsRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceProtobufClient) BanTag(ctx context.Context, in *BanTagRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "BanTag")
+	caller := c.callBanTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BanTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanTagRequest) when calling interceptor")
+					}
+					return c.callBanTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceProtobufClient) callBanTag(ctx context.Context, in *BanTagRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ======================
 // TagService JSON Client
 // ======================
 
 type tagServiceJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [7]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -205,9 +462,14 @@ func NewTagServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.Cl
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "TagService")
-	urls := [2]string{
+	urls := [7]string{
 		serviceURL + "CreateTag",
 		serviceURL + "ListTags",
+		serviceURL + "SearchTags",
+		serviceURL + "UpdateTag",
+		serviceURL + "DeleteTag",
+		serviceURL + "MergeTags",
+		serviceURL + "BanTag",
 	}
 
 	return &tagServiceJSONClient{
@@ -259,168 +521,1313 @@ func (c *tagServiceJSONClient) callCreateTag(ctx context.Context, in *CreateTagR
 		return nil, err
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceJSONClient) ListTags(ctx context.Context, in *ListTagsRequest) (*ListTagsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListTags")
+	caller := c.callListTags
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTagsRequest) when calling interceptor")
+					}
+					return c.callListTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTagsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTagsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceJSONClient) callListTags(ctx context.Context, in *ListTagsRequest) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceJSONClient) SearchTags(ctx context.Context, in *SearchTagsRequest) (*SearchTagsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchTags")
+	caller := c.callSearchTags
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchTagsRequest) (*SearchTagsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchTagsRequest) when calling interceptor")
+					}
+					return c.callSearchTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchTagsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchTagsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceJSONClient) callSearchTags(ctx context.Context, in *SearchTagsRequest) (*SearchTagsResponse, error) {
+	out := new(SearchTagsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceJSONClient) UpdateTag(ctx context.Context, in *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTag")
+	caller := c.callUpdateTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTagRequest) when calling interceptor")
+					}
+					return c.callUpdateTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceJSONClient) callUpdateTag(ctx context.Context, in *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceJSONClient) DeleteTag(ctx context.Context, in *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
+	caller := c.callDeleteTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTagRequest) when calling interceptor")
+					}
+					return c.callDeleteTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceJSONClient) callDeleteTag(ctx context.Context, in *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceJSONClient) MergeTags(ctx context.Context, in *MergeTagsRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeTags")
+	caller := c.callMergeTags
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MergeTagsRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MergeTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MergeTagsRequest) when calling interceptor")
+					}
+					return c.callMergeTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceJSONClient) callMergeTags(ctx context.Context, in *MergeTagsRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *tagServiceJSONClient) BanTag(ctx context.Context, in *BanTagRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithMethodName(ctx, "BanTag")
+	caller := c.callBanTag
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BanTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BanTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BanTagRequest) when calling interceptor")
+					}
+					return c.callBanTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *tagServiceJSONClient) callBanTag(ctx context.Context, in *BanTagRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// TagService Server Handler
+// =========================
+
+type tagServiceServer struct {
+	TagService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewTagServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewTagServiceServer(svc TagService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &tagServiceServer{
+		TagService:       svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *tagServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *tagServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// TagServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const TagServicePathPrefix = "/twirp/editor.v1.TagService/"
+
+func (s *tagServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TagService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "editor.v1.TagService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateTag":
+		s.serveCreateTag(ctx, resp, req)
+		return
+	case "ListTags":
+		s.serveListTags(ctx, resp, req)
+		return
+	case "SearchTags":
+		s.serveSearchTags(ctx, resp, req)
+		return
+	case "UpdateTag":
+		s.serveUpdateTag(ctx, resp, req)
+		return
+	case "DeleteTag":
+		s.serveDeleteTag(ctx, resp, req)
+		return
+	case "MergeTags":
+		s.serveMergeTags(ctx, resp, req)
+		return
+	case "BanTag":
+		s.serveBanTag(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *tagServiceServer) serveCreateTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateTagJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateTagProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tagServiceServer) serveCreateTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateTagRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TagService.CreateTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateTagRequest) (*CreateTagResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTagRequest) when calling interceptor")
+					}
+					return s.TagService.CreateTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateTagResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTagResponse and nil error while calling CreateTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveCreateTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateTagRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TagService.CreateTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateTagRequest) (*CreateTagResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateTagRequest) when calling interceptor")
+					}
+					return s.TagService.CreateTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateTagResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateTagResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateTagResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTagResponse and nil error while calling CreateTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveListTags(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListTagsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListTagsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tagServiceServer) serveListTagsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTags")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListTagsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TagService.ListTags
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTagsRequest) when calling interceptor")
+					}
+					return s.TagService.ListTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTagsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTagsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTagsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTagsResponse and nil error while calling ListTags. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveListTagsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListTags")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListTagsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TagService.ListTags
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListTagsRequest) when calling interceptor")
+					}
+					return s.TagService.ListTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListTagsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListTagsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListTagsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTagsResponse and nil error while calling ListTags. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveSearchTags(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSearchTagsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSearchTagsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tagServiceServer) serveSearchTagsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SearchTags")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SearchTagsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TagService.SearchTags
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchTagsRequest) (*SearchTagsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchTagsRequest) when calling interceptor")
+					}
+					return s.TagService.SearchTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchTagsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchTagsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchTagsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchTagsResponse and nil error while calling SearchTags. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveSearchTagsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SearchTags")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SearchTagsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TagService.SearchTags
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchTagsRequest) (*SearchTagsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchTagsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchTagsRequest) when calling interceptor")
+					}
+					return s.TagService.SearchTags(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchTagsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchTagsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchTagsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchTagsResponse and nil error while calling SearchTags. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveUpdateTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateTagJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateTagProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tagServiceServer) serveUpdateTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateTagRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TagService.UpdateTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTagRequest) when calling interceptor")
+					}
+					return s.TagService.UpdateTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling UpdateTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveUpdateTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateTagRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TagService.UpdateTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTagRequest) when calling interceptor")
+					}
+					return s.TagService.UpdateTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling UpdateTag. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *tagServiceServer) serveDeleteTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteTagJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteTagProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *tagServiceServer) serveDeleteTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteTagRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
 
-func (c *tagServiceJSONClient) ListTags(ctx context.Context, in *ListTagsRequest) (*ListTagsResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "TagService")
-	ctx = ctxsetters.WithMethodName(ctx, "ListTags")
-	caller := c.callListTags
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
-			resp, err := c.interceptor(
+	handler := s.TagService.DeleteTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListTagsRequest)
+					typedReq, ok := req.(*DeleteTagRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListTagsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTagRequest) when calling interceptor")
 					}
-					return c.callListTags(ctx, typedReq)
+					return s.TagService.DeleteTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListTagsResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListTagsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *tagServiceJSONClient) callListTags(ctx context.Context, in *ListTagsRequest) (*ListTagsResponse, error) {
-	out := new(ListTagsResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling DeleteTag. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
-
-// =========================
-// TagService Server Handler
-// =========================
-
-type tagServiceServer struct {
-	TagService
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewTagServiceServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewTagServiceServer(svc TagService, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
 	}
 
-	return &tagServiceServer{
-		TagService:       svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *tagServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+func (s *tagServiceServer) serveDeleteTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTag")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *tagServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(DeleteTagRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// TagServicePathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const TagServicePathPrefix = "/twirp/editor.v1.TagService/"
+	handler := s.TagService.DeleteTag
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteTagRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTagRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTagRequest) when calling interceptor")
+					}
+					return s.TagService.DeleteTag(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *tagServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
-	ctx = ctxsetters.WithServiceName(ctx, "TagService")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling DeleteTag. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "editor.v1.TagService" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "CreateTag":
-		s.serveCreateTag(ctx, resp, req)
-		return
-	case "ListTags":
-		s.serveListTags(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tagServiceServer) serveCreateTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagServiceServer) serveMergeTags(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -428,9 +1835,9 @@ func (s *tagServiceServer) serveCreateTag(ctx context.Context, resp http.Respons
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveCreateTagJSON(ctx, resp, req)
+		s.serveMergeTagsJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveCreateTagProtobuf(ctx, resp, req)
+		s.serveMergeTagsProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -438,9 +1845,9 @@ func (s *tagServiceServer) serveCreateTag(ctx context.Context, resp http.Respons
 	}
 }
 
-func (s *tagServiceServer) serveCreateTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagServiceServer) serveMergeTagsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateTag")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeTags")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -453,29 +1860,29 @@ func (s *tagServiceServer) serveCreateTagJSON(ctx context.Context, resp http.Res
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(CreateTagRequest)
+	reqContent := new(MergeTagsRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TagService.CreateTag
+	handler := s.TagService.MergeTags
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateTagRequest) (*CreateTagResponse, error) {
+		handler = func(ctx context.Context, req *MergeTagsRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateTagRequest)
+					typedReq, ok := req.(*MergeTagsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateTagRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*MergeTagsRequest) when calling interceptor")
 					}
-					return s.TagService.CreateTag(ctx, typedReq)
+					return s.TagService.MergeTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateTagResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateTagResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -484,7 +1891,7 @@ func (s *tagServiceServer) serveCreateTagJSON(ctx context.Context, resp http.Res
 	}
 
 	// Call service method
-	var respContent *CreateTagResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -495,7 +1902,7 @@ func (s *tagServiceServer) serveCreateTagJSON(ctx context.Context, resp http.Res
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTagResponse and nil error while calling CreateTag. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling MergeTags. nil responses are not supported"))
 		return
 	}
 
@@ -521,9 +1928,9 @@ func (s *tagServiceServer) serveCreateTagJSON(ctx context.Context, resp http.Res
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tagServiceServer) serveCreateTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagServiceServer) serveMergeTagsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "CreateTag")
+	ctx = ctxsetters.WithMethodName(ctx, "MergeTags")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -535,28 +1942,28 @@ func (s *tagServiceServer) serveCreateTagProtobuf(ctx context.Context, resp http
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(CreateTagRequest)
+	reqContent := new(MergeTagsRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TagService.CreateTag
+	handler := s.TagService.MergeTags
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *CreateTagRequest) (*CreateTagResponse, error) {
+		handler = func(ctx context.Context, req *MergeTagsRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*CreateTagRequest)
+					typedReq, ok := req.(*MergeTagsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*CreateTagRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*MergeTagsRequest) when calling interceptor")
 					}
-					return s.TagService.CreateTag(ctx, typedReq)
+					return s.TagService.MergeTags(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*CreateTagResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*CreateTagResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -565,7 +1972,7 @@ func (s *tagServiceServer) serveCreateTagProtobuf(ctx context.Context, resp http
 	}
 
 	// Call service method
-	var respContent *CreateTagResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -576,7 +1983,7 @@ func (s *tagServiceServer) serveCreateTagProtobuf(ctx context.Context, resp http
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateTagResponse and nil error while calling CreateTag. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling MergeTags. nil responses are not supported"))
 		return
 	}
 
@@ -600,7 +2007,7 @@ func (s *tagServiceServer) serveCreateTagProtobuf(ctx context.Context, resp http
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tagServiceServer) serveListTags(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagServiceServer) serveBanTag(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -608,9 +2015,9 @@ func (s *tagServiceServer) serveListTags(ctx context.Context, resp http.Response
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveListTagsJSON(ctx, resp, req)
+		s.serveBanTagJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveListTagsProtobuf(ctx, resp, req)
+		s.serveBanTagProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -618,9 +2025,9 @@ func (s *tagServiceServer) serveListTags(ctx context.Context, resp http.Response
 	}
 }
 
-func (s *tagServiceServer) serveListTagsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagServiceServer) serveBanTagJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListTags")
+	ctx = ctxsetters.WithMethodName(ctx, "BanTag")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -633,29 +2040,29 @@ func (s *tagServiceServer) serveListTagsJSON(ctx context.Context, resp http.Resp
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(ListTagsRequest)
+	reqContent := new(BanTagRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.TagService.ListTags
+	handler := s.TagService.BanTag
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+		handler = func(ctx context.Context, req *BanTagRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListTagsRequest)
+					typedReq, ok := req.(*BanTagRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListTagsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BanTagRequest) when calling interceptor")
 					}
-					return s.TagService.ListTags(ctx, typedReq)
+					return s.TagService.BanTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListTagsResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListTagsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -664,7 +2071,7 @@ func (s *tagServiceServer) serveListTagsJSON(ctx context.Context, resp http.Resp
 	}
 
 	// Call service method
-	var respContent *ListTagsResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -675,7 +2082,7 @@ func (s *tagServiceServer) serveListTagsJSON(ctx context.Context, resp http.Resp
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTagsResponse and nil error while calling ListTags. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling BanTag. nil responses are not supported"))
 		return
 	}
 
@@ -701,9 +2108,9 @@ func (s *tagServiceServer) serveListTagsJSON(ctx context.Context, resp http.Resp
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *tagServiceServer) serveListTagsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *tagServiceServer) serveBanTagProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "ListTags")
+	ctx = ctxsetters.WithMethodName(ctx, "BanTag")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -715,28 +2122,28 @@ func (s *tagServiceServer) serveListTagsProtobuf(ctx context.Context, resp http.
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(ListTagsRequest)
+	reqContent := new(BanTagRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.TagService.ListTags
+	handler := s.TagService.BanTag
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+		handler = func(ctx context.Context, req *BanTagRequest) (*google_protobuf3.Empty, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*ListTagsRequest)
+					typedReq, ok := req.(*BanTagRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*ListTagsRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BanTagRequest) when calling interceptor")
 					}
-					return s.TagService.ListTags(ctx, typedReq)
+					return s.TagService.BanTag(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*ListTagsResponse)
+				typedResp, ok := resp.(*google_protobuf3.Empty)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*ListTagsResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -745,7 +2152,7 @@ func (s *tagServiceServer) serveListTagsProtobuf(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *ListTagsResponse
+	var respContent *google_protobuf3.Empty
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -756,7 +2163,7 @@ func (s *tagServiceServer) serveListTagsProtobuf(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListTagsResponse and nil error while calling ListTags. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling BanTag. nil responses are not supported"))
 		return
 	}
 
@@ -781,7 +2188,7 @@ func (s *tagServiceServer) serveListTagsProtobuf(ctx context.Context, resp http.
 }
 
 func (s *tagServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor5, 0
+	return twirpFileDescriptor6, 0
 }
 
 func (s *tagServiceServer) ProtocGenTwirpVersion() string {
@@ -795,33 +2202,49 @@ func (s *tagServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "editor.v1", "TagService")
 }
 
-var twirpFileDescriptor5 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x38, 0x4d, 0xe2, 0x89, 0x20, 0x61, 0x41, 0x60, 0x5c, 0x10, 0x96, 0x85, 0xa0, 0x27,
-	0x5b, 0x09, 0x42, 0x42, 0xea, 0xcd, 0x95, 0x38, 0x21, 0x84, 0x5c, 0x9f, 0x38, 0x60, 0x4d, 0x9a,
-	0x61, 0xb1, 0x68, 0xbc, 0xc1, 0x3b, 0xb1, 0x68, 0xff, 0x09, 0xff, 0x93, 0x53, 0x4f, 0x95, 0x77,
-	0xe3, 0xd4, 0xaa, 0x72, 0x9b, 0x8f, 0x37, 0xef, 0xbd, 0x7d, 0x5a, 0x78, 0x4a, 0xab, 0x92, 0x55,
-	0x9d, 0x34, 0xf3, 0x84, 0x51, 0xc6, 0x9b, 0x5a, 0xb1, 0x12, 0x9e, 0x1d, 0xc6, 0xcd, 0x3c, 0x78,
-	0xd1, 0xe0, 0x65, 0xb9, 0x42, 0xa6, 0xa4, 0x2b, 0x2c, 0x26, 0x78, 0x23, 0x95, 0x92, 0x97, 0x94,
-	0x98, 0x6e, 0xb9, 0xfd, 0x99, 0x70, 0xb9, 0x26, 0xcd, 0xb8, 0xde, 0x58, 0x40, 0x54, 0x81, 0x9b,
-	0xa3, 0x14, 0x02, 0x06, 0x15, 0xae, 0xc9, 0x77, 0x42, 0xe7, 0xc4, 0xcb, 0x4c, 0x2d, 0x9e, 0xc3,
-	0x10, 0xb7, 0xfc, 0x4b, 0xd5, 0xfe, 0x43, 0x33, 0xdd, 0x75, 0xe2, 0x14, 0x26, 0x17, 0x35, 0x21,
-	0x53, 0xd1, 0x92, 0xf9, 0x8b, 0xd0, 0x39, 0x99, 0x2c, 0x82, 0xd8, 0x2a, 0xc5, 0x9d, 0x52, 0x9c,
-	0x77, 0x4a, 0x19, 0x58, 0x78, 0x3b, 0x88, 0x3e, 0xc1, 0xec, 0xcc, 0x76, 0x28, 0x33, 0xfa, 0xb3,
-	0x25, 0xcd, 0xe2, 0x2d, 0x8c, 0x19, 0x65, 0x71, 0x67, 0x20, 0xf5, 0x6e, 0xd2, 0x61, 0x3d, 0x98,
-	0xb9, 0xfe, 0x34, 0x1b, 0x31, 0xca, 0xaf, 0xb8, 0xa6, 0xe8, 0x23, 0x3c, 0xe9, 0x5d, 0xea, 0x8d,
-	0xaa, 0x34, 0x89, 0x10, 0x5c, 0x46, 0x69, 0xae, 0x26, 0x8b, 0xc7, 0xf1, 0x3e, 0x91, 0xb8, 0x05,
-	0xb5, 0xab, 0x88, 0x61, 0xfa, 0xa5, 0xd4, 0x9c, 0xa3, 0xd4, 0x9d, 0xde, 0x4b, 0x18, 0xab, 0x7a,
-	0x45, 0x75, 0xb1, 0xbc, 0xda, 0x3d, 0x78, 0x64, 0xfa, 0xf4, 0x4a, 0xbc, 0x07, 0x6f, 0x83, 0x92,
-	0x0a, 0x5d, 0x5e, 0x93, 0x79, 0xf6, 0x51, 0x0a, 0x37, 0xe9, 0x28, 0x38, 0x9a, 0xfd, 0x77, 0xc3,
-	0x07, 0xd9, 0xb8, 0x5d, 0x9e, 0x97, 0xd7, 0x24, 0x5e, 0x03, 0x18, 0x20, 0xab, 0xdf, 0x54, 0xf9,
-	0xae, 0x61, 0x31, 0xa7, 0x79, 0x3b, 0x88, 0x7e, 0xc0, 0xec, 0x4e, 0x75, 0xe7, 0x35, 0x82, 0x01,
-	0xa3, 0xd4, 0xbe, 0x13, 0xba, 0x07, 0xcc, 0x9a, 0x9d, 0x78, 0x07, 0xd3, 0x8a, 0xfe, 0x72, 0xd1,
-	0xe3, 0xb6, 0xe1, 0x3f, 0x6a, 0xc7, 0xdf, 0x3a, 0xfe, 0xc5, 0x3f, 0x07, 0x20, 0x47, 0x79, 0x4e,
-	0x75, 0x53, 0x5e, 0x90, 0xf8, 0x0c, 0xde, 0x3e, 0x1b, 0x71, 0xdc, 0x63, 0xbe, 0x9f, 0x75, 0xf0,
-	0xea, 0xf0, 0x72, 0x67, 0xf1, 0x0c, 0xc6, 0x9d, 0x6d, 0x11, 0xf4, 0x90, 0xf7, 0x12, 0x0c, 0x8e,
-	0x0f, 0xee, 0x2c, 0x49, 0xfa, 0xec, 0xbb, 0xd8, 0x7f, 0xd7, 0x53, 0x5b, 0x35, 0xf3, 0xe5, 0xd0,
-	0x7c, 0x8c, 0x0f, 0xb7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x63, 0x06, 0xf3, 0xcb, 0x02, 0x00,
-	0x00,
+var twirpFileDescriptor6 = []byte{
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xad, 0xeb, 0xfc, 0xf9, 0xa6, 0xfd, 0xe2, 0xce, 0x87, 0x8a, 0xeb, 0x52, 0xd5, 0xb2, 0x10,
+	0x54, 0x42, 0x38, 0x34, 0xa8, 0x52, 0x45, 0x37, 0xc8, 0x05, 0x24, 0x24, 0x40, 0xc8, 0x09, 0x9b,
+	0x2e, 0x88, 0xa6, 0xc9, 0xed, 0xd4, 0x22, 0xb1, 0xd3, 0x99, 0x71, 0x4a, 0xfb, 0x12, 0x48, 0x3c,
+	0x24, 0x4f, 0xc0, 0x2a, 0x2b, 0xe4, 0x9f, 0xb8, 0x8e, 0xdb, 0xa0, 0xb2, 0xf3, 0xdc, 0x7b, 0xee,
+	0x99, 0x33, 0x67, 0x8e, 0x07, 0xfe, 0xc7, 0xa1, 0x2f, 0x43, 0xde, 0x9e, 0xee, 0xb7, 0x25, 0x65,
+	0xce, 0x84, 0x87, 0x32, 0x24, 0x5a, 0x5a, 0x74, 0xa6, 0xfb, 0xe6, 0xc3, 0x29, 0x1d, 0xf9, 0x43,
+	0x2a, 0xb1, 0x3d, 0xff, 0x48, 0x31, 0xe6, 0x2e, 0x0b, 0x43, 0x36, 0xc2, 0x76, 0xb2, 0x3a, 0x8d,
+	0xce, 0xda, 0xd2, 0x1f, 0xa3, 0x90, 0x74, 0x3c, 0xc9, 0x00, 0xdb, 0x65, 0x00, 0x8e, 0x27, 0xf2,
+	0x2a, 0x6d, 0xda, 0x3f, 0x14, 0x50, 0x7b, 0x94, 0x11, 0x02, 0x95, 0x80, 0x8e, 0xd1, 0x50, 0x2c,
+	0x65, 0x4f, 0xf3, 0x92, 0x6f, 0xb2, 0x09, 0x35, 0x1a, 0xc9, 0xf3, 0x90, 0x1b, 0xab, 0x49, 0x35,
+	0x5b, 0x91, 0x5d, 0x68, 0x46, 0x82, 0x32, 0xec, 0x0f, 0xc2, 0x28, 0x90, 0x86, 0x6a, 0x29, 0x7b,
+	0x55, 0x0f, 0x92, 0xd2, 0x71, 0x5c, 0x21, 0x47, 0xd0, 0x1c, 0x70, 0xa4, 0x12, 0xfb, 0xb1, 0x16,
+	0xa3, 0x63, 0x29, 0x7b, 0xcd, 0x8e, 0xe9, 0xa4, 0x3a, 0x9c, 0xb9, 0x0e, 0xa7, 0x37, 0x17, 0xea,
+	0x41, 0x0a, 0x8f, 0x0b, 0xf6, 0x21, 0xe8, 0xc7, 0xe9, 0x8a, 0x32, 0x0f, 0x2f, 0x22, 0x14, 0x92,
+	0x3c, 0x86, 0x86, 0xa4, 0xac, 0x7f, 0xa3, 0xd0, 0xd5, 0x66, 0x6e, 0x8d, 0x57, 0x74, 0xd5, 0x68,
+	0x79, 0x75, 0x49, 0xd9, 0x27, 0x3a, 0x46, 0xfb, 0x00, 0x36, 0x0a, 0x93, 0x62, 0x12, 0x06, 0x02,
+	0x89, 0x05, 0xaa, 0xa4, 0x2c, 0x99, 0x6a, 0x76, 0xfe, 0x73, 0x72, 0x43, 0x9d, 0x18, 0x14, 0xb7,
+	0x6c, 0x09, 0xad, 0x0f, 0xbe, 0x90, 0x3d, 0xca, 0xc4, 0x7c, 0xbf, 0x2d, 0x68, 0x84, 0x7c, 0x88,
+	0xbc, 0x7f, 0x7a, 0x95, 0x39, 0x52, 0x4f, 0xd6, 0xee, 0x15, 0x79, 0x0a, 0xda, 0x24, 0x3e, 0xbb,
+	0xf0, 0xaf, 0x31, 0xf1, 0xa5, 0xea, 0xc2, 0xcc, 0xad, 0x9b, 0x55, 0x6b, 0x45, 0xff, 0xad, 0x7a,
+	0x8d, 0xb8, 0xd9, 0xf5, 0xaf, 0x91, 0xec, 0x00, 0x24, 0x40, 0x19, 0x7e, 0xc3, 0x20, 0x31, 0x49,
+	0xf3, 0x92, 0xd1, 0x5e, 0x5c, 0xb0, 0xbf, 0x82, 0x7e, 0xb3, 0x6b, 0xa6, 0xd5, 0x86, 0x8a, 0xa4,
+	0x4c, 0x18, 0x8a, 0xa5, 0xde, 0x21, 0x36, 0xe9, 0x91, 0x27, 0xd0, 0x0a, 0xf0, 0xbb, 0xec, 0x17,
+	0xb8, 0xd3, 0xdb, 0x59, 0x8f, 0xcb, 0x9f, 0x73, 0xfe, 0x13, 0xd0, 0xbf, 0x4c, 0x86, 0x8b, 0x36,
+	0x6e, 0x95, 0x6d, 0xcc, 0xbd, 0x23, 0xcf, 0x60, 0x2d, 0xc0, 0xcb, 0x7e, 0xde, 0x5e, 0x2d, 0xb8,
+	0x6c, 0xb4, 0x74, 0xd5, 0x83, 0x00, 0x2f, 0x7b, 0x99, 0xd1, 0xcf, 0x41, 0x7f, 0x83, 0x23, 0xbc,
+	0x27, 0xb7, 0xdd, 0x85, 0x8d, 0x2e, 0x52, 0x3e, 0x38, 0x2f, 0x5a, 0xbc, 0x03, 0xd5, 0x8b, 0x08,
+	0x79, 0xe6, 0xaf, 0x5b, 0x9f, 0xb9, 0x15, 0xbe, 0x6a, 0xb4, 0xbc, 0xb4, 0x4a, 0x76, 0xa1, 0x3a,
+	0xf2, 0xc7, 0xbe, 0xcc, 0x2c, 0x8e, 0x85, 0x98, 0x15, 0xa3, 0x63, 0xad, 0x78, 0x69, 0xdd, 0x3e,
+	0x04, 0x52, 0x24, 0xbd, 0xbf, 0x83, 0xf6, 0x19, 0xe8, 0x1f, 0x91, 0x33, 0x2c, 0xaa, 0x79, 0x01,
+	0xeb, 0x82, 0x0f, 0xf2, 0xe3, 0xa7, 0x04, 0x9a, 0xbb, 0x36, 0x73, 0xb5, 0x9f, 0x4a, 0xad, 0xa1,
+	0xe8, 0x60, 0x28, 0x5e, 0x53, 0xf0, 0x41, 0x66, 0x81, 0x20, 0x16, 0xac, 0x0d, 0x85, 0x2c, 0x19,
+	0xe6, 0xc1, 0x50, 0xc8, 0x0c, 0x62, 0x1f, 0xc0, 0xba, 0x4b, 0x83, 0x7f, 0x4d, 0x71, 0xe7, 0x97,
+	0x0a, 0xd0, 0xa3, 0xac, 0x8b, 0x7c, 0xea, 0x0f, 0x90, 0xbc, 0x03, 0x2d, 0x0f, 0x35, 0xd9, 0x2e,
+	0x1c, 0xa8, 0xfc, 0x93, 0x98, 0x8f, 0xee, 0x6e, 0x66, 0xce, 0x1c, 0x43, 0x63, 0x9e, 0x37, 0x62,
+	0x16, 0x90, 0xa5, 0xe8, 0x9b, 0xdb, 0x77, 0xf6, 0x32, 0x92, 0xf7, 0x00, 0x37, 0xa6, 0x93, 0xe2,
+	0x86, 0xb7, 0x2e, 0xd8, 0xdc, 0x59, 0xd2, 0xcd, 0xa8, 0x5e, 0x83, 0x96, 0xe7, 0x73, 0xe1, 0x5c,
+	0xe5, 0xd4, 0x9a, 0x9b, 0xb7, 0x1e, 0x8e, 0xb7, 0xf1, 0x03, 0x16, 0x33, 0xe4, 0x29, 0x5c, 0x60,
+	0x28, 0x67, 0xf3, 0x6f, 0x0c, 0x79, 0x12, 0x16, 0x18, 0xca, 0xf9, 0x58, 0xca, 0xf0, 0x0a, 0x6a,
+	0xe9, 0x1d, 0x13, 0xa3, 0x30, 0xbe, 0x70, 0xed, 0xcb, 0x66, 0xdd, 0x07, 0x27, 0x24, 0x7f, 0xf3,
+	0x8f, 0xd2, 0xaf, 0xe9, 0xfe, 0x69, 0x2d, 0x41, 0xbd, 0xfc, 0x33, 0x00, 0xbe, 0x5a, 0x95, 0xfd,
+	0x10, 0x06, 0x00, 0x00,
 }
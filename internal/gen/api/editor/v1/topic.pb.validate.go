@@ -60,6 +60,8 @@ func (m *Topic) validate(all bool) error {
 
 	// no validation rules for Title
 
+	// no validation rules for Language
+
 	if all {
 		switch v := interface{}(m.GetCreateTime()).(type) {
 		case interface{ ValidateAll() error }:
@@ -199,6 +201,17 @@ func (m *CreateTopicRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := Language_name[int32(m.GetLanguage())]; !ok {
+		err := CreateTopicRequestValidationError{
+			field:  "Language",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateTopicRequestMultiError(errors)
 	}
@@ -409,3 +422,222 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreateTopicResponseValidationError{}
+
+// Validate checks the field values on UpdateTopicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTopicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTopicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTopicRequestMultiError, or nil if none found.
+func (m *UpdateTopicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTopicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TopicId
+
+	if l := utf8.RuneCountInString(m.GetTopicTitle()); l < 3 || l > 30 {
+		err := UpdateTopicRequestValidationError{
+			field:  "TopicTitle",
+			reason: "value length must be between 3 and 30 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTopicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTopicRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTopicRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTopicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTopicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTopicRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTopicRequestValidationError is the validation error returned by
+// UpdateTopicRequest.Validate if the designated constraints aren't met.
+type UpdateTopicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTopicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTopicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTopicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTopicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTopicRequestValidationError) ErrorName() string {
+	return "UpdateTopicRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTopicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTopicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTopicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTopicRequestValidationError{}
+
+// Validate checks the field values on DeleteTopicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTopicRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTopicRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTopicRequestMultiError, or nil if none found.
+func (m *DeleteTopicRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTopicRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TopicId
+
+	if len(errors) > 0 {
+		return DeleteTopicRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTopicRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTopicRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTopicRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTopicRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTopicRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTopicRequestValidationError is the validation error returned by
+// DeleteTopicRequest.Validate if the designated constraints aren't met.
+type DeleteTopicRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTopicRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTopicRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTopicRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTopicRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTopicRequestValidationError) ErrorName() string {
+	return "DeleteTopicRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTopicRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTopicRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTopicRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTopicRequestValidationError{}
@@ -16,6 +16,8 @@ import proto "google.golang.org/protobuf/proto"
 import twirp "github.com/twitchtv/twirp"
 import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
 
+import google_protobuf3 "google.golang.org/protobuf/types/known/emptypb"
+
 // Version compatibility assertion.
 // If the constant is not defined in the package, that likely means
 // the package needs to be updated to work with this generated code.
@@ -28,6 +30,13 @@ const _ = twirp.TwirpPackageMinVersion_8_1_0
 
 type TopicService interface {
 	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error)
+
+	// UpdateTopic updates topic title.
+	// Topic used in published pack cannot be updated.
+	UpdateTopic(context.Context, *UpdateTopicRequest) (*google_protobuf3.Empty, error)
+
+	// DeleteTopic deletes topic which is not used in any pack.
+	DeleteTopic(context.Context, *DeleteTopicRequest) (*google_protobuf3.Empty, error)
 }
 
 // ============================
@@ -36,7 +45,7 @@ type TopicService interface {
 
 type topicServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [1]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -64,8 +73,10 @@ func NewTopicServiceProtobufClient(baseURL string, client HTTPClient, opts ...tw
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "TopicService")
-	urls := [1]string{
+	urls := [3]string{
 		serviceURL + "CreateTopic",
+		serviceURL + "UpdateTopic",
+		serviceURL + "DeleteTopic",
 	}
 
 	return &topicServiceProtobufClient{
@@ -122,13 +133,105 @@ func (c *topicServiceProtobufClient) callCreateTopic(ctx context.Context, in *Cr
 	return out, nil
 }
 
+func (c *topicServiceProtobufClient) UpdateTopic(ctx context.Context, in *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TopicService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTopic")
+	caller := c.callUpdateTopic
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTopicRequest) when calling interceptor")
+					}
+					return c.callUpdateTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *topicServiceProtobufClient) callUpdateTopic(ctx context.Context, in *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *topicServiceProtobufClient) DeleteTopic(ctx context.Context, in *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TopicService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopic")
+	caller := c.callDeleteTopic
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopicRequest) when calling interceptor")
+					}
+					return c.callDeleteTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *topicServiceProtobufClient) callDeleteTopic(ctx context.Context, in *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ========================
 // TopicService JSON Client
 // ========================
 
 type topicServiceJSONClient struct {
 	client      HTTPClient
-	urls        [1]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -156,8 +259,10 @@ func NewTopicServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "editor.v1", "TopicService")
-	urls := [1]string{
+	urls := [3]string{
 		serviceURL + "CreateTopic",
+		serviceURL + "UpdateTopic",
+		serviceURL + "DeleteTopic",
 	}
 
 	return &topicServiceJSONClient{
@@ -214,6 +319,98 @@ func (c *topicServiceJSONClient) callCreateTopic(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *topicServiceJSONClient) UpdateTopic(ctx context.Context, in *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TopicService")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTopic")
+	caller := c.callUpdateTopic
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTopicRequest) when calling interceptor")
+					}
+					return c.callUpdateTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *topicServiceJSONClient) callUpdateTopic(ctx context.Context, in *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *topicServiceJSONClient) DeleteTopic(ctx context.Context, in *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "editor.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "TopicService")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopic")
+	caller := c.callDeleteTopic
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopicRequest) when calling interceptor")
+					}
+					return c.callDeleteTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *topicServiceJSONClient) callDeleteTopic(ctx context.Context, in *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+	out := new(google_protobuf3.Empty)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===========================
 // TopicService Server Handler
 // ===========================
@@ -314,6 +511,12 @@ func (s *topicServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Reque
 	case "CreateTopic":
 		s.serveCreateTopic(ctx, resp, req)
 		return
+	case "UpdateTopic":
+		s.serveUpdateTopic(ctx, resp, req)
+		return
+	case "DeleteTopic":
+		s.serveDeleteTopic(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -501,8 +704,368 @@ func (s *topicServiceServer) serveCreateTopicProtobuf(ctx context.Context, resp
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *topicServiceServer) serveUpdateTopic(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateTopicJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateTopicProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *topicServiceServer) serveUpdateTopicJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTopic")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(UpdateTopicRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TopicService.UpdateTopic
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTopicRequest) when calling interceptor")
+					}
+					return s.TopicService.UpdateTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling UpdateTopic. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *topicServiceServer) serveUpdateTopicProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateTopic")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(UpdateTopicRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TopicService.UpdateTopic
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *UpdateTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*UpdateTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*UpdateTopicRequest) when calling interceptor")
+					}
+					return s.TopicService.UpdateTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling UpdateTopic. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *topicServiceServer) serveDeleteTopic(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteTopicJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteTopicProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *topicServiceServer) serveDeleteTopicJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopic")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteTopicRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.TopicService.DeleteTopic
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopicRequest) when calling interceptor")
+					}
+					return s.TopicService.DeleteTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling DeleteTopic. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *topicServiceServer) serveDeleteTopicProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteTopic")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteTopicRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.TopicService.DeleteTopic
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteTopicRequest) (*google_protobuf3.Empty, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteTopicRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteTopicRequest) when calling interceptor")
+					}
+					return s.TopicService.DeleteTopic(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*google_protobuf3.Empty)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*google_protobuf3.Empty) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *google_protobuf3.Empty
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *google_protobuf3.Empty and nil error while calling DeleteTopic. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *topicServiceServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor6, 0
+	return twirpFileDescriptor7, 0
 }
 
 func (s *topicServiceServer) ProtocGenTwirpVersion() string {
@@ -516,24 +1079,33 @@ func (s *topicServiceServer) PathPrefix() string {
 	return baseServicePath(s.pathPrefix, "editor.v1", "TopicService")
 }
 
-var twirpFileDescriptor6 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x25, 0xd1, 0x14, 0x32, 0x11, 0x29, 0x6b, 0xc5, 0x10, 0xb0, 0x86, 0x1c, 0x24, 0x78, 0xd8,
-	0x90, 0x78, 0x2c, 0x82, 0xc4, 0xab, 0xa7, 0x98, 0x93, 0x08, 0x25, 0x4d, 0xc6, 0xb2, 0x92, 0xba,
-	0x31, 0xd9, 0xe6, 0xe3, 0x3d, 0xc9, 0xee, 0x90, 0x52, 0x11, 0x6f, 0xf3, 0xe6, 0xbd, 0x79, 0x6f,
-	0x76, 0x16, 0x2e, 0xb1, 0x11, 0x4a, 0xf6, 0xc9, 0x98, 0x26, 0x4a, 0x76, 0xa2, 0xe6, 0x5d, 0x2f,
-	0x95, 0x64, 0x2e, 0xb5, 0xf9, 0x98, 0x06, 0x57, 0x63, 0xd5, 0x8a, 0xa6, 0x52, 0x98, 0x4c, 0x05,
-	0x69, 0x82, 0x9b, 0xad, 0x94, 0xdb, 0x16, 0x13, 0x83, 0x36, 0xfb, 0xf7, 0x44, 0x89, 0x1d, 0x0e,
-	0xaa, 0xda, 0x75, 0x24, 0x88, 0x3e, 0xc0, 0x29, 0xb5, 0x27, 0x3b, 0x07, 0x5b, 0x34, 0xbe, 0x15,
-	0x5a, 0xb1, 0x53, 0xd8, 0xa2, 0x61, 0x0b, 0x70, 0x94, 0x50, 0x2d, 0xfa, 0x76, 0x68, 0xc5, 0x6e,
-	0x41, 0x80, 0xad, 0xc0, 0xab, 0x7b, 0xac, 0x14, 0xae, 0xb5, 0x91, 0x9f, 0x85, 0x56, 0xec, 0x65,
-	0x01, 0xa7, 0x14, 0x3e, 0xa5, 0xf0, 0x72, 0x4a, 0x29, 0x80, 0xe4, 0xba, 0x11, 0x3d, 0x02, 0x7b,
-	0x22, 0xa4, 0x13, 0x0b, 0xfc, 0xda, 0xe3, 0xa0, 0xd8, 0x1d, 0x78, 0xe6, 0x55, 0x6b, 0x8a, 0xd3,
-	0x1b, 0xb8, 0xb9, 0xfb, 0x9d, 0xcf, 0xfa, 0xd3, 0xf9, 0x89, 0xbf, 0x2c, 0xc0, 0xb0, 0xa5, 0x26,
-	0xa3, 0x07, 0xb8, 0xf8, 0xe5, 0x30, 0x74, 0xf2, 0x73, 0x40, 0x76, 0x0b, 0x8e, 0x11, 0x99, 0x61,
-	0x2f, 0x9b, 0xf3, 0xc3, 0x65, 0x38, 0x09, 0x89, 0xce, 0xde, 0xe0, 0xcc, 0xe0, 0x17, 0xec, 0x47,
-	0x51, 0x23, 0x7b, 0x06, 0xef, 0xc8, 0x8e, 0x5d, 0x1f, 0xcd, 0xfd, 0x5d, 0x34, 0x58, 0xfe, 0x47,
-	0xd3, 0x16, 0xf9, 0xe2, 0x95, 0x1d, 0x3e, 0x6a, 0x45, 0xd5, 0x98, 0x6e, 0x66, 0xe6, 0x28, 0xf7,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xf8, 0x59, 0x62, 0xc5, 0x01, 0x00, 0x00,
+var twirpFileDescriptor7 = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x8f, 0x93, 0x40,
+	0x14, 0xc6, 0x33, 0x54, 0xd6, 0xf2, 0x30, 0x1b, 0x32, 0xbb, 0x2a, 0x62, 0xac, 0x84, 0x83, 0x21,
+	0x1e, 0x20, 0xc5, 0x93, 0xd9, 0x78, 0xc1, 0xf5, 0x60, 0xb2, 0x27, 0xac, 0x17, 0x3d, 0x6c, 0xd8,
+	0xf2, 0x24, 0x93, 0xd0, 0x05, 0x61, 0x4a, 0x62, 0xbc, 0xf9, 0x6f, 0xf8, 0x0f, 0xfa, 0x37, 0xf4,
+	0x64, 0x98, 0x01, 0x3a, 0x2d, 0x69, 0xb2, 0xb7, 0x79, 0xf3, 0x7d, 0xf9, 0xe6, 0xf7, 0xbd, 0x16,
+	0x78, 0x8a, 0x19, 0xe3, 0x65, 0x1d, 0xb6, 0xcb, 0x90, 0x97, 0x15, 0x5b, 0x07, 0x55, 0x5d, 0xf2,
+	0x92, 0x1a, 0xf2, 0x3a, 0x68, 0x97, 0x8e, 0xbd, 0x77, 0x14, 0xe9, 0x7d, 0xbe, 0x4d, 0x73, 0x94,
+	0x26, 0xe7, 0x79, 0x9b, 0x16, 0x2c, 0x4b, 0x39, 0x86, 0xc3, 0xa1, 0x17, 0x5e, 0xe7, 0x65, 0x99,
+	0x17, 0x18, 0x8a, 0xe9, 0x6e, 0xfb, 0x23, 0xe4, 0x6c, 0x83, 0x0d, 0x4f, 0x37, 0x55, 0x6f, 0x78,
+	0x79, 0x6c, 0xc0, 0x4d, 0xc5, 0x7f, 0x49, 0xd1, 0xfb, 0x4b, 0x40, 0x5f, 0x75, 0x2c, 0xf4, 0x1c,
+	0x34, 0x96, 0xd9, 0xc4, 0x25, 0xbe, 0x9e, 0x68, 0x2c, 0xa3, 0x97, 0xa0, 0x73, 0xc6, 0x0b, 0xb4,
+	0x35, 0x97, 0xf8, 0x46, 0x22, 0x07, 0x1a, 0xc2, 0x7c, 0x00, 0xb3, 0x67, 0x2e, 0xf1, 0xcf, 0xa3,
+	0x8b, 0x60, 0xc4, 0x0f, 0x6e, 0x7a, 0x29, 0x19, 0x4d, 0xf4, 0x0a, 0xcc, 0x75, 0x8d, 0x29, 0xc7,
+	0xdb, 0x8e, 0xcb, 0x8e, 0x5c, 0xe2, 0x9b, 0x91, 0x13, 0x48, 0xa6, 0x60, 0x60, 0x0a, 0x56, 0x03,
+	0x74, 0x02, 0xd2, 0xde, 0x5d, 0x78, 0xbf, 0x81, 0x7e, 0x94, 0x53, 0x87, 0x98, 0xe0, 0xcf, 0x2d,
+	0x36, 0x9c, 0xbe, 0x05, 0x53, 0xac, 0xef, 0x56, 0xf2, 0x75, 0xc8, 0x46, 0x6c, 0xec, 0xe2, 0xb3,
+	0xfa, 0x91, 0x35, 0xb3, 0x17, 0x09, 0x08, 0x75, 0x25, 0x78, 0xdf, 0x2b, 0xbc, 0xda, 0x49, 0xde,
+	0x78, 0xbe, 0x8b, 0xf5, 0x3f, 0x44, 0xb3, 0xc8, 0x9e, 0xdc, 0xfb, 0x00, 0x17, 0x07, 0x8f, 0x37,
+	0x55, 0x79, 0xdf, 0x20, 0x7d, 0x03, 0xba, 0xc8, 0x17, 0xef, 0x9a, 0x91, 0xa5, 0xc4, 0x49, 0xa3,
+	0x94, 0xbd, 0xef, 0x40, 0xbf, 0x56, 0xd9, 0x31, 0xfb, 0x0b, 0x98, 0x4b, 0xf6, 0x71, 0xd7, 0x8f,
+	0xc5, 0xfc, 0x39, 0x3b, 0xae, 0xa5, 0x29, 0xb5, 0xec, 0x85, 0x35, 0x53, 0x6b, 0x79, 0x21, 0xd0,
+	0x6b, 0x2c, 0xf0, 0xc1, 0xe1, 0xd1, 0x3f, 0x02, 0x4f, 0x84, 0xf7, 0x0b, 0xd6, 0x2d, 0x5b, 0x23,
+	0xbd, 0x01, 0x53, 0x69, 0x47, 0x5f, 0x29, 0x35, 0xa6, 0x2b, 0x77, 0x16, 0xa7, 0xe4, 0x7e, 0x29,
+	0xd7, 0x60, 0x2a, 0x65, 0x0f, 0xd2, 0xa6, 0x4b, 0x70, 0x9e, 0x4d, 0x7e, 0xfe, 0x4f, 0xdd, 0x5f,
+	0xb2, 0x4b, 0x51, 0x5a, 0x1d, 0xa4, 0x4c, 0xdb, 0x9e, 0x4a, 0x89, 0x2f, 0xbf, 0xd1, 0xf1, 0x2b,
+	0xba, 0x92, 0xa7, 0x76, 0x79, 0x77, 0x26, 0x5c, 0xef, 0xfe, 0x0f, 0x00, 0xd3, 0x6e, 0x3c, 0x20,
+	0x84, 0x03, 0x00, 0x00,
 }
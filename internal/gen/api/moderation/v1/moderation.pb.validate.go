@@ -0,0 +1,963 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: moderation/v1/moderation.proto
+
+package moderationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsRequestMultiError, or nil if none found.
+func (m *ListReportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ReportStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListReportsRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val <= 0 || val >= 500 {
+		err := ListReportsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range (0, 500)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListReportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReportsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportsRequestValidationError is the validation error returned by
+// ListReportsRequest.Validate if the designated constraints aren't met.
+type ListReportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsRequestValidationError) ErrorName() string {
+	return "ListReportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsRequestValidationError{}
+
+// Validate checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsResponseMultiError, or nil if none found.
+func (m *ListReportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportsResponseValidationError{
+					field:  fmt.Sprintf("Reports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListReportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReportsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsResponseMultiError) AllErrors() []error { return m }
+
+// ListReportsResponseValidationError is the validation error returned by
+// ListReportsResponse.Validate if the designated constraints aren't met.
+type ListReportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsResponseValidationError) ErrorName() string {
+	return "ListReportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsResponseValidationError{}
+
+// Validate checks the field values on CloseReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseReportRequestMultiError, or nil if none found.
+func (m *CloseReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReportId
+
+	if _, ok := _CloseReportRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := CloseReportRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [RESOLVED DISMISSED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CloseReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloseReportRequestMultiError is an error wrapping multiple validation errors
+// returned by CloseReportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CloseReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseReportRequestMultiError) AllErrors() []error { return m }
+
+// CloseReportRequestValidationError is the validation error returned by
+// CloseReportRequest.Validate if the designated constraints aren't met.
+type CloseReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseReportRequestValidationError) ErrorName() string {
+	return "CloseReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseReportRequestValidationError{}
+
+var _CloseReportRequest_Status_InLookup = map[ReportStatus]struct{}{
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on CloseReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseReportResponseMultiError, or nil if none found.
+func (m *CloseReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloseReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloseReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloseReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloseReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloseReportResponseMultiError is an error wrapping multiple validation
+// errors returned by CloseReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CloseReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseReportResponseMultiError) AllErrors() []error { return m }
+
+// CloseReportResponseValidationError is the validation error returned by
+// CloseReportResponse.Validate if the designated constraints aren't met.
+type CloseReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseReportResponseValidationError) ErrorName() string {
+	return "CloseReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseReportResponseValidationError{}
+
+// Validate checks the field values on HidePackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HidePackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HidePackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HidePackRequestMultiError, or nil if none found.
+func (m *HidePackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HidePackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	if len(errors) > 0 {
+		return HidePackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HidePackRequestMultiError is an error wrapping multiple validation errors
+// returned by HidePackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HidePackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HidePackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HidePackRequestMultiError) AllErrors() []error { return m }
+
+// HidePackRequestValidationError is the validation error returned by
+// HidePackRequest.Validate if the designated constraints aren't met.
+type HidePackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HidePackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HidePackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HidePackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HidePackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HidePackRequestValidationError) ErrorName() string { return "HidePackRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HidePackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHidePackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HidePackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HidePackRequestValidationError{}
+
+// Validate checks the field values on UnhidePackRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnhidePackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnhidePackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnhidePackRequestMultiError, or nil if none found.
+func (m *UnhidePackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnhidePackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	if len(errors) > 0 {
+		return UnhidePackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnhidePackRequestMultiError is an error wrapping multiple validation errors
+// returned by UnhidePackRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnhidePackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnhidePackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnhidePackRequestMultiError) AllErrors() []error { return m }
+
+// UnhidePackRequestValidationError is the validation error returned by
+// UnhidePackRequest.Validate if the designated constraints aren't met.
+type UnhidePackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnhidePackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnhidePackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnhidePackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnhidePackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnhidePackRequestValidationError) ErrorName() string {
+	return "UnhidePackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnhidePackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnhidePackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnhidePackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnhidePackRequestValidationError{}
+
+// Validate checks the field values on BanAuthorRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BanAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BanAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BanAuthorRequestMultiError, or nil if none found.
+func (m *BanAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BanAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nickname
+
+	if len(errors) > 0 {
+		return BanAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BanAuthorRequestMultiError is an error wrapping multiple validation errors
+// returned by BanAuthorRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BanAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BanAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BanAuthorRequestMultiError) AllErrors() []error { return m }
+
+// BanAuthorRequestValidationError is the validation error returned by
+// BanAuthorRequest.Validate if the designated constraints aren't met.
+type BanAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BanAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BanAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BanAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BanAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BanAuthorRequestValidationError) ErrorName() string { return "BanAuthorRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BanAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBanAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BanAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BanAuthorRequestValidationError{}
+
+// Validate checks the field values on UnbanAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnbanAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnbanAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnbanAuthorRequestMultiError, or nil if none found.
+func (m *UnbanAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnbanAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nickname
+
+	if len(errors) > 0 {
+		return UnbanAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnbanAuthorRequestMultiError is an error wrapping multiple validation errors
+// returned by UnbanAuthorRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnbanAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnbanAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnbanAuthorRequestMultiError) AllErrors() []error { return m }
+
+// UnbanAuthorRequestValidationError is the validation error returned by
+// UnbanAuthorRequest.Validate if the designated constraints aren't met.
+type UnbanAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnbanAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnbanAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnbanAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnbanAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnbanAuthorRequestValidationError) ErrorName() string {
+	return "UnbanAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnbanAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnbanAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnbanAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnbanAuthorRequestValidationError{}
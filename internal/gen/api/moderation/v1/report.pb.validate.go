@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: moderation/v1/report.proto
+
+package moderationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Report) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PackId
+
+	// no validation rules for QuestionId
+
+	// no validation rules for PackName
+
+	// no validation rules for QuestionText
+
+	// no validation rules for Reason
+
+	// no validation rules for Reporter
+
+	// no validation rules for Status
+
+	// no validation rules for ResolvedBy
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResolveTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "ResolveTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportValidationError{
+					field:  "ResolveTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolveTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportValidationError{
+				field:  "ResolveTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportMultiError) AllErrors() []error { return m }
+
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportValidationError{}
+
+// Validate checks the field values on CreateReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReportRequestMultiError, or nil if none found.
+func (m *CreateReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackId
+
+	// no validation rules for QuestionId
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 3 || l > 500 {
+		err := CreateReportRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 3 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ShareToken
+
+	if len(errors) > 0 {
+		return CreateReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReportRequestMultiError) AllErrors() []error { return m }
+
+// CreateReportRequestValidationError is the validation error returned by
+// CreateReportRequest.Validate if the designated constraints aren't met.
+type CreateReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportRequestValidationError) ErrorName() string {
+	return "CreateReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportRequestValidationError{}
+
+// Validate checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReportResponseMultiError, or nil if none found.
+func (m *CreateReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReportId
+
+	if len(errors) > 0 {
+		return CreateReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReportResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReportResponseMultiError) AllErrors() []error { return m }
+
+// CreateReportResponseValidationError is the validation error returned by
+// CreateReportResponse.Validate if the designated constraints aren't met.
+type CreateReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportResponseValidationError) ErrorName() string {
+	return "CreateReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportResponseValidationError{}
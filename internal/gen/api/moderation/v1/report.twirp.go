@@ -0,0 +1,557 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: moderation/v1/report.proto
+
+package moderationv1
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =======================
+// ReportService Interface
+// =======================
+
+type ReportService interface {
+	// CreateReport adds report on pack or question content to moderation queue,
+	// exactly one of pack_id and question_id must be set.
+	// Only content readable by the caller can be reported, visibility rules are the same as in GetPack.
+	CreateReport(context.Context, *CreateReportRequest) (*CreateReportResponse, error)
+}
+
+// =============================
+// ReportService Protobuf Client
+// =============================
+
+type reportServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewReportServiceProtobufClient creates a Protobuf client that implements the ReportService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewReportServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ReportService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "moderation.v1", "ReportService")
+	urls := [1]string{
+		serviceURL + "CreateReport",
+	}
+
+	return &reportServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *reportServiceProtobufClient) CreateReport(ctx context.Context, in *CreateReportRequest) (*CreateReportResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "moderation.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReportService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReport")
+	caller := c.callCreateReport
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateReportRequest) (*CreateReportResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReportRequest) when calling interceptor")
+					}
+					return c.callCreateReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reportServiceProtobufClient) callCreateReport(ctx context.Context, in *CreateReportRequest) (*CreateReportResponse, error) {
+	out := new(CreateReportResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// ReportService JSON Client
+// =========================
+
+type reportServiceJSONClient struct {
+	client      HTTPClient
+	urls        [1]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewReportServiceJSONClient creates a JSON client that implements the ReportService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewReportServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) ReportService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "moderation.v1", "ReportService")
+	urls := [1]string{
+		serviceURL + "CreateReport",
+	}
+
+	return &reportServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *reportServiceJSONClient) CreateReport(ctx context.Context, in *CreateReportRequest) (*CreateReportResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "moderation.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReportService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReport")
+	caller := c.callCreateReport
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateReportRequest) (*CreateReportResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReportRequest) when calling interceptor")
+					}
+					return c.callCreateReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *reportServiceJSONClient) callCreateReport(ctx context.Context, in *CreateReportRequest) (*CreateReportResponse, error) {
+	out := new(CreateReportResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// ReportService Server Handler
+// ============================
+
+type reportServiceServer struct {
+	ReportService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewReportServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewReportServiceServer(svc ReportService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &reportServiceServer{
+		ReportService:    svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *reportServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *reportServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// ReportServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const ReportServicePathPrefix = "/twirp/moderation.v1.ReportService/"
+
+func (s *reportServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "moderation.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "ReportService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "moderation.v1.ReportService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateReport":
+		s.serveCreateReport(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *reportServiceServer) serveCreateReport(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateReportJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateReportProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *reportServiceServer) serveCreateReportJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReport")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateReportRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.ReportService.CreateReport
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateReportRequest) (*CreateReportResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReportRequest) when calling interceptor")
+					}
+					return s.ReportService.CreateReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateReportResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateReportResponse and nil error while calling CreateReport. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reportServiceServer) serveCreateReportProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateReport")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateReportRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.ReportService.CreateReport
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateReportRequest) (*CreateReportResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateReportRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateReportRequest) when calling interceptor")
+					}
+					return s.ReportService.CreateReport(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateReportResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateReportResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateReportResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateReportResponse and nil error while calling CreateReport. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *reportServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor1, 0
+}
+
+func (s *reportServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *reportServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "moderation.v1", "ReportService")
+}
+
+var twirpFileDescriptor1 = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x6f, 0xda, 0x4e,
+	0x10, 0xfd, 0x19, 0x12, 0x03, 0x03, 0x44, 0x68, 0x7f, 0x55, 0xe3, 0x3a, 0xad, 0x40, 0xe4, 0x82,
+	0x7a, 0x30, 0x02, 0x8e, 0x51, 0x0f, 0x25, 0xb8, 0x92, 0xa5, 0x16, 0x90, 0xed, 0xb4, 0x52, 0x2f,
+	0xd6, 0x82, 0xa7, 0x89, 0x15, 0x60, 0xe9, 0x7a, 0xb1, 0x92, 0xef, 0xd1, 0x53, 0x3f, 0x67, 0x4f,
+	0x3d, 0x55, 0xbb, 0x8b, 0xf9, 0xd3, 0x56, 0xe9, 0xcd, 0xf3, 0xe6, 0xcd, 0xf3, 0xcc, 0x7b, 0x36,
+	0xd8, 0x4b, 0x16, 0x23, 0xa7, 0x22, 0x61, 0xab, 0x6e, 0xd6, 0xeb, 0x72, 0x5c, 0x33, 0x2e, 0x9c,
+	0x35, 0x67, 0x82, 0x91, 0xfa, 0xbe, 0xe7, 0x64, 0x3d, 0xfb, 0x3c, 0xa3, 0x8b, 0x24, 0xa6, 0x02,
+	0xbb, 0xf9, 0x83, 0xe6, 0xd9, 0xcd, 0x5b, 0xc6, 0x6e, 0x17, 0xd8, 0x55, 0xd5, 0x6c, 0xf3, 0xa5,
+	0x2b, 0x92, 0x25, 0xa6, 0x82, 0x2e, 0xd7, 0x9a, 0xd0, 0xfe, 0x5e, 0x04, 0xd3, 0x57, 0xca, 0xe4,
+	0x0c, 0x0a, 0x49, 0x6c, 0x19, 0x2d, 0xa3, 0x73, 0xea, 0x17, 0x92, 0x98, 0x9c, 0x43, 0x69, 0x4d,
+	0xe7, 0xf7, 0x51, 0x12, 0x5b, 0x05, 0x05, 0x9a, 0xb2, 0xf4, 0x62, 0xd2, 0x84, 0xea, 0xd7, 0x0d,
+	0xa6, 0xf2, 0xe5, 0xb2, 0x59, 0x54, 0x4d, 0xc8, 0x21, 0x2f, 0x26, 0x17, 0x50, 0x51, 0x93, 0x2b,
+	0xba, 0x44, 0xab, 0xdc, 0x32, 0x3a, 0x15, 0xbf, 0x2c, 0x81, 0x31, 0x5d, 0x22, 0xb9, 0x84, 0xfa,
+	0x6e, 0x5a, 0xe0, 0x83, 0xb0, 0x2a, 0x8a, 0x50, 0xcb, 0xc1, 0x10, 0x1f, 0x04, 0x79, 0x0e, 0x26,
+	0x47, 0x9a, 0xb2, 0x95, 0x75, 0xa2, 0xba, 0xdb, 0x8a, 0xd8, 0x50, 0xd6, 0x3e, 0x20, 0xb7, 0x4e,
+	0xb5, 0x70, 0x5e, 0x93, 0x01, 0x98, 0xa9, 0xa0, 0x62, 0x93, 0x5a, 0x66, 0xcb, 0xe8, 0x9c, 0xf5,
+	0x2f, 0x9c, 0x23, 0x93, 0x1c, 0x7d, 0x66, 0xa0, 0x28, 0xfe, 0x96, 0x2a, 0x6f, 0xe1, 0x98, 0xb2,
+	0x45, 0x86, 0x71, 0x34, 0x7b, 0xb4, 0x4a, 0x4a, 0x13, 0x72, 0x68, 0xf8, 0x48, 0xae, 0xa0, 0x3a,
+	0xe7, 0x48, 0x05, 0x46, 0xd2, 0x3a, 0xab, 0xdf, 0x32, 0x3a, 0xd5, 0xbe, 0xed, 0x68, 0x5f, 0x9d,
+	0xdc, 0x57, 0x27, 0xcc, 0x7d, 0xf5, 0x41, 0xd3, 0x25, 0x40, 0xde, 0x40, 0x6d, 0x2b, 0xa5, 0xa7,
+	0x07, 0xff, 0x9c, 0xce, 0xb7, 0x91, 0x48, 0xfb, 0x9b, 0x01, 0xff, 0x5f, 0x2b, 0x35, 0xbd, 0xbb,
+	0x8f, 0xca, 0xa4, 0xc3, 0x64, 0x8c, 0xa7, 0x92, 0x29, 0xfc, 0x91, 0x4c, 0x7b, 0xe7, 0xab, 0x4c,
+	0xad, 0x32, 0x84, 0x9f, 0xc3, 0x12, 0x3f, 0x6d, 0x14, 0xad, 0x1f, 0xc5, 0x9d, 0xc7, 0x4d, 0xa8,
+	0xa6, 0x77, 0x94, 0x63, 0x24, 0xd8, 0x3d, 0xe6, 0x01, 0x80, 0x82, 0x42, 0x89, 0xb4, 0x07, 0xf0,
+	0xec, 0x78, 0xab, 0x74, 0xcd, 0x56, 0x29, 0xca, 0xd8, 0x75, 0x18, 0xfb, 0xc5, 0xb6, 0xe9, 0x78,
+	0xf1, 0xeb, 0x10, 0x6a, 0x87, 0x01, 0x90, 0x57, 0xf0, 0xc2, 0x77, 0xa7, 0x13, 0x3f, 0x8c, 0x82,
+	0xf0, 0x6d, 0x78, 0x13, 0x44, 0x37, 0xe3, 0x60, 0xea, 0x5e, 0x7b, 0xef, 0x3c, 0x77, 0xd4, 0xf8,
+	0x8f, 0x94, 0xe1, 0x64, 0x32, 0x75, 0xc7, 0x0d, 0x83, 0xd4, 0xa0, 0xec, 0xbb, 0xc1, 0xe4, 0xfd,
+	0x47, 0x77, 0xd4, 0x28, 0x90, 0x3a, 0x54, 0x46, 0x5e, 0xf0, 0xc1, 0x0b, 0x02, 0x77, 0xd4, 0x28,
+	0xf6, 0xef, 0xa0, 0xbe, 0x55, 0x45, 0x9e, 0x25, 0x73, 0x24, 0x9f, 0xa0, 0x76, 0xb8, 0x1b, 0x69,
+	0xff, 0xf6, 0x11, 0xfc, 0xc5, 0x4e, 0xfb, 0xf2, 0x49, 0x8e, 0x3e, 0x6e, 0xf8, 0xf2, 0xf3, 0xf1,
+	0xff, 0x78, 0xb5, 0xaf, 0xb2, 0xde, 0xcc, 0x54, 0x51, 0x0e, 0x7e, 0x0d, 0x00, 0x5f, 0xef, 0xe6,
+	0x8f, 0xb4, 0x03, 0x00, 0x00,
+}
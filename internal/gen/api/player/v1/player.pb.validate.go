@@ -601,3 +601,557 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetPlayerResponseValidationError{}
+
+// Validate checks the field values on AuthorPack with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthorPack) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorPack with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorPackMultiError, or
+// nil if none found.
+func (m *AuthorPack) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorPack) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for CoverUrl
+
+	// no validation rules for RoundCount
+
+	// no validation rules for TopicCount
+
+	// no validation rules for QuestionCount
+
+	// no validation rules for VideoCount
+
+	// no validation rules for AudioCount
+
+	// no validation rules for ImageCount
+
+	// no validation rules for PlayCount
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorPackValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorPackValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorPackValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorPackMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorPackMultiError is an error wrapping multiple validation errors
+// returned by AuthorPack.ValidateAll() if the designated constraints aren't met.
+type AuthorPackMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorPackMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorPackMultiError) AllErrors() []error { return m }
+
+// AuthorPackValidationError is the validation error returned by
+// AuthorPack.Validate if the designated constraints aren't met.
+type AuthorPackValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorPackValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorPackValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorPackValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorPackValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorPackValidationError) ErrorName() string { return "AuthorPackValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorPackValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorPack.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorPackValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorPackValidationError{}
+
+// Validate checks the field values on AuthorProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthorProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorProfileMultiError, or
+// nil if none found.
+func (m *AuthorProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nickname
+
+	// no validation rules for DisplayName
+
+	for idx, item := range m.GetPacks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthorProfileValidationError{
+						field:  fmt.Sprintf("Packs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthorProfileValidationError{
+						field:  fmt.Sprintf("Packs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthorProfileValidationError{
+					field:  fmt.Sprintf("Packs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PlayCount
+
+	// no validation rules for AverageRating
+
+	if all {
+		switch v := interface{}(m.GetJoinTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorProfileValidationError{
+					field:  "JoinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorProfileValidationError{
+					field:  "JoinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJoinTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorProfileValidationError{
+				field:  "JoinTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorProfileMultiError is an error wrapping multiple validation errors
+// returned by AuthorProfile.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorProfileMultiError) AllErrors() []error { return m }
+
+// AuthorProfileValidationError is the validation error returned by
+// AuthorProfile.Validate if the designated constraints aren't met.
+type AuthorProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorProfileValidationError) ErrorName() string { return "AuthorProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorProfileValidationError{}
+
+// Validate checks the field values on GetAuthorProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorProfileRequestMultiError, or nil if none found.
+func (m *GetAuthorProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nickname
+
+	if len(errors) > 0 {
+		return GetAuthorProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorProfileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetAuthorProfileRequestValidationError is the validation error returned by
+// GetAuthorProfileRequest.Validate if the designated constraints aren't met.
+type GetAuthorProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorProfileRequestValidationError) ErrorName() string {
+	return "GetAuthorProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorProfileRequestValidationError{}
+
+// Validate checks the field values on GetAuthorProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorProfileResponseMultiError, or nil if none found.
+func (m *GetAuthorProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAuthorProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAuthorProfileResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAuthorProfileResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAuthorProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAuthorProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuthorProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorProfileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetAuthorProfileResponseValidationError is the validation error returned by
+// GetAuthorProfileResponse.Validate if the designated constraints aren't met.
+type GetAuthorProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorProfileResponseValidationError) ErrorName() string {
+	return "GetAuthorProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorProfileResponseValidationError{}
@@ -31,7 +31,11 @@ const _ = twirp.TwirpPackageMinVersion_8_1_0
 type PlayerService interface {
 	CreatePlayer(context.Context, *CreatePlayerRequest) (*google_protobuf3.Empty, error)
 
+	// GetPlayer returns player, email is returned only to the player itself.
 	GetPlayer(context.Context, *GetPlayerRequest) (*GetPlayerResponse, error)
+
+	// GetAuthorProfile returns public profile of the player with published public packs.
+	GetAuthorProfile(context.Context, *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error)
 }
 
 // =============================
@@ -40,7 +44,7 @@ type PlayerService interface {
 
 type playerServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -68,9 +72,10 @@ func NewPlayerServiceProtobufClient(baseURL string, client HTTPClient, opts ...t
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "player.v1", "PlayerService")
-	urls := [2]string{
+	urls := [3]string{
 		serviceURL + "CreatePlayer",
 		serviceURL + "GetPlayer",
+		serviceURL + "GetAuthorProfile",
 	}
 
 	return &playerServiceProtobufClient{
@@ -173,13 +178,59 @@ func (c *playerServiceProtobufClient) callGetPlayer(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *playerServiceProtobufClient) GetAuthorProfile(ctx context.Context, in *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "player.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PlayerService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthorProfile")
+	caller := c.callGetAuthorProfile
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAuthorProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAuthorProfileRequest) when calling interceptor")
+					}
+					return c.callGetAuthorProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAuthorProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAuthorProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *playerServiceProtobufClient) callGetAuthorProfile(ctx context.Context, in *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+	out := new(GetAuthorProfileResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // =========================
 // PlayerService JSON Client
 // =========================
 
 type playerServiceJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [3]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -207,9 +258,10 @@ func NewPlayerServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "player.v1", "PlayerService")
-	urls := [2]string{
+	urls := [3]string{
 		serviceURL + "CreatePlayer",
 		serviceURL + "GetPlayer",
+		serviceURL + "GetAuthorProfile",
 	}
 
 	return &playerServiceJSONClient{
@@ -312,6 +364,52 @@ func (c *playerServiceJSONClient) callGetPlayer(ctx context.Context, in *GetPlay
 	return out, nil
 }
 
+func (c *playerServiceJSONClient) GetAuthorProfile(ctx context.Context, in *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "player.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "PlayerService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthorProfile")
+	caller := c.callGetAuthorProfile
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAuthorProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAuthorProfileRequest) when calling interceptor")
+					}
+					return c.callGetAuthorProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAuthorProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAuthorProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *playerServiceJSONClient) callGetAuthorProfile(ctx context.Context, in *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+	out := new(GetAuthorProfileResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ============================
 // PlayerService Server Handler
 // ============================
@@ -415,6 +513,9 @@ func (s *playerServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Requ
 	case "GetPlayer":
 		s.serveGetPlayer(ctx, resp, req)
 		return
+	case "GetAuthorProfile":
+		s.serveGetAuthorProfile(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -782,6 +883,186 @@ func (s *playerServiceServer) serveGetPlayerProtobuf(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *playerServiceServer) serveGetAuthorProfile(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetAuthorProfileJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetAuthorProfileProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *playerServiceServer) serveGetAuthorProfileJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthorProfile")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(GetAuthorProfileRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.PlayerService.GetAuthorProfile
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAuthorProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAuthorProfileRequest) when calling interceptor")
+					}
+					return s.PlayerService.GetAuthorProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAuthorProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAuthorProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAuthorProfileResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAuthorProfileResponse and nil error while calling GetAuthorProfile. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *playerServiceServer) serveGetAuthorProfileProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthorProfile")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(GetAuthorProfileRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.PlayerService.GetAuthorProfile
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetAuthorProfileRequest) (*GetAuthorProfileResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetAuthorProfileRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetAuthorProfileRequest) when calling interceptor")
+					}
+					return s.PlayerService.GetAuthorProfile(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetAuthorProfileResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetAuthorProfileResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetAuthorProfileResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetAuthorProfileResponse and nil error while calling GetAuthorProfile. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *playerServiceServer) ServiceDescriptor() ([]byte, int) {
 	return twirpFileDescriptor2, 0
 }
@@ -798,33 +1079,50 @@ func (s *playerServiceServer) PathPrefix() string {
 }
 
 var twirpFileDescriptor2 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0x16, 0x1a, 0x92, 0x71, 0x8b, 0xda, 0xa5, 0x6a, 0x8d, 0x83, 0x8a, 0xb1, 0x00, 0x05,
-	0x89, 0xd8, 0xc4, 0xe5, 0x02, 0x95, 0x90, 0x30, 0xa2, 0xdc, 0x10, 0x32, 0x88, 0x43, 0x4b, 0x09,
-	0xdb, 0x78, 0x5a, 0xad, 0xb0, 0x63, 0xb3, 0xde, 0xba, 0x2a, 0x08, 0x89, 0xff, 0xe0, 0xc0, 0xaf,
-	0xc0, 0x6f, 0xe5, 0x84, 0xbc, 0x6b, 0x5b, 0x4e, 0x88, 0xb8, 0xcd, 0xbc, 0xf7, 0x66, 0xf7, 0xbd,
-	0xd1, 0xc0, 0x76, 0x16, 0xb3, 0x4b, 0x14, 0x5e, 0x31, 0xf2, 0x74, 0xe5, 0x66, 0x22, 0x95, 0x29,
-	0xed, 0x55, 0x5d, 0x31, 0xb2, 0x76, 0x0a, 0x16, 0xf3, 0x88, 0x49, 0xf4, 0xea, 0x42, 0x6b, 0xac,
-	0xfe, 0x59, 0x9a, 0x9e, 0xc5, 0xe8, 0xa9, 0xee, 0xe4, 0xfc, 0xd4, 0xc3, 0x24, 0x93, 0x97, 0x15,
-	0x79, 0x7b, 0x91, 0x94, 0x3c, 0xc1, 0x5c, 0xb2, 0x24, 0xd3, 0x02, 0xe7, 0x0f, 0x81, 0xce, 0x1b,
-	0xf5, 0x09, 0xb5, 0xa0, 0x3b, 0xe5, 0x93, 0xcf, 0x53, 0x96, 0xa0, 0x49, 0x6c, 0x32, 0xe8, 0x85,
-	0x4d, 0x4f, 0xb7, 0x60, 0x15, 0x13, 0xc6, 0x63, 0x73, 0x45, 0x11, 0xba, 0xa1, 0x77, 0x60, 0x2d,
-	0xe2, 0x79, 0xe9, 0x71, 0xac, 0xa6, 0xae, 0x28, 0xd2, 0xa8, 0xb0, 0xd7, 0xe5, 0xe0, 0x3d, 0xb8,
-	0xae, 0xb4, 0xe3, 0x02, 0x05, 0x3f, 0xe5, 0x18, 0x99, 0x57, 0x6d, 0x32, 0xe8, 0x86, 0xeb, 0x0a,
-	0x7d, 0x5f, 0x81, 0x74, 0x1f, 0x8c, 0x89, 0x40, 0x26, 0x71, 0x5c, 0x1a, 0x34, 0x7d, 0x9b, 0x0c,
-	0x0c, 0xdf, 0x72, 0xb5, 0x7b, 0xb7, 0x76, 0xef, 0xbe, 0xab, 0xdd, 0x87, 0xa0, 0xe5, 0x25, 0xe0,
-	0xfc, 0x24, 0x70, 0xe3, 0x85, 0x6a, 0x75, 0x92, 0x10, 0xbf, 0x9c, 0x63, 0x2e, 0xe9, 0xd3, 0xc5,
-	0x40, 0xc1, 0xee, 0x2c, 0xe8, 0x8b, 0x9b, 0xfe, 0xce, 0xc7, 0x23, 0x36, 0xfc, 0xfa, 0x7c, 0x78,
-	0xf8, 0x68, 0xf8, 0xe4, 0xf8, 0xe8, 0xc3, 0xc5, 0xf1, 0xb7, 0xbd, 0x87, 0xfe, 0xe3, 0xef, 0x77,
-	0x5b, 0x81, 0xed, 0xb9, 0xc0, 0x01, 0xcc, 0x82, 0x6b, 0x62, 0xd5, 0xfc, 0xbd, 0xf2, 0x89, 0xd4,
-	0xe1, 0xef, 0x43, 0x37, 0x63, 0x79, 0x7e, 0x91, 0x8a, 0x48, 0x07, 0xaf, 0x44, 0x1b, 0x60, 0xfe,
-	0x20, 0x61, 0xc3, 0x39, 0x2e, 0x6c, 0xbc, 0x42, 0x39, 0xef, 0xec, 0x3f, 0xab, 0x76, 0x9e, 0xc1,
-	0x66, 0x4b, 0x9f, 0x67, 0xe9, 0x34, 0x47, 0xfa, 0x00, 0x3a, 0xfa, 0x14, 0x94, 0xdc, 0xf0, 0x37,
-	0xdd, 0xe6, 0x32, 0xdc, 0x4a, 0x5a, 0x09, 0xfc, 0x5f, 0x04, 0xd6, 0x35, 0xf4, 0x16, 0x45, 0xc1,
-	0x27, 0x48, 0x0f, 0x60, 0xad, 0xbd, 0x1e, 0xba, 0xdb, 0x1a, 0x5e, 0xb2, 0x37, 0x6b, 0xfb, 0x9f,
-	0xbd, 0xbf, 0x2c, 0x4f, 0x8a, 0x1e, 0x40, 0xaf, 0x71, 0x46, 0xfb, 0xad, 0x47, 0x16, 0xf3, 0x59,
-	0xb7, 0x96, 0x93, 0x3a, 0x4c, 0xb0, 0x75, 0x48, 0x9b, 0x7b, 0xdf, 0xd7, 0x55, 0x31, 0x3a, 0xe9,
-	0xa8, 0xdf, 0xf6, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x34, 0x44, 0x49, 0x0c, 0x03, 0x00,
-	0x00,
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdf, 0x4e, 0xdb, 0x48,
+	0x14, 0xc6, 0xe5, 0x84, 0x84, 0xe4, 0x84, 0x44, 0x30, 0xcb, 0x82, 0x37, 0xd9, 0x85, 0x6c, 0x76,
+	0xa9, 0x52, 0xb5, 0x24, 0xc5, 0xb4, 0xaa, 0x5a, 0xa4, 0x4a, 0x04, 0x15, 0xee, 0x10, 0x72, 0xff,
+	0x5c, 0x40, 0x69, 0x3a, 0xd8, 0x43, 0x3a, 0x25, 0xf1, 0xb8, 0x63, 0xc7, 0x88, 0x56, 0x95, 0xfa,
+	0x1e, 0x7d, 0x99, 0xf6, 0xa1, 0x7a, 0x83, 0x7a, 0x51, 0xf9, 0xcc, 0xc4, 0x75, 0x12, 0x84, 0x90,
+	0x7a, 0x37, 0xf3, 0x9d, 0xdf, 0x8c, 0xe7, 0x9b, 0x73, 0xce, 0x18, 0x96, 0xfc, 0x3e, 0xbd, 0x60,
+	0xb2, 0x1d, 0x6d, 0xb4, 0xd5, 0xa8, 0xe5, 0x4b, 0x11, 0x0a, 0x52, 0xd4, 0xb3, 0x68, 0xa3, 0xba,
+	0x1c, 0xd1, 0x3e, 0x77, 0x69, 0xc8, 0xda, 0xa3, 0x81, 0x62, 0xaa, 0xb5, 0x9e, 0x10, 0xbd, 0x3e,
+	0x6b, 0xe3, 0xec, 0x64, 0x78, 0xda, 0x66, 0x03, 0x3f, 0xbc, 0xd0, 0xc1, 0xd5, 0xc9, 0x60, 0xc8,
+	0x07, 0x2c, 0x08, 0xe9, 0xc0, 0x57, 0x40, 0xe3, 0x9b, 0x01, 0xf9, 0x03, 0xfc, 0x08, 0xa9, 0x42,
+	0xc1, 0xe3, 0xce, 0x99, 0x47, 0x07, 0xcc, 0x34, 0xea, 0x46, 0xb3, 0x68, 0x27, 0x73, 0xb2, 0x08,
+	0x39, 0x36, 0xa0, 0xbc, 0x6f, 0x66, 0x30, 0xa0, 0x26, 0xe4, 0x5f, 0x98, 0x73, 0x79, 0x10, 0x9f,
+	0xb1, 0x8b, 0xab, 0xb2, 0x18, 0x2c, 0x69, 0x6d, 0x3f, 0x5e, 0xb8, 0x06, 0x15, 0x64, 0xbb, 0x11,
+	0x93, 0xfc, 0x94, 0x33, 0xd7, 0x9c, 0xa9, 0x1b, 0xcd, 0x82, 0x5d, 0x46, 0xf5, 0xa5, 0x16, 0xc9,
+	0x16, 0x94, 0x1c, 0xc9, 0x68, 0xc8, 0xba, 0xf1, 0x01, 0x4d, 0xab, 0x6e, 0x34, 0x4b, 0x56, 0xb5,
+	0xa5, 0x4e, 0xdf, 0x1a, 0x9d, 0xbe, 0xf5, 0x7c, 0x74, 0x7a, 0x1b, 0x14, 0x1e, 0x0b, 0x8d, 0x2f,
+	0x06, 0xfc, 0xb1, 0x83, 0x53, 0xe5, 0xc4, 0x66, 0xef, 0x87, 0x2c, 0x08, 0xc9, 0xe3, 0x49, 0x43,
+	0x9d, 0x95, 0xcb, 0x4e, 0x4d, 0xfe, 0x65, 0x2d, 0xbf, 0x3e, 0xa2, 0xeb, 0x1f, 0xb6, 0xd7, 0x0f,
+	0xef, 0xad, 0x3f, 0x3a, 0x3e, 0x7a, 0x75, 0x7e, 0xfc, 0x71, 0xf3, 0xae, 0x75, 0xff, 0xd3, 0xff,
+	0x29, 0xc3, 0xf5, 0x31, 0xc3, 0x1d, 0xb8, 0xec, 0xcc, 0xca, 0xdc, 0x1b, 0xc3, 0xfc, 0x9a, 0x19,
+	0x99, 0xbf, 0x05, 0x05, 0x9f, 0x06, 0xc1, 0xb9, 0x90, 0xae, 0x99, 0x4d, 0x41, 0xe6, 0x67, 0x63,
+	0x1e, 0xec, 0x24, 0xd6, 0x68, 0xc1, 0xfc, 0x1e, 0x0b, 0xc7, 0x4f, 0x76, 0xcd, 0x55, 0x37, 0x9e,
+	0xc0, 0x42, 0x8a, 0x0f, 0x7c, 0xe1, 0x05, 0x8c, 0xdc, 0x86, 0xbc, 0x2a, 0x05, 0xc4, 0x4b, 0xd6,
+	0x42, 0x2b, 0xa9, 0x8c, 0x96, 0x46, 0x35, 0xd0, 0xf8, 0x9e, 0x01, 0xd8, 0x1e, 0x86, 0x6f, 0x85,
+	0x3c, 0xa0, 0xce, 0x19, 0xa9, 0x40, 0x86, 0xbb, 0xb8, 0x2a, 0x67, 0x67, 0xb8, 0x4b, 0x08, 0xcc,
+	0xe0, 0x67, 0x55, 0x22, 0x71, 0x4c, 0x6a, 0x50, 0x74, 0x44, 0xc4, 0x64, 0x77, 0x28, 0xfb, 0x3a,
+	0x89, 0x05, 0x14, 0x5e, 0xc8, 0x3e, 0x59, 0x85, 0x92, 0x14, 0x43, 0xcf, 0xed, 0x3a, 0x62, 0xe8,
+	0x85, 0x98, 0xbe, 0x9c, 0x0d, 0x28, 0xed, 0xc4, 0x4a, 0x0c, 0x84, 0xc2, 0xe7, 0x8e, 0x06, 0x72,
+	0x0a, 0x40, 0x49, 0x01, 0x6b, 0x50, 0x41, 0xdb, 0x5c, 0x78, 0x9a, 0xc9, 0x23, 0x53, 0x1e, 0xa9,
+	0xc9, 0x3e, 0x11, 0x77, 0x99, 0xd0, 0xcc, 0xac, 0xda, 0x07, 0xa5, 0x04, 0xa0, 0x43, 0x97, 0x8f,
+	0x80, 0x82, 0x02, 0x50, 0x4a, 0x00, 0x3e, 0xa0, 0x3d, 0xa6, 0x81, 0xa2, 0x02, 0x50, 0x52, 0xc0,
+	0x3f, 0x00, 0x58, 0xad, 0x2a, 0x0e, 0x18, 0xc7, 0x1e, 0x53, 0xe1, 0xdf, 0xaa, 0xc2, 0x4b, 0x03,
+	0xca, 0xfa, 0xde, 0xa5, 0x38, 0xe5, 0x7d, 0x76, 0x6d, 0x43, 0x4d, 0xb6, 0x4e, 0x66, 0xba, 0x75,
+	0xee, 0x40, 0xce, 0xa7, 0xce, 0x59, 0x60, 0x66, 0xeb, 0xd9, 0x66, 0xc9, 0xfa, 0x33, 0x95, 0xf2,
+	0x5f, 0xf9, 0xb5, 0x15, 0x33, 0xe1, 0x2c, 0x4e, 0x52, 0x36, 0xed, 0x6c, 0x0d, 0x2a, 0x34, 0x62,
+	0x32, 0xbe, 0x1b, 0x49, 0x43, 0xee, 0xf5, 0x30, 0x4d, 0x19, 0xbb, 0xac, 0x55, 0x1b, 0x45, 0xf2,
+	0x10, 0x8a, 0xef, 0x04, 0xf7, 0x6e, 0x6a, 0xbf, 0x10, 0xc3, 0x68, 0xfe, 0x01, 0x2c, 0xef, 0xb1,
+	0x70, 0xcc, 0xfe, 0x4d, 0x6a, 0x7d, 0x1f, 0xcc, 0xe9, 0x65, 0xba, 0xe4, 0x2d, 0x98, 0xf5, 0x95,
+	0xa4, 0x6b, 0xde, 0x9c, 0xbe, 0x00, 0xbd, 0x64, 0x04, 0x5a, 0x3f, 0x0c, 0x28, 0xab, 0x76, 0x78,
+	0xc6, 0x64, 0xc4, 0x1d, 0x46, 0x76, 0x61, 0x2e, 0xfd, 0x34, 0x90, 0x95, 0xd4, 0x26, 0x57, 0xbc,
+	0x19, 0xd5, 0xa5, 0x29, 0xbb, 0x4f, 0xe3, 0xe7, 0x94, 0xec, 0x42, 0x31, 0xe9, 0x4a, 0x52, 0x4b,
+	0x6d, 0x32, 0xd9, 0xdb, 0xd5, 0xbf, 0xaf, 0x0e, 0x6a, 0x57, 0x47, 0xf8, 0x1a, 0x8c, 0xd7, 0x49,
+	0x63, 0x7c, 0xc5, 0x55, 0xb7, 0x58, 0xfd, 0xef, 0x5a, 0x46, 0x6d, 0xde, 0x59, 0x3c, 0x24, 0xc9,
+	0x8f, 0x64, 0x4b, 0x8d, 0xa2, 0x8d, 0x93, 0x3c, 0x5a, 0xd9, 0xfc, 0x39, 0x00, 0x33, 0xd9, 0xfd,
+	0xca, 0x65, 0x06, 0x00, 0x00,
 }
@@ -0,0 +1,615 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: room/v1/host.proto
+
+package roomv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on StartGameRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartGameRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartGameRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartGameRequestMultiError, or nil if none found.
+func (m *StartGameRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartGameRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	// no validation rules for FirstPlayer
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := StartGameRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartGameRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartGameRequestMultiError is an error wrapping multiple validation errors
+// returned by StartGameRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartGameRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartGameRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartGameRequestMultiError) AllErrors() []error { return m }
+
+// StartGameRequestValidationError is the validation error returned by
+// StartGameRequest.Validate if the designated constraints aren't met.
+type StartGameRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartGameRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartGameRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartGameRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartGameRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartGameRequestValidationError) ErrorName() string { return "StartGameRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StartGameRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartGameRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartGameRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartGameRequestValidationError{}
+
+// Validate checks the field values on JudgeAnswerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JudgeAnswerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JudgeAnswerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JudgeAnswerRequestMultiError, or nil if none found.
+func (m *JudgeAnswerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JudgeAnswerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	// no validation rules for Correct
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := JudgeAnswerRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return JudgeAnswerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JudgeAnswerRequestMultiError is an error wrapping multiple validation errors
+// returned by JudgeAnswerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type JudgeAnswerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JudgeAnswerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JudgeAnswerRequestMultiError) AllErrors() []error { return m }
+
+// JudgeAnswerRequestValidationError is the validation error returned by
+// JudgeAnswerRequest.Validate if the designated constraints aren't met.
+type JudgeAnswerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JudgeAnswerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JudgeAnswerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JudgeAnswerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JudgeAnswerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JudgeAnswerRequestValidationError) ErrorName() string {
+	return "JudgeAnswerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JudgeAnswerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJudgeAnswerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JudgeAnswerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JudgeAnswerRequestValidationError{}
+
+// Validate checks the field values on SetScoreRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetScoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetScoreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetScoreRequestMultiError, or nil if none found.
+func (m *SetScoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetScoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	// no validation rules for Player
+
+	// no validation rules for Score
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := SetScoreRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetScoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetScoreRequestMultiError is an error wrapping multiple validation errors
+// returned by SetScoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetScoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetScoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetScoreRequestMultiError) AllErrors() []error { return m }
+
+// SetScoreRequestValidationError is the validation error returned by
+// SetScoreRequest.Validate if the designated constraints aren't met.
+type SetScoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetScoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetScoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetScoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetScoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetScoreRequestValidationError) ErrorName() string { return "SetScoreRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetScoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetScoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetScoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetScoreRequestValidationError{}
+
+// Validate checks the field values on PauseGameRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseGameRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseGameRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseGameRequestMultiError, or nil if none found.
+func (m *PauseGameRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseGameRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	// no validation rules for Paused
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := PauseGameRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PauseGameRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseGameRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseGameRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseGameRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseGameRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseGameRequestMultiError) AllErrors() []error { return m }
+
+// PauseGameRequestValidationError is the validation error returned by
+// PauseGameRequest.Validate if the designated constraints aren't met.
+type PauseGameRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseGameRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseGameRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseGameRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseGameRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseGameRequestValidationError) ErrorName() string { return "PauseGameRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PauseGameRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseGameRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseGameRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseGameRequestValidationError{}
+
+// Validate checks the field values on SkipQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SkipQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkipQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkipQuestionRequestMultiError, or nil if none found.
+func (m *SkipQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkipQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := SkipQuestionRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SkipQuestionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkipQuestionRequestMultiError is an error wrapping multiple validation
+// errors returned by SkipQuestionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SkipQuestionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkipQuestionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkipQuestionRequestMultiError) AllErrors() []error { return m }
+
+// SkipQuestionRequestValidationError is the validation error returned by
+// SkipQuestionRequest.Validate if the designated constraints aren't met.
+type SkipQuestionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkipQuestionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkipQuestionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkipQuestionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkipQuestionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkipQuestionRequestValidationError) ErrorName() string {
+	return "SkipQuestionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkipQuestionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkipQuestionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkipQuestionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkipQuestionRequestValidationError{}
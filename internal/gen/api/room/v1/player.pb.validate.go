@@ -0,0 +1,822 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: room/v1/player.proto
+
+package roomv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SelectQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SelectQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelectQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SelectQuestionRequestMultiError, or nil if none found.
+func (m *SelectQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelectQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	// no validation rules for RoundQuestionId
+
+	if len(errors) > 0 {
+		return SelectQuestionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelectQuestionRequestMultiError is an error wrapping multiple validation
+// errors returned by SelectQuestionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SelectQuestionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelectQuestionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelectQuestionRequestMultiError) AllErrors() []error { return m }
+
+// SelectQuestionRequestValidationError is the validation error returned by
+// SelectQuestionRequest.Validate if the designated constraints aren't met.
+type SelectQuestionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelectQuestionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelectQuestionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelectQuestionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelectQuestionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelectQuestionRequestValidationError) ErrorName() string {
+	return "SelectQuestionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SelectQuestionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelectQuestionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelectQuestionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelectQuestionRequestValidationError{}
+
+// Validate checks the field values on BuzzRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BuzzRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BuzzRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BuzzRequestMultiError, or
+// nil if none found.
+func (m *BuzzRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BuzzRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if d := m.GetRtt(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = BuzzRequestValidationError{
+				field:  "Rtt",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := BuzzRequestValidationError{
+					field:  "Rtt",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return BuzzRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BuzzRequestMultiError is an error wrapping multiple validation errors
+// returned by BuzzRequest.ValidateAll() if the designated constraints aren't met.
+type BuzzRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BuzzRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BuzzRequestMultiError) AllErrors() []error { return m }
+
+// BuzzRequestValidationError is the validation error returned by
+// BuzzRequest.Validate if the designated constraints aren't met.
+type BuzzRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BuzzRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BuzzRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BuzzRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BuzzRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BuzzRequestValidationError) ErrorName() string { return "BuzzRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BuzzRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBuzzRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BuzzRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BuzzRequestValidationError{}
+
+// Validate checks the field values on AnswerRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AnswerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnswerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnswerRequestMultiError, or
+// nil if none found.
+func (m *AnswerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnswerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if utf8.RuneCountInString(m.GetText()) > 500 {
+		err := AnswerRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AnswerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnswerRequestMultiError is an error wrapping multiple validation errors
+// returned by AnswerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AnswerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnswerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnswerRequestMultiError) AllErrors() []error { return m }
+
+// AnswerRequestValidationError is the validation error returned by
+// AnswerRequest.Validate if the designated constraints aren't met.
+type AnswerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnswerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnswerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnswerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnswerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnswerRequestValidationError) ErrorName() string { return "AnswerRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnswerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnswerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnswerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnswerRequestValidationError{}
+
+// Validate checks the field values on PassRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PassRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PassRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PassRequestMultiError, or
+// nil if none found.
+func (m *PassRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PassRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if len(errors) > 0 {
+		return PassRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PassRequestMultiError is an error wrapping multiple validation errors
+// returned by PassRequest.ValidateAll() if the designated constraints aren't met.
+type PassRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PassRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PassRequestMultiError) AllErrors() []error { return m }
+
+// PassRequestValidationError is the validation error returned by
+// PassRequest.Validate if the designated constraints aren't met.
+type PassRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PassRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PassRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PassRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PassRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PassRequestValidationError) ErrorName() string { return "PassRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PassRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPassRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PassRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PassRequestValidationError{}
+
+// Validate checks the field values on BidRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BidRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BidRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BidRequestMultiError, or
+// nil if none found.
+func (m *BidRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BidRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if m.GetAmount() < 0 {
+		err := BidRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AllIn
+
+	if len(errors) > 0 {
+		return BidRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BidRequestMultiError is an error wrapping multiple validation errors
+// returned by BidRequest.ValidateAll() if the designated constraints aren't met.
+type BidRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BidRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BidRequestMultiError) AllErrors() []error { return m }
+
+// BidRequestValidationError is the validation error returned by
+// BidRequest.Validate if the designated constraints aren't met.
+type BidRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BidRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BidRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BidRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BidRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BidRequestValidationError) ErrorName() string { return "BidRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BidRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBidRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BidRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BidRequestValidationError{}
+
+// Validate checks the field values on TransferQuestionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferQuestionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferQuestionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferQuestionRequestMultiError, or nil if none found.
+func (m *TransferQuestionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferQuestionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return TransferQuestionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferQuestionRequestMultiError is an error wrapping multiple validation
+// errors returned by TransferQuestionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TransferQuestionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferQuestionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferQuestionRequestMultiError) AllErrors() []error { return m }
+
+// TransferQuestionRequestValidationError is the validation error returned by
+// TransferQuestionRequest.Validate if the designated constraints aren't met.
+type TransferQuestionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferQuestionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferQuestionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferQuestionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferQuestionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferQuestionRequestValidationError) ErrorName() string {
+	return "TransferQuestionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferQuestionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferQuestionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferQuestionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferQuestionRequestValidationError{}
+
+// Validate checks the field values on ChooseCostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChooseCostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChooseCostRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChooseCostRequestMultiError, or nil if none found.
+func (m *ChooseCostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChooseCostRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	if m.GetCost() < 1 {
+		err := ChooseCostRequestValidationError{
+			field:  "Cost",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChooseCostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChooseCostRequestMultiError is an error wrapping multiple validation errors
+// returned by ChooseCostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ChooseCostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChooseCostRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChooseCostRequestMultiError) AllErrors() []error { return m }
+
+// ChooseCostRequestValidationError is the validation error returned by
+// ChooseCostRequest.Validate if the designated constraints aren't met.
+type ChooseCostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChooseCostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChooseCostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChooseCostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChooseCostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChooseCostRequestValidationError) ErrorName() string {
+	return "ChooseCostRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChooseCostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChooseCostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChooseCostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChooseCostRequestValidationError{}
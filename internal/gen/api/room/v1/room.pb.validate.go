@@ -0,0 +1,1629 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: room/v1/room.proto
+
+package roomv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RoomSettings with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoomSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoomSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoomSettingsMultiError, or
+// nil if none found.
+func (m *RoomSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoomSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetMaxPlayers(); val < 0 || val > 12 {
+		err := RoomSettingsValidationError{
+			field:  "MaxPlayers",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetChoiceTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomSettingsValidationError{
+					field:  "ChoiceTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomSettingsValidationError{
+					field:  "ChoiceTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChoiceTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomSettingsValidationError{
+				field:  "ChoiceTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransferTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomSettingsValidationError{
+					field:  "TransferTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomSettingsValidationError{
+					field:  "TransferTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransferTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomSettingsValidationError{
+				field:  "TransferTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHostTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomSettingsValidationError{
+					field:  "HostTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomSettingsValidationError{
+					field:  "HostTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHostTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomSettingsValidationError{
+				field:  "HostTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.FalseStarts != nil {
+		// no validation rules for FalseStarts
+	}
+
+	if len(errors) > 0 {
+		return RoomSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoomSettingsMultiError is an error wrapping multiple validation errors
+// returned by RoomSettings.ValidateAll() if the designated constraints aren't met.
+type RoomSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoomSettingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoomSettingsMultiError) AllErrors() []error { return m }
+
+// RoomSettingsValidationError is the validation error returned by
+// RoomSettings.Validate if the designated constraints aren't met.
+type RoomSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoomSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoomSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoomSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoomSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoomSettingsValidationError) ErrorName() string { return "RoomSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoomSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoomSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoomSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoomSettingsValidationError{}
+
+// Validate checks the field values on RoomMember with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoomMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoomMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoomMemberMultiError, or
+// nil if none found.
+func (m *RoomMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoomMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Nickname
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetJoinTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomMemberValidationError{
+					field:  "JoinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomMemberValidationError{
+					field:  "JoinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJoinTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomMemberValidationError{
+				field:  "JoinTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoomMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoomMemberMultiError is an error wrapping multiple validation errors
+// returned by RoomMember.ValidateAll() if the designated constraints aren't met.
+type RoomMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoomMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoomMemberMultiError) AllErrors() []error { return m }
+
+// RoomMemberValidationError is the validation error returned by
+// RoomMember.Validate if the designated constraints aren't met.
+type RoomMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoomMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoomMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoomMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoomMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoomMemberValidationError) ErrorName() string { return "RoomMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoomMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoomMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoomMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoomMemberValidationError{}
+
+// Validate checks the field values on Room with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Room) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Room with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoomMultiError, or nil if none found.
+func (m *Room) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Room) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for HasPassword
+
+	// no validation rules for PackId
+
+	// no validation rules for Owner
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoomValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoomValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoomValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsStarted
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoomMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoomMultiError is an error wrapping multiple validation errors returned by
+// Room.ValidateAll() if the designated constraints aren't met.
+type RoomMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoomMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoomMultiError) AllErrors() []error { return m }
+
+// RoomValidationError is the validation error returned by Room.Validate if the
+// designated constraints aren't met.
+type RoomValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoomValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoomValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoomValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoomValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoomValidationError) ErrorName() string { return "RoomValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoomValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoom.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoomValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoomValidationError{}
+
+// Validate checks the field values on CreateRoomRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoomRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoomRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoomRequestMultiError, or nil if none found.
+func (m *CreateRoomRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoomRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 50 {
+		err := CreateRoomRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) > 64 {
+		err := CreateRoomRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PackId
+
+	// no validation rules for ShareToken
+
+	if _, ok := RoomRole_name[int32(m.GetRole())]; !ok {
+		err := CreateRoomRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoomRequestValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoomRequestValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoomRequestValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRoomRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoomRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRoomRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoomRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoomRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoomRequestMultiError) AllErrors() []error { return m }
+
+// CreateRoomRequestValidationError is the validation error returned by
+// CreateRoomRequest.Validate if the designated constraints aren't met.
+type CreateRoomRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoomRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoomRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoomRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoomRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoomRequestValidationError) ErrorName() string {
+	return "CreateRoomRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoomRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoomRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoomRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoomRequestValidationError{}
+
+// Validate checks the field values on CreateRoomResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoomResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoomResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoomResponseMultiError, or nil if none found.
+func (m *CreateRoomResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoomResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoomResponseValidationError{
+					field:  "Room",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoomResponseValidationError{
+					field:  "Room",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoomResponseValidationError{
+				field:  "Room",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRoomResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoomResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateRoomResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoomResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoomResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoomResponseMultiError) AllErrors() []error { return m }
+
+// CreateRoomResponseValidationError is the validation error returned by
+// CreateRoomResponse.Validate if the designated constraints aren't met.
+type CreateRoomResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoomResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoomResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoomResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoomResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoomResponseValidationError) ErrorName() string {
+	return "CreateRoomResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoomResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoomResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoomResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoomResponseValidationError{}
+
+// Validate checks the field values on ListRoomsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRoomsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoomsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoomsRequestMultiError, or nil if none found.
+func (m *ListRoomsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoomsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val <= 0 || val >= 500 {
+		err := ListRoomsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range (0, 500)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListRoomsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoomsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRoomsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRoomsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoomsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoomsRequestMultiError) AllErrors() []error { return m }
+
+// ListRoomsRequestValidationError is the validation error returned by
+// ListRoomsRequest.Validate if the designated constraints aren't met.
+type ListRoomsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoomsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoomsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoomsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoomsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoomsRequestValidationError) ErrorName() string { return "ListRoomsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRoomsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoomsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoomsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoomsRequestValidationError{}
+
+// Validate checks the field values on ListRoomsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRoomsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoomsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoomsResponseMultiError, or nil if none found.
+func (m *ListRoomsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoomsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRooms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoomsResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoomsResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoomsResponseValidationError{
+					field:  fmt.Sprintf("Rooms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListRoomsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoomsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRoomsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRoomsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoomsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoomsResponseMultiError) AllErrors() []error { return m }
+
+// ListRoomsResponseValidationError is the validation error returned by
+// ListRoomsResponse.Validate if the designated constraints aren't met.
+type ListRoomsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoomsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoomsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoomsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoomsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoomsResponseValidationError) ErrorName() string {
+	return "ListRoomsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoomsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoomsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoomsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoomsResponseValidationError{}
+
+// Validate checks the field values on SearchRoomsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRoomsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRoomsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRoomsRequestMultiError, or nil if none found.
+func (m *SearchRoomsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRoomsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 50 {
+		err := SearchRoomsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val <= 0 || val >= 500 {
+		err := SearchRoomsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range (0, 500)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchRoomsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRoomsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchRoomsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchRoomsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRoomsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRoomsRequestMultiError) AllErrors() []error { return m }
+
+// SearchRoomsRequestValidationError is the validation error returned by
+// SearchRoomsRequest.Validate if the designated constraints aren't met.
+type SearchRoomsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRoomsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRoomsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRoomsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRoomsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRoomsRequestValidationError) ErrorName() string {
+	return "SearchRoomsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRoomsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRoomsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRoomsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRoomsRequestValidationError{}
+
+// Validate checks the field values on SearchRoomsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRoomsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRoomsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRoomsResponseMultiError, or nil if none found.
+func (m *SearchRoomsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRoomsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRooms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchRoomsResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchRoomsResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchRoomsResponseValidationError{
+					field:  fmt.Sprintf("Rooms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchRoomsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRoomsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchRoomsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchRoomsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRoomsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRoomsResponseMultiError) AllErrors() []error { return m }
+
+// SearchRoomsResponseValidationError is the validation error returned by
+// SearchRoomsResponse.Validate if the designated constraints aren't met.
+type SearchRoomsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRoomsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRoomsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRoomsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRoomsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRoomsResponseValidationError) ErrorName() string {
+	return "SearchRoomsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRoomsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRoomsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRoomsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRoomsResponseValidationError{}
+
+// Validate checks the field values on JoinRoomRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JoinRoomRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JoinRoomRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JoinRoomRequestMultiError, or nil if none found.
+func (m *JoinRoomRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JoinRoomRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoomId
+
+	// no validation rules for Password
+
+	if _, ok := RoomRole_name[int32(m.GetRole())]; !ok {
+		err := JoinRoomRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return JoinRoomRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JoinRoomRequestMultiError is an error wrapping multiple validation errors
+// returned by JoinRoomRequest.ValidateAll() if the designated constraints
+// aren't met.
+type JoinRoomRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JoinRoomRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JoinRoomRequestMultiError) AllErrors() []error { return m }
+
+// JoinRoomRequestValidationError is the validation error returned by
+// JoinRoomRequest.Validate if the designated constraints aren't met.
+type JoinRoomRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinRoomRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinRoomRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinRoomRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinRoomRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinRoomRequestValidationError) ErrorName() string { return "JoinRoomRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JoinRoomRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinRoomRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinRoomRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinRoomRequestValidationError{}
+
+// Validate checks the field values on JoinRoomResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JoinRoomResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JoinRoomResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JoinRoomResponseMultiError, or nil if none found.
+func (m *JoinRoomResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JoinRoomResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JoinRoomResponseValidationError{
+					field:  "Room",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JoinRoomResponseValidationError{
+					field:  "Room",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JoinRoomResponseValidationError{
+				field:  "Room",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JoinRoomResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// JoinRoomResponseMultiError is an error wrapping multiple validation errors
+// returned by JoinRoomResponse.ValidateAll() if the designated constraints
+// aren't met.
+type JoinRoomResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JoinRoomResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JoinRoomResponseMultiError) AllErrors() []error { return m }
+
+// JoinRoomResponseValidationError is the validation error returned by
+// JoinRoomResponse.Validate if the designated constraints aren't met.
+type JoinRoomResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinRoomResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinRoomResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinRoomResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinRoomResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinRoomResponseValidationError) ErrorName() string { return "JoinRoomResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JoinRoomResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinRoomResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinRoomResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinRoomResponseValidationError{}
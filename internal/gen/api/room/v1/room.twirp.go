@@ -0,0 +1,1423 @@
+// Code generated by protoc-gen-twirp v8.1.3, DO NOT EDIT.
+// source: room/v1/room.proto
+
+package roomv1
+
+import context "context"
+import fmt "fmt"
+import http "net/http"
+import io "io"
+import json "encoding/json"
+import strconv "strconv"
+import strings "strings"
+
+import protojson "google.golang.org/protobuf/encoding/protojson"
+import proto "google.golang.org/protobuf/proto"
+import twirp "github.com/twitchtv/twirp"
+import ctxsetters "github.com/twitchtv/twirp/ctxsetters"
+
+// Version compatibility assertion.
+// If the constant is not defined in the package, that likely means
+// the package needs to be updated to work with this generated code.
+// See https://twitchtv.github.io/twirp/docs/version_matrix.html
+const _ = twirp.TwirpPackageMinVersion_8_1_0
+
+// =====================
+// RoomService Interface
+// =====================
+
+type RoomService interface {
+	// CreateRoom creates game room, only authenticated players can create rooms.
+	// Pack must be published, random published pack is used if pack is not specified.
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+
+	// ListRooms returns all rooms, newest first. Room is removed when its game is finished.
+	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+
+	// SearchRooms returns rooms which names contain query, newest first.
+	SearchRooms(context.Context, *SearchRoomsRequest) (*SearchRoomsResponse, error)
+
+	// JoinRoom adds current player to the room, password is required if room has one.
+	// After game start room can be joined only as spectator.
+	JoinRoom(context.Context, *JoinRoomRequest) (*JoinRoomResponse, error)
+}
+
+// ===========================
+// RoomService Protobuf Client
+// ===========================
+
+type roomServiceProtobufClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRoomServiceProtobufClient creates a Protobuf client that implements the RoomService interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewRoomServiceProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RoomService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "room.v1", "RoomService")
+	urls := [4]string{
+		serviceURL + "CreateRoom",
+		serviceURL + "ListRooms",
+		serviceURL + "SearchRooms",
+		serviceURL + "JoinRoom",
+	}
+
+	return &roomServiceProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *roomServiceProtobufClient) CreateRoom(ctx context.Context, in *CreateRoomRequest) (*CreateRoomResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateRoom")
+	caller := c.callCreateRoom
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateRoomRequest) (*CreateRoomResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateRoomRequest) when calling interceptor")
+					}
+					return c.callCreateRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceProtobufClient) callCreateRoom(ctx context.Context, in *CreateRoomRequest) (*CreateRoomResponse, error) {
+	out := new(CreateRoomResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceProtobufClient) ListRooms(ctx context.Context, in *ListRoomsRequest) (*ListRoomsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListRooms")
+	caller := c.callListRooms
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListRoomsRequest) (*ListRoomsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRoomsRequest) when calling interceptor")
+					}
+					return c.callListRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceProtobufClient) callListRooms(ctx context.Context, in *ListRoomsRequest) (*ListRoomsResponse, error) {
+	out := new(ListRoomsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceProtobufClient) SearchRooms(ctx context.Context, in *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRooms")
+	caller := c.callSearchRooms
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRoomsRequest) when calling interceptor")
+					}
+					return c.callSearchRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceProtobufClient) callSearchRooms(ctx context.Context, in *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+	out := new(SearchRoomsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceProtobufClient) JoinRoom(ctx context.Context, in *JoinRoomRequest) (*JoinRoomResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "JoinRoom")
+	caller := c.callJoinRoom
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *JoinRoomRequest) (*JoinRoomResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JoinRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JoinRoomRequest) when calling interceptor")
+					}
+					return c.callJoinRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*JoinRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceProtobufClient) callJoinRoom(ctx context.Context, in *JoinRoomRequest) (*JoinRoomResponse, error) {
+	out := new(JoinRoomResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// RoomService JSON Client
+// =======================
+
+type roomServiceJSONClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewRoomServiceJSONClient creates a JSON client that implements the RoomService interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewRoomServiceJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) RoomService {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "room.v1", "RoomService")
+	urls := [4]string{
+		serviceURL + "CreateRoom",
+		serviceURL + "ListRooms",
+		serviceURL + "SearchRooms",
+		serviceURL + "JoinRoom",
+	}
+
+	return &roomServiceJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *roomServiceJSONClient) CreateRoom(ctx context.Context, in *CreateRoomRequest) (*CreateRoomResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateRoom")
+	caller := c.callCreateRoom
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateRoomRequest) (*CreateRoomResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateRoomRequest) when calling interceptor")
+					}
+					return c.callCreateRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceJSONClient) callCreateRoom(ctx context.Context, in *CreateRoomRequest) (*CreateRoomResponse, error) {
+	out := new(CreateRoomResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceJSONClient) ListRooms(ctx context.Context, in *ListRoomsRequest) (*ListRoomsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "ListRooms")
+	caller := c.callListRooms
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListRoomsRequest) (*ListRoomsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRoomsRequest) when calling interceptor")
+					}
+					return c.callListRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceJSONClient) callListRooms(ctx context.Context, in *ListRoomsRequest) (*ListRoomsResponse, error) {
+	out := new(ListRoomsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceJSONClient) SearchRooms(ctx context.Context, in *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRooms")
+	caller := c.callSearchRooms
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRoomsRequest) when calling interceptor")
+					}
+					return c.callSearchRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceJSONClient) callSearchRooms(ctx context.Context, in *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+	out := new(SearchRoomsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *roomServiceJSONClient) JoinRoom(ctx context.Context, in *JoinRoomRequest) (*JoinRoomResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithMethodName(ctx, "JoinRoom")
+	caller := c.callJoinRoom
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *JoinRoomRequest) (*JoinRoomResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JoinRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JoinRoomRequest) when calling interceptor")
+					}
+					return c.callJoinRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*JoinRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *roomServiceJSONClient) callJoinRoom(ctx context.Context, in *JoinRoomRequest) (*JoinRoomResponse, error) {
+	out := new(JoinRoomResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// RoomService Server Handler
+// ==========================
+
+type roomServiceServer struct {
+	RoomService
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewRoomServiceServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewRoomServiceServer(svc RoomService, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwards compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &roomServiceServer{
+		RoomService:      svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *roomServiceServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *roomServiceServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// RoomServicePathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const RoomServicePathPrefix = "/twirp/room.v1.RoomService/"
+
+func (s *roomServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "room.v1")
+	ctx = ctxsetters.WithServiceName(ctx, "RoomService")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "room.v1.RoomService" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateRoom":
+		s.serveCreateRoom(ctx, resp, req)
+		return
+	case "ListRooms":
+		s.serveListRooms(ctx, resp, req)
+		return
+	case "SearchRooms":
+		s.serveSearchRooms(ctx, resp, req)
+		return
+	case "JoinRoom":
+		s.serveJoinRoom(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *roomServiceServer) serveCreateRoom(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateRoomJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateRoomProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomServiceServer) serveCreateRoomJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateRoom")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateRoomRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomService.CreateRoom
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateRoomRequest) (*CreateRoomResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateRoomRequest) when calling interceptor")
+					}
+					return s.RoomService.CreateRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateRoomResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateRoomResponse and nil error while calling CreateRoom. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveCreateRoomProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateRoom")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateRoomRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomService.CreateRoom
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateRoomRequest) (*CreateRoomResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateRoomRequest) when calling interceptor")
+					}
+					return s.RoomService.CreateRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateRoomResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateRoomResponse and nil error while calling CreateRoom. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveListRooms(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListRoomsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListRoomsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomServiceServer) serveListRoomsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListRooms")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListRoomsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomService.ListRooms
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListRoomsRequest) (*ListRoomsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRoomsRequest) when calling interceptor")
+					}
+					return s.RoomService.ListRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListRoomsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListRoomsResponse and nil error while calling ListRooms. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveListRoomsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListRooms")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ListRoomsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomService.ListRooms
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListRoomsRequest) (*ListRoomsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListRoomsRequest) when calling interceptor")
+					}
+					return s.RoomService.ListRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ListRoomsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListRoomsResponse and nil error while calling ListRooms. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveSearchRooms(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSearchRoomsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSearchRoomsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomServiceServer) serveSearchRoomsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRooms")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SearchRoomsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomService.SearchRooms
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRoomsRequest) when calling interceptor")
+					}
+					return s.RoomService.SearchRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchRoomsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchRoomsResponse and nil error while calling SearchRooms. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveSearchRoomsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SearchRooms")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SearchRoomsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomService.SearchRooms
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchRoomsRequest) (*SearchRoomsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchRoomsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchRoomsRequest) when calling interceptor")
+					}
+					return s.RoomService.SearchRooms(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchRoomsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchRoomsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchRoomsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchRoomsResponse and nil error while calling SearchRooms. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveJoinRoom(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveJoinRoomJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveJoinRoomProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *roomServiceServer) serveJoinRoomJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "JoinRoom")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(JoinRoomRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.RoomService.JoinRoom
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *JoinRoomRequest) (*JoinRoomResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JoinRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JoinRoomRequest) when calling interceptor")
+					}
+					return s.RoomService.JoinRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*JoinRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *JoinRoomResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *JoinRoomResponse and nil error while calling JoinRoom. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) serveJoinRoomProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "JoinRoom")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(JoinRoomRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.RoomService.JoinRoom
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *JoinRoomRequest) (*JoinRoomResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*JoinRoomRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*JoinRoomRequest) when calling interceptor")
+					}
+					return s.RoomService.JoinRoom(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*JoinRoomResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*JoinRoomResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *JoinRoomResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *JoinRoomResponse and nil error while calling JoinRoom. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *roomServiceServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor2, 0
+}
+
+func (s *roomServiceServer) ProtocGenTwirpVersion() string {
+	return "v8.1.3"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *roomServiceServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "room.v1", "RoomService")
+}
+
+var twirpFileDescriptor2 = []byte{
+	// 943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x73, 0xda, 0x46,
+	0x14, 0x47, 0x12, 0x7f, 0xc4, 0x13, 0xd8, 0xf2, 0xa6, 0x19, 0xcb, 0x6a, 0x53, 0x53, 0x32, 0x4d,
+	0x99, 0xcc, 0x14, 0xc6, 0x74, 0x3a, 0x39, 0xa4, 0x33, 0x4d, 0x14, 0xd3, 0xb1, 0x33, 0x4e, 0x61,
+	0x16, 0x7a, 0x68, 0x2e, 0xea, 0x1a, 0xd6, 0xa0, 0x1a, 0xb4, 0x44, 0x2b, 0xb0, 0x93, 0x99, 0x5e,
+	0x7a, 0xca, 0xf7, 0xe8, 0xa7, 0x68, 0x3f, 0x57, 0x7a, 0xf1, 0xa9, 0xb3, 0xab, 0x3f, 0x46, 0x86,
+	0x8c, 0xdd, 0x43, 0x4e, 0xda, 0xf7, 0xff, 0xbd, 0xdf, 0xfb, 0xed, 0x02, 0xa0, 0x80, 0xb1, 0x59,
+	0x6b, 0x79, 0xd0, 0x12, 0xdf, 0xe6, 0x3c, 0x60, 0x21, 0x43, 0x25, 0x79, 0x5e, 0x1e, 0xd8, 0xbb,
+	0x4b, 0x32, 0xf5, 0x46, 0x24, 0xa4, 0xad, 0xe4, 0x10, 0x79, 0xd8, 0x5f, 0x8e, 0x19, 0x1b, 0x4f,
+	0x69, 0x4b, 0x4a, 0xa7, 0x8b, 0xb3, 0xd6, 0x68, 0x11, 0x90, 0xd0, 0x63, 0x7e, 0x6c, 0xdf, 0xbf,
+	0x69, 0x0f, 0xbd, 0x19, 0xe5, 0x21, 0x99, 0xcd, 0x23, 0x87, 0xfa, 0xdf, 0x2a, 0x54, 0x30, 0x63,
+	0xb3, 0x3e, 0x0d, 0x43, 0xcf, 0x1f, 0x73, 0xf4, 0x18, 0x8c, 0x19, 0xb9, 0x74, 0xe7, 0x53, 0xf2,
+	0x96, 0x06, 0xdc, 0x52, 0x6a, 0x4a, 0xa3, 0xe0, 0x94, 0xaf, 0x9c, 0xa2, 0x9d, 0x6f, 0xe4, 0xac,
+	0x0a, 0x86, 0x19, 0xb9, 0xec, 0x45, 0x46, 0xf4, 0x08, 0x2a, 0x67, 0x64, 0xca, 0xa9, 0xcb, 0x43,
+	0x12, 0x84, 0xdc, 0x52, 0x6b, 0x4a, 0x43, 0x3f, 0xca, 0x61, 0x43, 0x6a, 0xfb, 0x52, 0xf9, 0x5e,
+	0x51, 0xd0, 0x33, 0xd8, 0x1a, 0x4e, 0x98, 0x37, 0xa4, 0xae, 0x28, 0xcf, 0x16, 0xa1, 0xa5, 0xd5,
+	0x94, 0x86, 0xd1, 0xde, 0x6b, 0x46, 0xed, 0x35, 0x93, 0xf6, 0x9a, 0x87, 0x71, 0xfb, 0xb8, 0x1a,
+	0x05, 0x0c, 0x22, 0x7f, 0x74, 0x08, 0x66, 0x18, 0x10, 0x9f, 0x9f, 0xd1, 0x20, 0xcd, 0x91, 0xbf,
+	0x2d, 0xc7, 0x76, 0x12, 0x92, 0x64, 0xf9, 0x01, 0x2a, 0x13, 0xc6, 0xc3, 0x34, 0x43, 0xe1, 0xb6,
+	0x0c, 0x86, 0x70, 0x8f, 0xa3, 0x9d, 0x6d, 0xa8, 0xba, 0xab, 0xe3, 0xd6, 0xdf, 0x2b, 0x00, 0x02,
+	0xbb, 0x57, 0x74, 0x76, 0x4a, 0x03, 0x64, 0x83, 0xee, 0x7b, 0xc3, 0x73, 0x9f, 0xcc, 0xa8, 0x84,
+	0xad, 0x8c, 0x53, 0x19, 0x7d, 0x0d, 0xf9, 0x80, 0x4d, 0xa9, 0x44, 0x68, 0xab, 0xbd, 0xd3, 0x8c,
+	0x17, 0xdb, 0x14, 0xe1, 0x98, 0x4d, 0x29, 0x96, 0x66, 0xf4, 0x04, 0xca, 0xbf, 0x33, 0xcf, 0x97,
+	0x0d, 0x5a, 0x6d, 0xd9, 0x9d, 0xbd, 0xd6, 0xdd, 0x20, 0x59, 0x21, 0xd6, 0x85, 0xb3, 0x10, 0xeb,
+	0xff, 0xa8, 0x90, 0x17, 0xb9, 0xd0, 0x16, 0xa8, 0xde, 0x28, 0x2e, 0xaf, 0x7a, 0x23, 0x84, 0x20,
+	0x2f, 0x1b, 0x52, 0xa5, 0x46, 0x9e, 0xd1, 0x57, 0x50, 0x99, 0x10, 0xee, 0xce, 0x09, 0xe7, 0x17,
+	0x2c, 0x18, 0xc9, 0x65, 0xe8, 0xd8, 0x98, 0x10, 0xde, 0x8b, 0x55, 0x68, 0x17, 0x4a, 0x73, 0x32,
+	0x3c, 0x77, 0xbd, 0x91, 0x84, 0xb9, 0x80, 0x8b, 0x42, 0x3c, 0x1e, 0xa1, 0xcf, 0xa0, 0xc0, 0x2e,
+	0x7c, 0x1a, 0x48, 0xec, 0xca, 0x38, 0x12, 0xd0, 0x01, 0xe8, 0x3c, 0x26, 0x90, 0x55, 0x94, 0x6d,
+	0xdf, 0xcf, 0x8c, 0x98, 0xb0, 0x0b, 0xa7, 0x6e, 0xe8, 0x5b, 0x28, 0xcd, 0x24, 0x6e, 0xdc, 0x2a,
+	0xd5, 0xb4, 0x86, 0xd1, 0xbe, 0x97, 0x89, 0x88, 0x30, 0xc5, 0x89, 0x0f, 0x7a, 0x00, 0xe0, 0xf1,
+	0x08, 0x78, 0x3a, 0xb2, 0x74, 0xd9, 0x71, 0xd9, 0xe3, 0xfd, 0x48, 0x81, 0x9e, 0x82, 0x31, 0x0c,
+	0x28, 0x09, 0xe9, 0x5d, 0xa1, 0x83, 0xc8, 0x5d, 0x82, 0xf7, 0xaf, 0x02, 0x3b, 0x2f, 0xa4, 0x28,
+	0xd7, 0x41, 0xdf, 0x2c, 0x28, 0x0f, 0xd1, 0x83, 0x18, 0x39, 0x89, 0xa5, 0xbc, 0x01, 0x41, 0xde,
+	0xd4, 0xac, 0x76, 0x0c, 0xe2, 0x43, 0xd0, 0x53, 0x00, 0x25, 0xb8, 0x4e, 0xe9, 0xca, 0xc9, 0x07,
+	0xaa, 0xf5, 0x0c, 0xeb, 0xf3, 0x0d, 0x30, 0x6a, 0x19, 0x18, 0xf7, 0xc1, 0xe0, 0x13, 0x12, 0x50,
+	0x37, 0x64, 0xe7, 0xd4, 0x97, 0x18, 0x97, 0x31, 0x48, 0xd5, 0x40, 0x68, 0x50, 0x2b, 0x26, 0x4c,
+	0xe1, 0x23, 0x84, 0x71, 0xf4, 0x2b, 0xa7, 0xf0, 0xa7, 0xa2, 0x9a, 0x4a, 0x4c, 0x9d, 0xff, 0xbf,
+	0x82, 0xfa, 0x13, 0x40, 0xab, 0x63, 0xf3, 0x39, 0xf3, 0xb9, 0x60, 0x47, 0x5e, 0xc4, 0xc9, 0xb9,
+	0x8d, 0x76, 0x35, 0x5b, 0x59, 0x9a, 0xea, 0xaf, 0xc1, 0x3c, 0xf1, 0x78, 0x28, 0x34, 0x3c, 0x81,
+	0xeb, 0x1b, 0x28, 0xcf, 0xc9, 0x98, 0xba, 0xdc, 0x7b, 0x47, 0xe3, 0x57, 0x03, 0xae, 0x9c, 0x92,
+	0x5d, 0x30, 0x3f, 0x68, 0xb5, 0x9c, 0xc0, 0x64, 0x4c, 0xfb, 0xde, 0x3b, 0x2a, 0x36, 0x29, 0x1d,
+	0xa3, 0xc9, 0x23, 0x5e, 0xca, 0x50, 0x39, 0x78, 0xfd, 0x37, 0xd8, 0x59, 0xc9, 0x1d, 0xf7, 0xf4,
+	0x10, 0x0a, 0xa2, 0xb0, 0x78, 0x8e, 0xb4, 0xf5, 0xa6, 0x22, 0x1b, 0x7a, 0x04, 0xdb, 0x3e, 0xbd,
+	0x0c, 0xdd, 0xb5, 0xec, 0x55, 0xa1, 0xee, 0xa5, 0x15, 0xfe, 0x00, 0xd4, 0xa7, 0x24, 0x18, 0x4e,
+	0x32, 0xfd, 0xef, 0x43, 0xe1, 0xcd, 0x82, 0x06, 0x6f, 0xb3, 0xfb, 0x56, 0xac, 0x36, 0x8e, 0xf4,
+	0xd9, 0x01, 0xd5, 0x95, 0x01, 0x6b, 0x39, 0xf3, 0x83, 0xf6, 0xd1, 0x01, 0xb5, 0x9b, 0x03, 0x9e,
+	0xc2, 0xbd, 0x4c, 0xf9, 0x4f, 0x31, 0xe2, 0x05, 0x6c, 0xbf, 0x64, 0x9e, 0xbf, 0x4a, 0xe7, 0x5d,
+	0x90, 0xbf, 0x26, 0x6e, 0xfa, 0x3a, 0x14, 0x85, 0x78, 0x3c, 0x12, 0xcf, 0x56, 0x96, 0xc8, 0x2b,
+	0xfc, 0x4d, 0x58, 0xa8, 0xdd, 0x91, 0x85, 0xf5, 0xef, 0xc1, 0xbc, 0x2e, 0x7c, 0x67, 0x42, 0x3d,
+	0x7e, 0x09, 0x7a, 0x92, 0x12, 0xed, 0xc1, 0x7d, 0xdc, 0xed, 0xbe, 0x72, 0x71, 0xf7, 0xa4, 0xe3,
+	0xfe, 0xf2, 0x73, 0xbf, 0xd7, 0x79, 0x71, 0xfc, 0xd3, 0x71, 0xe7, 0xd0, 0xcc, 0x21, 0x80, 0x62,
+	0xef, 0xe4, 0xf9, 0xaf, 0x1d, 0x6c, 0x2a, 0x48, 0x87, 0xfc, 0x51, 0xb7, 0x3f, 0x30, 0x55, 0x54,
+	0x85, 0xb2, 0x70, 0x1a, 0x3c, 0x1f, 0x74, 0xb1, 0xa9, 0xb5, 0xff, 0x52, 0xc1, 0x88, 0x08, 0x1f,
+	0x2c, 0xbd, 0x21, 0x45, 0x1d, 0x80, 0x6b, 0x96, 0x23, 0x3b, 0x2d, 0xbf, 0x76, 0xe3, 0xed, 0xcf,
+	0x37, 0xda, 0xe2, 0x29, 0x1c, 0x28, 0xa7, 0xbc, 0x44, 0x7b, 0xa9, 0xe7, 0xcd, 0x7b, 0x60, 0xdb,
+	0x9b, 0x4c, 0x71, 0x8e, 0x23, 0x30, 0x56, 0x56, 0x8f, 0xae, 0xeb, 0xad, 0xf3, 0xd1, 0xfe, 0x62,
+	0xb3, 0x31, 0xce, 0xf4, 0x23, 0xe8, 0x09, 0xce, 0xc8, 0x4a, 0x3d, 0x6f, 0xec, 0xdc, 0xde, 0xdb,
+	0x60, 0x89, 0x12, 0x38, 0xe6, 0xeb, 0xad, 0xf8, 0x0f, 0xc7, 0x53, 0xf1, 0x5d, 0x1e, 0x9c, 0x16,
+	0xe5, 0x2b, 0xf9, 0xdd, 0x7f, 0x03, 0x00, 0x6c, 0x0c, 0xca, 0x0e, 0x89, 0x08, 0x00, 0x00,
+}
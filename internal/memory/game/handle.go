@@ -0,0 +1,72 @@
+package game
+
+import (
+	"context"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Handle applies command to the game of the room and publishes emitted events.
+func (r *Repository) Handle(ctx context.Context, roomID string, cmd game.Command) ([]game.Event, error) {
+	return r.HandleFunc(ctx, roomID, cmd, nil)
+}
+
+// HandleFunc applies command to the game of the room and calls fn with the state of the game
+// before the command only if command is accepted by the game. Changes of the command are kept
+// and its events are published only if fn succeeds. No other command or timeout is applied
+// in between, so fn sees the game in the same state the command is applied to.
+func (r *Repository) HandleFunc(
+	_ context.Context, roomID string, cmd game.Command, fn func(*game.Game) error) ([]game.Event, error) {
+	r.mu.RLock()
+	rg, ok := r.games[roomID]
+	r.mu.RUnlock()
+
+	if !ok {
+		return nil, apperr.RoomGameNotStarted
+	}
+
+	events, finished, err := r.handle(rg, roomID, cmd, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if finished {
+		r.remove(roomID, rg)
+	}
+
+	return events, nil
+}
+
+// handle applies command to the game under its lock and reports whether the game is finished.
+func (r *Repository) handle(
+	rg *running, roomID string, cmd game.Command, fn func(*game.Game) error) ([]game.Event, bool, error) {
+	rg.mu.Lock()
+	defer rg.mu.Unlock()
+
+	g := rg.game
+
+	// command is applied to copy of the game, so it can be dropped if fn fails
+	if fn != nil {
+		g = g.Clone()
+	}
+
+	events, err := g.Handle(cmd, time.Now())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if fn != nil {
+		if err := fn(rg.game); err != nil {
+			return nil, false, err
+		}
+	}
+
+	rg.game = g
+
+	r.publish(roomID, events)
+	r.schedule(roomID, rg)
+
+	return events, rg.game.Stage() == game.StageFinished, nil
+}
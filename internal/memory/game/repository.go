@@ -0,0 +1,88 @@
+// Package game is an in-process registry of running games.
+package game
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/game"
+)
+
+// Publisher delivers game events to members of the room.
+type Publisher func(roomID string, events []game.Event)
+
+// Closer is called after the game of the room is finished and removed from the registry,
+// all events of the game are published before it's called.
+type Closer func(roomID string)
+
+// Repository keeps running games in memory of the process and drives their timers,
+// games are lost on restart and removed when finished.
+type Repository struct {
+	mu      sync.RWMutex
+	games   map[string]*running
+	publish Publisher
+	close   Closer
+}
+
+func NewRepository(p Publisher, c Closer) *Repository {
+	return &Repository{
+		games:   make(map[string]*running),
+		publish: p,
+		close:   c,
+	}
+}
+
+// running serializes calls to the game and calls Tick when its deadline is reached.
+type running struct {
+	mu    sync.Mutex
+	game  *game.Game
+	timer *time.Timer
+}
+
+// schedule restarts the timer according to current deadline of the game, rg.mu must be held.
+func (r *Repository) schedule(roomID string, rg *running) {
+	if rg.timer != nil {
+		rg.timer.Stop()
+		rg.timer = nil
+	}
+
+	deadline := rg.game.Deadline()
+	if deadline.IsZero() {
+		return
+	}
+
+	rg.timer = time.AfterFunc(time.Until(deadline), func() {
+		rg.mu.Lock()
+
+		if events := rg.game.Tick(time.Now()); len(events) > 0 {
+			r.publish(roomID, events)
+		}
+
+		r.schedule(roomID, rg)
+		finished := rg.game.Stage() == game.StageFinished
+
+		rg.mu.Unlock()
+
+		if finished {
+			r.remove(roomID, rg)
+		}
+	})
+}
+
+// remove removes finished game from the registry and closes the room,
+// rg.mu must not be held since games are locked after the registry.
+func (r *Repository) remove(roomID string, rg *running) {
+	r.mu.Lock()
+
+	// game could be removed already by concurrent command
+	removed := r.games[roomID] == rg
+	if removed {
+		delete(r.games, roomID)
+	}
+
+	r.mu.Unlock()
+
+	if removed && r.close != nil {
+		r.close(roomID)
+	}
+}
@@ -0,0 +1,29 @@
+package game
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Save registers started game of the room and publishes events emitted on its start.
+func (r *Repository) Save(_ context.Context, roomID string, g *game.Game, events []game.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.games[roomID]; ok {
+		return apperr.RoomGameStarted
+	}
+
+	rg := &running{game: g}
+	r.games[roomID] = rg
+
+	rg.mu.Lock()
+	defer rg.mu.Unlock()
+
+	r.publish(roomID, events)
+	r.schedule(roomID, rg)
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package game
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Snapshot publishes current state of the game to the player, it's published along with
+// game events so events published after it are newer than the snapshot.
+func (r *Repository) Snapshot(_ context.Context, roomID, player string) error {
+	r.mu.RLock()
+	rg, ok := r.games[roomID]
+	r.mu.RUnlock()
+
+	if !ok {
+		return apperr.RoomGameNotStarted
+	}
+
+	rg.mu.Lock()
+	defer rg.mu.Unlock()
+
+	r.publish(roomID, []game.Event{rg.game.Snapshot(player)})
+
+	return nil
+}
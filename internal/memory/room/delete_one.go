@@ -0,0 +1,20 @@
+package room
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) DeleteOne(_ context.Context, roomID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.rooms[roomID]; !ok {
+		return apperr.RoomNotFound
+	}
+
+	delete(r.rooms, roomID)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package room
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+)
+
+// GetAll returns rooms which names contain query case-insensitively, newest first.
+// All rooms are returned if query is empty.
+func (r *Repository) GetAll(_ context.Context, query string, p paging.Params) (paging.List[entity.Room], error) {
+	limit, offset, err := paging.OffsetToken(p.PageToken).Decode()
+	if err != nil {
+		return paging.List[entity.Room]{}, err
+	}
+
+	// use limit from params only if token has no limit
+	if limit == 0 {
+		limit = uint64(p.PageSize)
+	}
+
+	query = strings.ToLower(query)
+
+	r.mu.RLock()
+
+	rooms := make([]entity.Room, 0, len(r.rooms))
+
+	for _, room := range r.rooms {
+		if query != "" && !strings.Contains(strings.ToLower(room.Name), query) {
+			continue
+		}
+
+		rooms = append(rooms, *room.Clone())
+	}
+
+	r.mu.RUnlock()
+
+	slices.SortFunc(rooms, func(a, b entity.Room) int {
+		if c := b.CreateTime.Compare(a.CreateTime); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	if offset >= uint64(len(rooms)) {
+		return paging.NewListWithOffset([]entity.Room{}, limit, offset)
+	}
+
+	rooms = rooms[offset:]
+
+	if uint64(len(rooms)) > limit+1 {
+		rooms = rooms[:limit+1]
+	}
+
+	return paging.NewListWithOffset(rooms, limit, offset)
+}
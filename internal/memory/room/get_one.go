@@ -0,0 +1,20 @@
+package room
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) GetOne(_ context.Context, roomID string) (*entity.Room, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	room, ok := r.rooms[roomID]
+	if !ok {
+		return nil, apperr.RoomNotFound
+	}
+
+	return room.Clone(), nil
+}
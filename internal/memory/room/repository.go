@@ -0,0 +1,20 @@
+// Package room is an in-process registry of game rooms.
+package room
+
+import (
+	"sync"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// Repository keeps rooms in memory of the process, rooms are lost on restart.
+type Repository struct {
+	mu    sync.RWMutex
+	rooms map[string]*entity.Room
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		rooms: make(map[string]*entity.Room),
+	}
+}
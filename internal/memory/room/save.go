@@ -0,0 +1,16 @@
+package room
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+func (r *Repository) Save(_ context.Context, room *entity.Room) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rooms[room.ID] = room.Clone()
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package room
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// UpdateOne atomically applies update func to the room and returns updated room.
+// Room is not changed if update func returns error.
+func (r *Repository) UpdateOne(_ context.Context, roomID string, update func(*entity.Room) error) (*entity.Room, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	room, ok := r.rooms[roomID]
+	if !ok {
+		return nil, apperr.RoomNotFound
+	}
+
+	room = room.Clone()
+
+	if err := update(room); err != nil {
+		return nil, err
+	}
+
+	r.rooms[roomID] = room
+
+	return room.Clone(), nil
+}
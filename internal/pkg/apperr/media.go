@@ -3,9 +3,11 @@ package apperr
 import "errors"
 
 const (
-	MsgMediaNotFound = "media not found"
+	MsgMediaNotFound    = "media not found"
+	MsgMediaClipInvalid = "media clip must be within duration of audio or video media"
 )
 
 var (
-	MediaNotFound = errors.New(MsgMediaNotFound)
+	MediaNotFound    = errors.New(MsgMediaNotFound)
+	MediaClipInvalid = errors.New(MsgMediaClipInvalid)
 )
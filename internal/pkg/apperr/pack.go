@@ -6,9 +6,26 @@ const (
 	MsgPackCoverNotFound = "pack cover not found, upload media first"
 	MsgPackNotFound      = "pack not found"
 	MsgPackNotAuthor     = "current user is not an author of the pack"
+	MsgPackPublished     = "pack is already published"
+	MsgPackAuthorBanned  = "author is banned from publishing packs"
+
+	MsgPackShareTokenNotFound = "pack share token not found"
+
+	MsgPackInvalidRating = "pack rating must be from 1 to 5"
+	MsgPackRatedByAuthor = "author cannot rate his own pack"
+	MsgPackNotPlayed     = "pack can be rated only after finishing a game with it"
 )
 
 var (
 	PackNotFound  = errors.New(MsgPackNotFound)
 	PackNotAuthor = errors.New(MsgPackNotAuthor)
+	PackPublished = errors.New(MsgPackPublished)
+
+	PackAuthorBanned = errors.New(MsgPackAuthorBanned)
+
+	PackShareTokenNotFound = errors.New(MsgPackShareTokenNotFound)
+
+	PackInvalidRating = errors.New(MsgPackInvalidRating)
+	PackRatedByAuthor = errors.New(MsgPackRatedByAuthor)
+	PackNotPlayed     = errors.New(MsgPackNotPlayed)
 )
@@ -4,9 +4,11 @@ import "errors"
 
 const (
 	MsgPlayerNotVerified = "player not verified"
+	MsgPlayerNotAdmin    = "current user is not an admin"
 )
 
 var (
 	PlayerNotFound      = errors.New("player not found")
 	PlayerAlreadyExists = errors.New("player with provided email or nickname already exists")
+	PlayerNotAdmin      = errors.New(MsgPlayerNotAdmin)
 )
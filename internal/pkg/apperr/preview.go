@@ -0,0 +1,11 @@
+package apperr
+
+import "errors"
+
+const (
+	MsgPreviewNotFound = "preview not found, start preview first"
+)
+
+var (
+	PreviewNotFound = errors.New(MsgPreviewNotFound)
+)
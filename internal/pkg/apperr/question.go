@@ -5,8 +5,18 @@ import "errors"
 const (
 	MsgQuestionMediaNotFound = "question or answer media not found, upload it first"
 	MsgQuestionNotFound      = "question not found"
+	MsgQuestionNotAuthor     = "current user is not an author of the question"
+	MsgQuestionAlreadyExists = "similar question already exists"
+	MsgQuestionPublished     = "question is used in published pack and cannot be changed"
+	MsgQuestionInUse         = "question is used in pack and cannot be deleted"
+	MsgQuestionPartMismatch  = "question part type doesn't match type of its media"
 )
 
 var (
-	QuestionNotFound = errors.New(MsgQuestionNotFound)
+	QuestionNotFound      = errors.New(MsgQuestionNotFound)
+	QuestionNotAuthor     = errors.New(MsgQuestionNotAuthor)
+	QuestionAlreadyExists = errors.New(MsgQuestionAlreadyExists)
+	QuestionPublished     = errors.New(MsgQuestionPublished)
+	QuestionInUse         = errors.New(MsgQuestionInUse)
+	QuestionPartMismatch  = errors.New(MsgQuestionPartMismatch)
 )
@@ -0,0 +1,11 @@
+package apperr
+
+import "errors"
+
+const (
+	MsgReportNotFound = "report not found"
+)
+
+var (
+	ReportNotFound = errors.New(MsgReportNotFound)
+)
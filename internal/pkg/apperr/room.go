@@ -0,0 +1,31 @@
+package apperr
+
+import "errors"
+
+const (
+	MsgRoomNotFound         = "room not found"
+	MsgRoomWrongPassword    = "wrong room password"
+	MsgRoomPackNotPlayable  = "pack must be published and not hidden to be played"
+	MsgRoomNoPlayablePacks  = "no published packs found to play"
+	MsgRoomNotMember        = "player must join the room first"
+	MsgRoomNotHost          = "only host of the room can do this"
+	MsgRoomNotOwner         = "only creator of the room can start the game"
+	MsgRoomNoHost           = "host of the room is not chosen yet"
+	MsgRoomGameStarted      = "game is already started"
+	MsgRoomGameNotStarted   = "game is not started"
+	MsgRoomNotEnoughPlayers = "room must have host and at least one player to start the game"
+)
+
+var (
+	RoomNotFound         = errors.New(MsgRoomNotFound)
+	RoomWrongPassword    = errors.New(MsgRoomWrongPassword)
+	RoomPackNotPlayable  = errors.New(MsgRoomPackNotPlayable)
+	RoomNoPlayablePacks  = errors.New(MsgRoomNoPlayablePacks)
+	RoomNotMember        = errors.New(MsgRoomNotMember)
+	RoomNotHost          = errors.New(MsgRoomNotHost)
+	RoomNotOwner         = errors.New(MsgRoomNotOwner)
+	RoomNoHost           = errors.New(MsgRoomNoHost)
+	RoomGameStarted      = errors.New(MsgRoomGameStarted)
+	RoomGameNotStarted   = errors.New(MsgRoomGameNotStarted)
+	RoomNotEnoughPlayers = errors.New(MsgRoomNotEnoughPlayers)
+)
@@ -8,6 +8,7 @@ const (
 	MsgRoundTopicNotAdded      = "amount of topic in round exceeded"
 	MsgRoundTopicAlreadyExists = "topic already added to round"
 	MsgRoundTopicNotDeleted    = "round or topic not found"
+	MsgRoundTopicNotFound      = "topic not found in round"
 )
 
 var (
@@ -16,4 +17,5 @@ var (
 	RoundTopicNotAdded      = errors.New(MsgRoundTopicNotAdded)
 	RoundTopicAlreadyExists = errors.New(MsgRoundTopicAlreadyExists)
 	RoundTopicNotDeleted    = errors.New(MsgRoundTopicNotDeleted)
+	RoundTopicNotFound      = errors.New(MsgRoundTopicNotFound)
 )
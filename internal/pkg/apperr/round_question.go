@@ -4,8 +4,12 @@ import "errors"
 
 const (
 	MsgRoundQuestionNotFound = "round question not found"
+	MsgRoundQuestionNotMoved = "amount of questions in topic exceeded"
+	MsgRoundQuestionInPack   = "question already added to pack"
 )
 
 var (
 	RoundQuestionNotFound = errors.New(MsgRoundQuestionNotFound)
+	RoundQuestionNotMoved = errors.New(MsgRoundQuestionNotMoved)
+	RoundQuestionInPack   = errors.New(MsgRoundQuestionInPack)
 )
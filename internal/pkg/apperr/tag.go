@@ -4,8 +4,20 @@ import "errors"
 
 const (
 	MsgTagAlreadyExists = "tag with provided name already exists"
+	MsgTagNotFound      = "tag not found"
+	MsgTagNotAuthor     = "current user is not an author of the tag"
+	MsgTagPublished     = "tag is used in published pack and cannot be changed"
+	MsgTagInUse         = "tag is used in pack and cannot be deleted"
+	MsgTagBanned        = "tag is banned"
+	MsgTagMergeInvalid  = "source tags must be unique and must not contain destination tag"
 )
 
 var (
 	TagAlreadyExists = errors.New(MsgTagAlreadyExists)
+	TagNotFound      = errors.New(MsgTagNotFound)
+	TagNotAuthor     = errors.New(MsgTagNotAuthor)
+	TagPublished     = errors.New(MsgTagPublished)
+	TagInUse         = errors.New(MsgTagInUse)
+	TagBanned        = errors.New(MsgTagBanned)
+	TagMergeInvalid  = errors.New(MsgTagMergeInvalid)
 )
@@ -3,9 +3,15 @@ package apperr
 import "errors"
 
 const (
-	MsgTopicNotFound = "topic not found"
+	MsgTopicNotFound  = "topic not found"
+	MsgTopicNotAuthor = "current user is not an author of the topic"
+	MsgTopicPublished = "topic is used in published pack and cannot be changed"
+	MsgTopicInUse     = "topic is used in pack and cannot be deleted"
 )
 
 var (
-	TopicNotFound = errors.New(MsgTopicNotFound)
+	TopicNotFound  = errors.New(MsgTopicNotFound)
+	TopicNotAuthor = errors.New(MsgTopicNotAuthor)
+	TopicPublished = errors.New(MsgTopicPublished)
+	TopicInUse     = errors.New(MsgTopicInUse)
 )
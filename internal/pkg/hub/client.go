@@ -0,0 +1,41 @@
+package hub
+
+import "sync"
+
+// Message is encoded envelope ready to be written to connection.
+type Message struct {
+	ID   uint64
+	Data []byte
+}
+
+// Client is a room subscriber with its own send buffer.
+type Client struct {
+	Nickname string
+
+	send chan Message
+	done chan struct{}
+	once sync.Once
+}
+
+func newClient(nickname string, bufferSize int) *Client {
+	return &Client{
+		Nickname: nickname,
+		send:     make(chan Message, bufferSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// Messages returns channel of messages to be written to the client connection.
+func (c *Client) Messages() <-chan Message {
+	return c.send
+}
+
+// Evicted returns channel which is closed when client is evicted from the room
+// because it doesn't read messages fast enough or the room is closed.
+func (c *Client) Evicted() <-chan struct{} {
+	return c.done
+}
+
+func (c *Client) evict() {
+	c.once.Do(func() { close(c.done) })
+}
@@ -0,0 +1,184 @@
+// Package hub implements fan-out of room events to connected clients.
+package hub
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBufferSize  = 64
+	defaultHistorySize = 256
+)
+
+// Envelope is a message delivered to room subscribers.
+type Envelope struct {
+	// ID is a sequence number of the message in the room.
+	ID      uint64    `json:"id"`
+	Room    string    `json:"room"`
+	Kind    string    `json:"kind"`
+	Time    time.Time `json:"time"`
+	Payload any       `json:"payload"`
+}
+
+type room struct {
+	seq     uint64
+	clients map[*Client]struct{}
+
+	// history contains last published messages for clients resuming after reconnect.
+	history []entry
+}
+
+type entry struct {
+	msg        Message
+	recipients []string
+}
+
+func (e entry) visibleTo(nickname string) bool {
+	return len(e.recipients) == 0 || slices.Contains(e.recipients, nickname)
+}
+
+type Hub struct {
+	mu          sync.Mutex
+	rooms       map[string]*room
+	bufferSize  int
+	historySize int
+}
+
+func New(opts ...Option) *Hub {
+	h := &Hub{
+		rooms:       make(map[string]*room),
+		bufferSize:  defaultBufferSize,
+		historySize: defaultHistorySize,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Subscribe subscribes client of the player to messages of the room.
+// Messages published after lastID are sent to the client first if lastID is not zero,
+// false is returned if some of them are not in the history anymore.
+func (h *Hub) Subscribe(roomID, nickname string, lastID uint64) (*Client, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r := h.room(roomID)
+
+	var missed []Message
+
+	// client can't resume if room was closed after its last message
+	complete := lastID <= r.seq
+
+	if lastID != 0 && lastID < r.seq {
+		// ids are sequential, so the first missed message must be in the history
+		complete = len(r.history) > 0 && r.history[0].msg.ID <= lastID+1
+
+		for _, e := range r.history {
+			if e.msg.ID > lastID && e.visibleTo(nickname) {
+				missed = append(missed, e.msg)
+			}
+		}
+	}
+
+	c := newClient(nickname, h.bufferSize+len(missed))
+
+	for _, m := range missed {
+		c.send <- m
+	}
+
+	r.clients[c] = struct{}{}
+
+	return c, complete
+}
+
+func (h *Hub) room(roomID string) *room {
+	r, ok := h.rooms[roomID]
+	if !ok {
+		r = &room{clients: make(map[*Client]struct{})}
+		h.rooms[roomID] = r
+	}
+
+	return r
+}
+
+// Unsubscribe removes client from the room.
+func (h *Hub) Unsubscribe(roomID string, c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if r, ok := h.rooms[roomID]; ok {
+		delete(r.clients, c)
+	}
+}
+
+// Close removes the room with its history and evicts all its clients.
+func (h *Hub) Close(roomID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r, ok := h.rooms[roomID]
+	if !ok {
+		return
+	}
+
+	for c := range r.clients {
+		c.evict()
+	}
+
+	delete(h.rooms, roomID)
+}
+
+// Publish sends payload to every client in the room or only to recipients if any specified.
+// Clients with full send buffer are evicted from the room instead of blocking the publisher.
+func (h *Hub) Publish(roomID, kind string, payload any, recipients ...string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r := h.room(roomID)
+	r.seq++
+
+	data, err := json.Marshal(Envelope{
+		ID:      r.seq,
+		Room:    roomID,
+		Kind:    kind,
+		Time:    time.Now(),
+		Payload: payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	e := entry{
+		msg:        Message{ID: r.seq, Data: data},
+		recipients: recipients,
+	}
+
+	if h.historySize > 0 {
+		if len(r.history) == h.historySize {
+			r.history = slices.Delete(r.history, 0, 1)
+		}
+
+		r.history = append(r.history, e)
+	}
+
+	for c := range r.clients {
+		if !e.visibleTo(c.Nickname) {
+			continue
+		}
+
+		select {
+		case c.send <- e.msg:
+		default:
+			delete(r.clients, c)
+			c.evict()
+		}
+	}
+
+	return nil
+}
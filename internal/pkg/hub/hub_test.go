@@ -0,0 +1,243 @@
+package hub
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// received returns ids of messages buffered for the client.
+func received(c *Client) []uint64 {
+	var ids []uint64
+
+	for {
+		select {
+		case m := <-c.Messages():
+			ids = append(ids, m.ID)
+		default:
+			return ids
+		}
+	}
+}
+
+func evicted(c *Client) bool {
+	select {
+	case <-c.Evicted():
+		return true
+	default:
+		return false
+	}
+}
+
+func TestHub_Publish(t *testing.T) {
+	type publish struct {
+		roomID     string
+		recipients []string
+	}
+	tests := []struct {
+		name        string
+		bufferSize  int
+		publish     []publish
+		wantAlice   []uint64
+		wantBob     []uint64
+		wantEvicted bool
+	}{
+		{
+			name:       "fan-out to everyone",
+			bufferSize: 10,
+			publish: []publish{
+				{roomID: "room"},
+				{roomID: "room"},
+			},
+			wantAlice: []uint64{1, 2},
+			wantBob:   []uint64{1, 2},
+		},
+		{
+			name:       "only to recipients",
+			bufferSize: 10,
+			publish: []publish{
+				{roomID: "room", recipients: []string{"alice"}},
+				{roomID: "room"},
+			},
+			wantAlice: []uint64{1, 2},
+			wantBob:   []uint64{2},
+		},
+		{
+			name:       "other room",
+			bufferSize: 10,
+			publish: []publish{
+				{roomID: "other"},
+				{roomID: "room"},
+			},
+			wantAlice: []uint64{1},
+			wantBob:   []uint64{1},
+		},
+		{
+			name:       "slow consumer evicted",
+			bufferSize: 1,
+			publish: []publish{
+				{roomID: "room", recipients: []string{"alice"}},
+				{roomID: "room"},
+				{roomID: "room"},
+			},
+			wantAlice:   []uint64{1},
+			wantBob:     []uint64{2},
+			wantEvicted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(WithBufferSize(tt.bufferSize))
+			alice, _ := h.Subscribe("room", "alice", 0)
+			bob, _ := h.Subscribe("room", "bob", 0)
+
+			for _, p := range tt.publish {
+				require.NoError(t, h.Publish(p.roomID, "kind", nil, p.recipients...))
+			}
+
+			assert.Equal(t, tt.wantAlice, received(alice))
+			assert.Equal(t, tt.wantBob, received(bob))
+			assert.Equal(t, tt.wantEvicted, evicted(alice))
+			assert.Equal(t, tt.wantEvicted, evicted(bob))
+		})
+	}
+}
+
+func TestHub_PublishEnvelope(t *testing.T) {
+	h := New()
+	c, _ := h.Subscribe("room", "alice", 0)
+
+	require.NoError(t, h.Publish("room", "score", map[string]int{"score": 100}))
+
+	m := <-c.Messages()
+
+	var got struct {
+		ID      uint64         `json:"id"`
+		Room    string         `json:"room"`
+		Kind    string         `json:"kind"`
+		Payload map[string]int `json:"payload"`
+	}
+
+	require.NoError(t, json.Unmarshal(m.Data, &got))
+	assert.Equal(t, uint64(1), got.ID)
+	assert.Equal(t, "room", got.Room)
+	assert.Equal(t, "score", got.Kind)
+	assert.Equal(t, map[string]int{"score": 100}, got.Payload)
+}
+
+func TestHub_Unsubscribe(t *testing.T) {
+	h := New()
+	c, _ := h.Subscribe("room", "alice", 0)
+	h.Unsubscribe("room", c)
+
+	require.NoError(t, h.Publish("room", "kind", nil))
+	assert.Empty(t, received(c))
+	assert.False(t, evicted(c))
+}
+
+func TestHub_Close(t *testing.T) {
+	h := New()
+	c, _ := h.Subscribe("room", "alice", 0)
+	require.NoError(t, h.Publish("room", "kind", nil))
+
+	h.Close("room")
+	assert.True(t, evicted(c))
+	assert.Empty(t, h.rooms)
+
+	// room is started over, so last id of the closed room can't be resumed
+	_, complete := h.Subscribe("room", "alice", 1)
+	assert.False(t, complete)
+}
+
+func TestHub_SubscribeResume(t *testing.T) {
+	tests := []struct {
+		name         string
+		historySize  int
+		published    int
+		recipients   []string
+		lastID       uint64
+		want         []uint64
+		wantComplete bool
+	}{
+		{
+			name:         "new client",
+			historySize:  10,
+			published:    3,
+			lastID:       0,
+			want:         nil,
+			wantComplete: true,
+		},
+		{
+			name:         "missed messages",
+			historySize:  10,
+			published:    5,
+			lastID:       2,
+			want:         []uint64{3, 4, 5},
+			wantComplete: true,
+		},
+		{
+			name:         "nothing missed",
+			historySize:  10,
+			published:    5,
+			lastID:       5,
+			want:         nil,
+			wantComplete: true,
+		},
+		{
+			name:         "first missed message is the oldest in history",
+			historySize:  3,
+			published:    5,
+			lastID:       2,
+			want:         []uint64{3, 4, 5},
+			wantComplete: true,
+		},
+		{
+			name:         "missed messages not in history",
+			historySize:  3,
+			published:    5,
+			lastID:       1,
+			want:         []uint64{3, 4, 5},
+			wantComplete: false,
+		},
+		{
+			name:         "no history",
+			historySize:  0,
+			published:    5,
+			lastID:       1,
+			want:         nil,
+			wantComplete: false,
+		},
+		{
+			name:         "messages of other players skipped",
+			historySize:  10,
+			published:    5,
+			recipients:   []string{"bob"},
+			lastID:       2,
+			want:         nil,
+			wantComplete: true,
+		},
+		{
+			name:         "last id from the future",
+			historySize:  10,
+			published:    5,
+			lastID:       10,
+			want:         nil,
+			wantComplete: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(WithHistorySize(tt.historySize))
+
+			for i := 0; i < tt.published; i++ {
+				require.NoError(t, h.Publish("room", "kind", nil, tt.recipients...))
+			}
+
+			c, complete := h.Subscribe("room", "alice", tt.lastID)
+			assert.Equal(t, tt.wantComplete, complete)
+			assert.Equal(t, tt.want, received(c))
+		})
+	}
+}
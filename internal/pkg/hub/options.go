@@ -0,0 +1,17 @@
+package hub
+
+type Option func(*Hub)
+
+// WithBufferSize sets size of send buffer of every client.
+func WithBufferSize(size int) Option {
+	return func(h *Hub) {
+		h.bufferSize = size
+	}
+}
+
+// WithHistorySize sets number of last messages of the room kept for resuming clients.
+func WithHistorySize(size int) Option {
+	return func(h *Hub) {
+		h.historySize = size
+	}
+}
@@ -0,0 +1,96 @@
+// Package normalize provides normalization of typed answers to compare them
+// with accepted answers regardless of case, punctuation, articles and alphabet.
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// translit is a simplified cyrillic to latin transliteration table.
+var translit = map[rune]string{ //nolint:gochecknoglobals // lookup table
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n",
+	'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y",
+	'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// articles are removed from answers since they're usually omitted by players.
+var articles = map[string]struct{}{ //nolint:gochecknoglobals // lookup table
+	"a":   {},
+	"an":  {},
+	"the": {},
+}
+
+// String returns normalized form of s:
+//   - lower case;
+//   - "ё" replaced with "е";
+//   - hyphens and apostrophes removed, other punctuation and symbols replaced with space;
+//   - cyrillic transliterated to latin;
+//   - articles removed;
+//   - words separated with single space.
+func String(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range strings.ToLower(s) {
+		if r == 'ё' {
+			r = 'е'
+		}
+
+		switch {
+		case r == '\'' || r == '’' || r == '`' || unicode.Is(unicode.Pd, r):
+			continue
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			b.WriteRune(' ')
+		default:
+			if l, ok := translit[r]; ok {
+				b.WriteString(l)
+				continue
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	words := strings.Fields(b.String())
+	res := words[:0]
+
+	for _, w := range words {
+		if _, ok := articles[w]; !ok {
+			res = append(res, w)
+		}
+	}
+
+	return strings.Join(res, " ")
+}
+
+// Matches reports whether typed answer matches answer text or one of accepted alternatives.
+// Typed answer never matches if it's equal to one of explicitly wrong variants.
+func Matches(typed string, answer entity.Answer) bool {
+	t := String(typed)
+	if t == "" {
+		return false
+	}
+
+	for _, v := range answer.WrongVariants {
+		if String(v) == t {
+			return false
+		}
+	}
+
+	if String(answer.Text) == t {
+		return true
+	}
+
+	for _, v := range answer.Alternatives {
+		if String(v) == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package normalize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "empty",
+			s:    "",
+			want: "",
+		},
+		{
+			name: "case",
+			s:    "Leo TOLSTOY",
+			want: "leo tolstoy",
+		},
+		{
+			name: "yo",
+			s:    "Ёжик в тумане",
+			want: "ezhik v tumane",
+		},
+		{
+			name: "punctuation",
+			s:    "  Hello,   world!!! ",
+			want: "hello world",
+		},
+		{
+			name: "hyphens and apostrophes",
+			s:    "Spider-Man, O'Neil",
+			want: "spiderman oneil",
+		},
+		{
+			name: "articles",
+			s:    "The Lord of the Rings",
+			want: "lord of rings",
+		},
+		{
+			name: "only articles",
+			s:    "The A an",
+			want: "",
+		},
+		{
+			name: "transliteration",
+			s:    "Лев Толстой",
+			want: "lev tolstoy",
+		},
+		{
+			name: "soft and hard signs",
+			s:    "Объявление Гоголь",
+			want: "obyavlenie gogol",
+		},
+		{
+			name: "digits",
+			s:    "Apollo 11",
+			want: "apollo 11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, String(tt.s))
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	answer := entity.Answer{
+		Text:          "Лев Толстой",
+		Alternatives:  []string{"Tolstoy", "Leo Tolstoy"},
+		WrongVariants: []string{"Алексей Толстой"},
+	}
+
+	tests := []struct {
+		name   string
+		typed  string
+		answer entity.Answer
+		want   bool
+	}{
+		{
+			name:   "exact text",
+			typed:  "Лев Толстой",
+			answer: answer,
+			want:   true,
+		},
+		{
+			name:   "text in another alphabet",
+			typed:  "lev tolstoy",
+			answer: answer,
+			want:   true,
+		},
+		{
+			name:   "alternative",
+			typed:  "TOLSTOY!",
+			answer: answer,
+			want:   true,
+		},
+		{
+			name:   "alternative in another alphabet",
+			typed:  "Толстой",
+			answer: answer,
+			want:   true,
+		},
+		{
+			name:   "wrong variant",
+			typed:  "алексей толстой",
+			answer: answer,
+			want:   false,
+		},
+		{
+			name:   "not matched",
+			typed:  "Достоевский",
+			answer: answer,
+			want:   false,
+		},
+		{
+			name:   "empty typed",
+			typed:  " ",
+			answer: answer,
+			want:   false,
+		},
+		{
+			name:  "wrong variant same as accepted",
+			typed: "The Beatles",
+			answer: entity.Answer{
+				Text:          "Beatles",
+				WrongVariants: []string{"the beatles"},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Matches(tt.typed, tt.answer))
+		})
+	}
+}
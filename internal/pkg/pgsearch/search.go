@@ -1,9 +1,9 @@
 package pgsearch
 
 import (
-	"fmt"
-
 	sq "github.com/Masterminds/squirrel"
+
+	"github.com/ysomad/answersuck/internal/entity"
 )
 
 // conf is a postgres text search configuration.
@@ -14,6 +14,21 @@ const (
 	ConfEng conf = "english"
 )
 
+// ConfByLang returns text search configuration for language, ConfRus is default.
+func ConfByLang(l entity.Language) conf {
+	if l == entity.LangEnglish {
+		return ConfEng
+	}
+
+	return ConfRus
+}
+
+// LangConfExpr returns sql expression which picks text search configuration by language column,
+// must be kept in sync with expressions of generated ts columns.
+func LangConfExpr(column string) string {
+	return "CASE " + column + " WHEN 2 THEN 'english'::regconfig ELSE 'russian'::regconfig END"
+}
+
 type order string
 
 const (
@@ -24,10 +39,22 @@ const (
 
 // Where attaches where clause to builder with query for fulltext search.
 func Where(b sq.SelectBuilder, query string, c conf) sq.SelectBuilder {
-	return b.Where(sq.Expr("ts @@ phraseto_tsquery('"+string(c)+"', ?)", query))
+	return b.Where(sq.Expr("ts @@ phraseto_tsquery(?::text::regconfig, ?)", string(c), query))
+}
+
+// WhereByLang attaches where clause to builder with query for fulltext search,
+// text search configuration is picked for each row by its language column.
+func WhereByLang(b sq.SelectBuilder, query, langColumn string) sq.SelectBuilder {
+	return b.Where(sq.Expr("ts @@ phraseto_tsquery("+LangConfExpr(langColumn)+", ?)", query))
 }
 
 // OrderBy attaches order by clause to builder with order by for fulltext search.
 func OrderBy(b sq.SelectBuilder, query string, c conf, o order) sq.SelectBuilder {
-	return b.OrderBy(fmt.Sprintf("ts_rank(ts, to_tsquery('%s', '%s')) %s", c, query, o))
+	return b.OrderByClause("ts_rank(ts, phraseto_tsquery(?::text::regconfig, ?)) "+string(o), string(c), query)
+}
+
+// OrderByLang attaches order by clause to builder with order by for fulltext search,
+// text search configuration is picked for each row by its language column.
+func OrderByLang(b sq.SelectBuilder, query, langColumn string, o order) sq.SelectBuilder {
+	return b.OrderByClause("ts_rank(ts, phraseto_tsquery("+LangConfExpr(langColumn)+", ?)) "+string(o), query)
 }
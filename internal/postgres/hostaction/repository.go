@@ -0,0 +1,14 @@
+// Package hostaction is an append-only log of host actions in game rooms.
+package hostaction
+
+import "github.com/ysomad/answersuck/internal/pkg/pgclient"
+
+const hostActionsTable = "host_actions"
+
+type Repository struct {
+	*pgclient.Client
+}
+
+func NewRepository(c *pgclient.Client) *Repository {
+	return &Repository{c}
+}
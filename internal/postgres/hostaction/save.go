@@ -0,0 +1,49 @@
+package hostaction
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+func (r *Repository) Save(ctx context.Context, a entity.HostAction) (int64, error) {
+	var (
+		correct *bool
+		score   *int32
+	)
+
+	switch a.Type {
+	case entity.HostActionJudge:
+		correct = &a.Correct
+	case entity.HostActionSetScore:
+		score = &a.Score
+	}
+
+	sql, args, err := r.Builder.
+		Insert(hostActionsTable).
+		Columns("room_id, host, action_type, player, correct, score, reason, create_time").
+		Values(
+			a.RoomID,
+			a.Host,
+			a.Type,
+			zeronull.Text(a.Player),
+			correct,
+			score,
+			zeronull.Text(a.Reason),
+			a.CreateTime,
+		).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var actionID int64
+
+	if err := r.Pool.QueryRow(ctx, sql, args...).Scan(&actionID); err != nil {
+		return 0, err
+	}
+
+	return actionID, nil
+}
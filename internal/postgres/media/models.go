@@ -9,6 +9,7 @@ import (
 type media struct {
 	URL        string           `db:"url"`
 	Type       entity.MediaType `db:"type"`
+	Duration   time.Duration    `db:"duration"`
 	Uploader   string           `db:"uploader"`
 	CreateTime time.Time        `db:"create_time"`
 }
@@ -10,11 +10,11 @@ import (
 func (r *Repository) Save(ctx context.Context, m entity.Media) (entity.Media, error) {
 	sql, args, err := r.Builder.
 		Insert(mediaTable).
-		Columns("url, type, uploader, create_time").
-		Values(m.URL, m.Type, m.Uploader, m.CreateTime).
+		Columns("url, type, duration, uploader, create_time").
+		Values(m.URL, m.Type, m.Duration, m.Uploader, m.CreateTime).
 		Suffix("ON CONFLICT(url) DO UPDATE").
-		Suffix("SET url = EXCLUDED.url").
-		Suffix("RETURNING url, type, uploader, create_time").
+		Suffix("SET url = EXCLUDED.url, duration = COALESCE(NULLIF(EXCLUDED.duration, 0), " + mediaTable + ".duration)").
+		Suffix("RETURNING url, type, duration, uploader, create_time").
 		ToSql()
 	if err != nil {
 		return entity.Media{}, err
@@ -0,0 +1,29 @@
+package pack
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) DeleteShareToken(ctx context.Context, token string) error {
+	sql, args, err := r.Builder.
+		Delete(packShareTokensTable).
+		Where(squirrel.Eq{"token": token}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if ct.RowsAffected() == 0 {
+		return apperr.PackShareTokenNotFound
+	}
+
+	return nil
+}
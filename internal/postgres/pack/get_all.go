@@ -0,0 +1,86 @@
+package pack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+	"github.com/ysomad/answersuck/internal/pkg/pgsearch"
+)
+
+// GetAll returns published public packs matching filter except hidden ones, packs are sorted by relevance if
+// filter has query or by create time otherwise.
+func (r *Repository) GetAll(ctx context.Context, f entity.PackFilter, p paging.Params) (paging.List[entity.Pack], error) {
+	limit, offset, err := paging.OffsetToken(p.PageToken).Decode()
+	if err != nil {
+		return paging.List[entity.Pack]{}, err
+	}
+
+	// use limit from params only if token has no limit
+	if limit == 0 {
+		limit = uint64(p.PageSize)
+	}
+
+	b := r.Builder.
+		Select("id, name, author, is_published, is_hidden, cover_url, language, visibility, create_time").
+		From(PacksTable).
+		Where(squirrel.Eq{
+			"is_published": true,
+			"visibility":   entity.PackVisibilityPublic,
+			"is_hidden":    false,
+		}).
+		Limit(limit + 1).
+		Offset(offset)
+
+	if f.Language != 0 {
+		b = b.Where(squirrel.Eq{"language": f.Language})
+	}
+
+	switch {
+	case f.Query != "" && f.Language != 0:
+		conf := pgsearch.ConfByLang(f.Language)
+		b = pgsearch.Where(b, f.Query, conf)
+		b = pgsearch.OrderBy(b, f.Query, conf, pgsearch.OrderDefault)
+	case f.Query != "":
+		b = pgsearch.WhereByLang(b, f.Query, "language")
+		b = pgsearch.OrderByLang(b, f.Query, "language", pgsearch.OrderDefault)
+	}
+
+	b = b.OrderBy("create_time DESC", "id DESC")
+
+	sql, args, err := b.ToSql()
+	if err != nil {
+		return paging.List[entity.Pack]{}, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[entity.Pack]{}, fmt.Errorf("r.Pool.Query: %w", err)
+	}
+
+	pp, err := pgx.CollectRows(rows, pgx.RowToStructByName[Pack])
+	if err != nil {
+		return paging.List[entity.Pack]{}, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	packs := make([]entity.Pack, len(pp))
+
+	for i, p := range pp {
+		packs[i] = entity.Pack{
+			ID:         p.ID,
+			Name:       p.Name,
+			Author:     p.Author,
+			Published:  p.Published,
+			Hidden:     p.Hidden,
+			CoverURL:   string(p.CoverURL),
+			Language:   p.Language,
+			Visibility: p.Visibility,
+			CreateTime: p.CreateTime,
+		}
+	}
+
+	return paging.NewListWithOffset(packs, limit, offset)
+}
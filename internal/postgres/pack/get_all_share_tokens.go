@@ -0,0 +1,39 @@
+package pack
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+func (r *Repository) GetAllShareTokens(ctx context.Context, packID int32) ([]entity.PackShareToken, error) {
+	sql, args, err := r.Builder.
+		Select("token, pack_id, create_time").
+		From(packShareTokensTable).
+		Where(squirrel.Eq{"pack_id": packID}).
+		OrderBy("create_time").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	tt, err := pgx.CollectRows(rows, pgx.RowToStructByName[shareToken])
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]entity.PackShareToken, len(tt))
+
+	for i, t := range tt {
+		tokens[i] = entity.PackShareToken(t)
+	}
+
+	return tokens, nil
+}
@@ -10,9 +10,9 @@ import (
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
 )
 
-func (r *repository) GetOne(ctx context.Context, packID int32) (*entity.Pack, error) {
+func (r *Repository) GetOne(ctx context.Context, packID int32) (*entity.Pack, error) {
 	sql, args, err := r.Builder.
-		Select("id, name, author, is_published, cover_url, create_time").
+		Select("id, name, author, is_published, is_hidden, cover_url, language, visibility, create_time").
 		From(PacksTable).
 		Where(squirrel.Eq{"id": packID}).
 		ToSql()
@@ -39,7 +39,10 @@ func (r *repository) GetOne(ctx context.Context, packID int32) (*entity.Pack, er
 		Name:       p.Name,
 		Author:     p.Author,
 		Published:  p.Published,
+		Hidden:     p.Hidden,
 		CoverURL:   string(p.CoverURL),
+		Language:   p.Language,
+		Visibility: p.Visibility,
 		CreateTime: p.CreateTime,
 	}, nil
 }
@@ -0,0 +1,55 @@
+package pack
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// GetRandom returns random published public pack which is not hidden.
+func (r *Repository) GetRandom(ctx context.Context) (*entity.Pack, error) {
+	sql, args, err := r.Builder.
+		Select("id, name, author, is_published, is_hidden, cover_url, language, visibility, create_time").
+		From(PacksTable).
+		Where(squirrel.Eq{
+			"is_published": true,
+			"is_hidden":    false,
+			"visibility":   entity.PackVisibilityPublic,
+		}).
+		OrderBy("random()").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Pack])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.PackNotFound
+		}
+
+		return nil, err
+	}
+
+	return &entity.Pack{
+		ID:         p.ID,
+		Name:       p.Name,
+		Author:     p.Author,
+		Published:  p.Published,
+		Hidden:     p.Hidden,
+		CoverURL:   string(p.CoverURL),
+		Language:   p.Language,
+		Visibility: p.Visibility,
+		CreateTime: p.CreateTime,
+	}, nil
+}
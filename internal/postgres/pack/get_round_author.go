@@ -9,7 +9,7 @@ import (
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
 )
 
-func (r *repository) GetRoundAuthor(ctx context.Context, roundID int32) (string, error) {
+func (r *Repository) GetRoundAuthor(ctx context.Context, roundID int32) (string, error) {
 	sql, args, err := r.Builder.
 		Select("p.author").
 		From("rounds r").
@@ -0,0 +1,59 @@
+package pack
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// GetRoundPack returns pack of the round.
+func (r *Repository) GetRoundPack(ctx context.Context, roundID int32) (*entity.Pack, error) {
+	sql, args, err := r.Builder.
+		Select(
+			"p.id as id",
+			"p.name as name",
+			"p.author as author",
+			"p.is_published as is_published",
+			"p.is_hidden as is_hidden",
+			"p.cover_url as cover_url",
+			"p.language as language",
+			"p.visibility as visibility",
+			"p.create_time as create_time").
+		From("rounds r").
+		InnerJoin("packs p ON r.pack_id = p.id").
+		Where(squirrel.Eq{"r.id": roundID}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Pack])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.RoundNotFound
+		}
+
+		return nil, err
+	}
+
+	return &entity.Pack{
+		ID:         p.ID,
+		Name:       p.Name,
+		Author:     p.Author,
+		Published:  p.Published,
+		Hidden:     p.Hidden,
+		CoverURL:   string(p.CoverURL),
+		Language:   p.Language,
+		Visibility: p.Visibility,
+		CreateTime: p.CreateTime,
+	}, nil
+}
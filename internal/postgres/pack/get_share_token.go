@@ -0,0 +1,38 @@
+package pack
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) GetShareToken(ctx context.Context, token string) (*entity.PackShareToken, error) {
+	sql, args, err := r.Builder.
+		Select("token, pack_id, create_time").
+		From(packShareTokensTable).
+		Where(squirrel.Eq{"token": token}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[shareToken])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.PackShareTokenNotFound
+		}
+
+		return nil, err
+	}
+
+	return (*entity.PackShareToken)(&t), nil
+}
@@ -10,14 +10,17 @@ import (
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
 )
 
-func (r *repository) GetWithTags(ctx context.Context, packID int32) (*entity.PackWithTags, error) {
+func (r *Repository) GetWithTags(ctx context.Context, packID int32) (*entity.PackWithTags, error) {
 	sql, args, err := r.Builder.
 		Select(
 			"p.id as id",
 			"p.name as name",
 			"p.author as author",
 			"p.is_published as is_published",
+			"p.is_hidden as is_hidden",
 			"p.cover_url as cover_url",
+			"p.language as language",
+			"p.visibility as visibility",
 			"p.create_time as create_time",
 			"pt.tag as tag",
 		).
@@ -49,7 +52,10 @@ func (r *repository) GetWithTags(ctx context.Context, packID int32) (*entity.Pac
 			Name:       pp[0].Name,
 			Author:     pp[0].Author,
 			Published:  pp[0].Published,
+			Hidden:     pp[0].Hidden,
 			CoverURL:   string(pp[0].CoverURL),
+			Language:   pp[0].Language,
+			Visibility: pp[0].Visibility,
 			CreateTime: pp[0].CreateTime,
 		},
 		Tags: make([]string, len(pp)),
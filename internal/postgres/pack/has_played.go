@@ -0,0 +1,32 @@
+package pack
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// HasPlayed reports whether player finished a game with the pack.
+func (r *Repository) HasPlayed(ctx context.Context, packID int32, player string) (bool, error) {
+	sql, args, err := r.Builder.
+		Select("1").
+		From(packPlayersTable).
+		Where(squirrel.Eq{
+			"pack_id": packID,
+			"player":  player,
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var played bool
+
+	if err := r.Pool.QueryRow(ctx, sql, args...).Scan(&played); err != nil {
+		return false, err
+	}
+
+	return played, nil
+}
@@ -0,0 +1,30 @@
+package pack
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) IncrementPlayCount(ctx context.Context, packID int32) error {
+	sql, args, err := r.Builder.
+		Update(PacksTable).
+		Set("play_count", squirrel.Expr("play_count + 1")).
+		Where(squirrel.Eq{"id": packID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if ct.RowsAffected() == 0 {
+		return apperr.PackNotFound
+	}
+
+	return nil
+}
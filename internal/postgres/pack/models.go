@@ -4,18 +4,28 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
 )
 
 type Pack struct {
-	ID         int32         `db:"id"`
-	Name       string        `db:"name"`
-	Author     string        `db:"author"`
-	Published  bool          `db:"is_published"`
-	CoverURL   zeronull.Text `db:"cover_url"`
-	CreateTime time.Time     `db:"create_time"`
+	ID         int32                 `db:"id"`
+	Name       string                `db:"name"`
+	Author     string                `db:"author"`
+	Published  bool                  `db:"is_published"`
+	Hidden     bool                  `db:"is_hidden"`
+	CoverURL   zeronull.Text         `db:"cover_url"`
+	Language   entity.Language       `db:"language"`
+	Visibility entity.PackVisibility `db:"visibility"`
+	CreateTime time.Time             `db:"create_time"`
 }
 
 type packWithTag struct {
 	Pack
 	Tag string `db:"tag"`
 }
+
+type shareToken struct {
+	Token      string    `db:"token"`
+	PackID     int32     `db:"pack_id"`
+	CreateTime time.Time `db:"create_time"`
+}
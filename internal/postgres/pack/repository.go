@@ -5,12 +5,16 @@ import "github.com/ysomad/answersuck/internal/pkg/pgclient"
 const (
 	PacksTable    = "packs"
 	packTagsTable = "pack_tags"
+
+	packShareTokensTable = "pack_share_tokens"
+	packRatingsTable     = "pack_ratings"
+	packPlayersTable     = "pack_players"
 )
 
-type repository struct {
+type Repository struct {
 	*pgclient.Client
 }
 
-func NewRepository(c *pgclient.Client) *repository {
-	return &repository{c}
+func NewRepository(c *pgclient.Client) *Repository {
+	return &Repository{c}
 }
@@ -4,9 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
@@ -15,24 +16,30 @@ import (
 	"github.com/ysomad/answersuck/internal/postgres/tag"
 )
 
-func (r *repository) Save(ctx context.Context, p *entity.Pack, tags []string) (packID int32, err error) {
+func (r *Repository) Save(ctx context.Context, p *entity.Pack, tags []string) (packID int32, err error) {
 	if len(tags) == 0 {
 		return r.insertPack(ctx, r.Pool, p)
 	}
 
 	err = pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		// 1. Insert tags
+		// 1. Resolve tags to existing ones
+		tags, err = r.resolveTags(ctx, tx, tags)
+		if err != nil {
+			return fmt.Errorf("error resolving tags: %w", err)
+		}
+
+		// 2. Insert tags
 		if err = r.insertTags(ctx, tx, tags, p.Author, p.CreateTime); err != nil {
 			return fmt.Errorf("error saving tags: %w", err)
 		}
 
-		// 2. Insert pack
+		// 3. Insert pack
 		packID, err = r.insertPack(ctx, tx, p)
 		if err != nil {
 			return fmt.Errorf("error saving pack: %w", err)
 		}
 
-		// 3. Insert pack tags
+		// 4. Insert pack tags
 		if err = r.insertPackTags(ctx, tx, packID, tags); err != nil {
 			return fmt.Errorf("error saving pack tags: %w", err)
 		}
@@ -50,11 +57,11 @@ type queryRower interface {
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
-func (r *repository) insertPack(ctx context.Context, db queryRower, p *entity.Pack) (int32, error) {
+func (r *Repository) insertPack(ctx context.Context, db queryRower, p *entity.Pack) (int32, error) {
 	sql, args, err := r.Builder.
 		Insert(PacksTable).
-		Columns("name, author, is_published, cover_url, create_time").
-		Values(p.Name, p.Author, false, zeronull.Text(p.CoverURL), p.CreateTime).
+		Columns("name, author, is_published, cover_url, language, visibility, create_time").
+		Values(p.Name, p.Author, false, zeronull.Text(p.CoverURL), p.Language, p.Visibility, p.CreateTime).
 		Suffix("RETURNING id").
 		ToSql()
 	if err != nil {
@@ -76,7 +83,96 @@ func (r *repository) insertPack(ctx context.Context, db queryRower, p *entity.Pa
 	return packID, nil
 }
 
-func (r *repository) insertTags(ctx context.Context, tx pgx.Tx, tags []string, author string, createTime time.Time) error {
+// resolveTags returns names of existing tags matching provided tags case-insensitively or by alias,
+// tags which are not found are returned as is without duplicates.
+// Returns apperr.TagBanned if any of tags is banned.
+func (r *Repository) resolveTags(ctx context.Context, tx pgx.Tx, tags []string) ([]string, error) {
+	keys := make([]string, len(tags))
+
+	for i, t := range tags {
+		keys[i] = strings.ToLower(t)
+	}
+
+	// 1. Check banned tags
+	sql, args, err := r.Builder.
+		Select("name").
+		From(tag.BannedTagsTable).
+		Where(squirrel.Eq{"name": keys}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var banned bool
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&banned); err != nil {
+		return nil, err
+	}
+
+	if banned {
+		return nil, apperr.TagBanned
+	}
+
+	// 2. Find existing tags and aliases
+	sql, args, err = r.Builder.
+		Select("lower(name), name, false").
+		From(tag.TagsTable).
+		Where(squirrel.Eq{"lower(name)": keys}).
+		Suffix("UNION ALL SELECT alias, tag, true FROM "+tag.TagAliasesTable+" WHERE alias = ANY(?::varchar[])", keys).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]string, len(keys))
+
+	var (
+		key, name string
+		isAlias   bool
+	)
+
+	_, err = pgx.ForEachRow(rows, []any{&key, &name, &isAlias}, func() error {
+		// existing tags take precedence over aliases
+		if _, ok := found[key]; ok && isAlias {
+			return nil
+		}
+
+		found[key] = name
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Replace tags with existing ones
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+
+	for i, t := range tags {
+		if name, ok := found[keys[i]]; ok {
+			t = name
+		}
+
+		if _, ok := seen[strings.ToLower(t)]; ok {
+			continue
+		}
+
+		seen[strings.ToLower(t)] = struct{}{}
+		res = append(res, t)
+	}
+
+	return res, nil
+}
+
+func (r *Repository) insertTags(ctx context.Context, tx pgx.Tx, tags []string, author string, createTime time.Time) error {
 	b := r.Builder.
 		Insert(tag.TagsTable).
 		Columns("name, author, create_time").
@@ -98,7 +194,7 @@ func (r *repository) insertTags(ctx context.Context, tx pgx.Tx, tags []string, a
 	return nil
 }
 
-func (r *repository) insertPackTags(ctx context.Context, tx pgx.Tx, packID int32, tags []string) error {
+func (r *Repository) insertPackTags(ctx context.Context, tx pgx.Tx, packID int32, tags []string) error {
 	b := r.Builder.
 		Insert(packTagsTable).
 		Columns("pack_id, tag")
@@ -112,9 +208,6 @@ func (r *repository) insertPackTags(ctx context.Context, tx pgx.Tx, packID int32
 		return err
 	}
 
-	slog.Info(sql)
-	slog.Info("pack", packID)
-
 	if _, err := tx.Exec(ctx, sql, args...); err != nil {
 		return err
 	}
@@ -0,0 +1,44 @@
+package pack
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// SavePlayers saves players who finished a game with the pack, play time of players
+// who played the pack before is overwritten.
+func (r *Repository) SavePlayers(ctx context.Context, packID int32, players []string, playTime time.Time) error {
+	if len(players) == 0 {
+		return nil
+	}
+
+	b := r.Builder.
+		Insert(packPlayersTable).
+		Columns("pack_id, player, play_time").
+		Suffix("ON CONFLICT (pack_id, player) DO UPDATE SET play_time = EXCLUDED.play_time")
+
+	for _, p := range players {
+		b = b.Values(packID, p, playTime)
+	}
+
+	sql, args, err := b.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Pool.Exec(ctx, sql, args...); err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "pack_players_pack_id_fkey" {
+			return apperr.PackNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
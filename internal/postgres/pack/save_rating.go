@@ -0,0 +1,35 @@
+package pack
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// SaveRating saves rating of the pack, previous rating of the player is overwritten.
+func (r *Repository) SaveRating(ctx context.Context, pr entity.PackRating) error {
+	sql, args, err := r.Builder.
+		Insert(packRatingsTable).
+		Columns("pack_id, player, rating, create_time").
+		Values(pr.PackID, pr.Player, pr.Rating, pr.CreateTime).
+		Suffix("ON CONFLICT (pack_id, player) DO UPDATE SET rating = EXCLUDED.rating, create_time = EXCLUDED.create_time").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Pool.Exec(ctx, sql, args...); err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "pack_ratings_pack_id_fkey" {
+			return apperr.PackNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package pack
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) SaveShareToken(ctx context.Context, t entity.PackShareToken) error {
+	sql, args, err := r.Builder.
+		Insert(packShareTokensTable).
+		Columns("token, pack_id, create_time").
+		Values(t.Token, t.PackID, t.CreateTime).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Pool.Exec(ctx, sql, args...); err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "pack_share_tokens_pack_id_fkey" {
+			return apperr.PackNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
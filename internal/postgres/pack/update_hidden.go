@@ -0,0 +1,31 @@
+package pack
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// UpdateHidden hides pack from catalog or returns it back.
+func (r *Repository) UpdateHidden(ctx context.Context, packID int32, hidden bool) error {
+	sql, args, err := r.Builder.
+		Update(PacksTable).
+		Set("is_hidden", hidden).
+		Where(squirrel.Eq{"id": packID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if ct.RowsAffected() == 0 {
+		return apperr.PackNotFound
+	}
+
+	return nil
+}
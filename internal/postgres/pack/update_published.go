@@ -0,0 +1,204 @@
+package pack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/postgres/player"
+)
+
+// UpdatePublished publishes pack and saves its stats.
+// Returns apperr.PackAuthorBanned if author of the pack is banned from publishing,
+// entity pack layout error if pack content doesn't meet publishing requirements.
+func (r *Repository) UpdatePublished(ctx context.Context, packID int32, publishTime time.Time) (entity.PackStats, error) {
+	var stats entity.PackStats
+
+	err := pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// 1. Check author is not banned
+		sql, args, err := r.Builder.
+			Select("1").
+			From(player.BannedAuthorsTable + " b").
+			InnerJoin(PacksTable + " p ON p.author = b.nickname").
+			Where(squirrel.Eq{"p.id": packID}).
+			Prefix("SELECT EXISTS (").
+			Suffix(")").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var banned bool
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&banned); err != nil {
+			return err
+		}
+
+		if banned {
+			return apperr.PackAuthorBanned
+		}
+
+		// 2. Validate pack layout
+		layout, err := r.getLayout(ctx, tx, packID)
+		if err != nil {
+			return fmt.Errorf("error getting pack layout: %w", err)
+		}
+
+		if err := layout.Validate(); err != nil {
+			return err
+		}
+
+		stats = layout.Stats()
+
+		// 3. Count question media
+		if err := r.countMedia(ctx, tx, packID, &stats); err != nil {
+			return fmt.Errorf("error counting pack media: %w", err)
+		}
+
+		// 4. Publish pack
+		sql, args, err = r.Builder.
+			Update(PacksTable).
+			SetMap(map[string]any{
+				"is_published":   true,
+				"publish_time":   publishTime,
+				"round_count":    stats.RoundCount,
+				"topic_count":    stats.TopicCount,
+				"question_count": stats.QuestionCount,
+				"video_count":    stats.VideoCount,
+				"audio_count":    stats.AudioCount,
+				"image_count":    stats.ImageCount,
+			}).
+			Where(squirrel.Eq{
+				"id":           packID,
+				"is_published": false,
+			}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		ct, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		if ct.RowsAffected() == 0 {
+			return apperr.PackPublished
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.PackStats{}, err
+	}
+
+	return stats, nil
+}
+
+// getLayout returns number of questions in each topic of each round ordered by positions.
+func (r *Repository) getLayout(ctx context.Context, tx pgx.Tx, packID int32) (entity.PackLayout, error) {
+	sql, args, err := r.Builder.
+		Select("r.id, rt.id, count(rq.id)").
+		From("rounds r").
+		LeftJoin("round_topics rt ON rt.round_id = r.id").
+		LeftJoin("round_questions rq ON rq.round_topic_id = rt.id").
+		Where(squirrel.Eq{"r.pack_id": packID}).
+		GroupBy("r.id", "rt.id").
+		OrderBy("r.position", "rt.position").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		layout             entity.PackLayout
+		roundID, prevRound int32
+		roundTopicID       zeronull.Int4
+		questionCount      int
+	)
+
+	_, err = pgx.ForEachRow(rows, []any{&roundID, &roundTopicID, &questionCount}, func() error {
+		if roundID != prevRound {
+			layout = append(layout, []int{})
+			prevRound = roundID
+		}
+
+		// round without topics
+		if roundTopicID == 0 {
+			return nil
+		}
+
+		layout[len(layout)-1] = append(layout[len(layout)-1], questionCount)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return layout, nil
+}
+
+// countMedia sets video, audio and image counts of media used in questions and question parts of the pack,
+// media used several times is counted once.
+func (r *Repository) countMedia(ctx context.Context, tx pgx.Tx, packID int32, stats *entity.PackStats) error {
+	// UNION removes duplicate urls
+	urls := r.Builder.
+		Select("q.media_url AS url").
+		From("rounds r").
+		InnerJoin("round_topics rt ON rt.round_id = r.id").
+		InnerJoin("round_questions rq ON rq.round_topic_id = rt.id").
+		InnerJoin("questions q ON q.id = rq.question_id").
+		Where(squirrel.Eq{"r.pack_id": packID}).
+		Suffix("UNION "+
+			"SELECT qp.media_url FROM rounds r "+
+			"INNER JOIN round_topics rt ON rt.round_id = r.id "+
+			"INNER JOIN round_questions rq ON rq.round_topic_id = rt.id "+
+			"INNER JOIN question_parts qp ON qp.question_id = rq.question_id "+
+			"WHERE r.pack_id = ?", packID)
+
+	sql, args, err := r.Builder.
+		Select("m.type, count(*)").
+		FromSelect(urls, "u").
+		InnerJoin("media m ON m.url = u.url").
+		GroupBy("m.type").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mediaType entity.MediaType
+		count     int16
+	)
+
+	_, err = pgx.ForEachRow(rows, []any{&mediaType, &count}, func() error {
+		switch mediaType {
+		case entity.MediaTypeVideo:
+			stats.VideoCount = count
+		case entity.MediaTypeAudio:
+			stats.AudioCount = count
+		case entity.MediaTypeImage:
+			stats.ImageCount = count
+		}
+
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,31 @@
+package pack
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) UpdateVisibility(ctx context.Context, packID int32, v entity.PackVisibility) error {
+	sql, args, err := r.Builder.
+		Update(PacksTable).
+		Set("visibility", v).
+		Where(squirrel.Eq{"id": packID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if ct.RowsAffected() == 0 {
+		return apperr.PackNotFound
+	}
+
+	return nil
+}
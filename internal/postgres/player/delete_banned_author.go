@@ -0,0 +1,24 @@
+package player
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// DeleteBannedAuthor allows player to publish packs again.
+func (r *Repository) DeleteBannedAuthor(ctx context.Context, nickname string) error {
+	sql, args, err := r.Builder.
+		Delete(BannedAuthorsTable).
+		Where(sq.Eq{"nickname": nickname}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Pool.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
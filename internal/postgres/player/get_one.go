@@ -19,6 +19,7 @@ func (r *Repository) GetOne(ctx context.Context, login string, loginType entity.
 			"email",
 			"display_name",
 			"email_verified",
+			"is_admin",
 			"password",
 			"create_time",
 			"update_time",
@@ -56,6 +57,7 @@ func (r *Repository) GetOne(ctx context.Context, login string, loginType entity.
 		Email:         p.Email,
 		DisplayName:   string(p.DisplayName),
 		EmailVerified: p.EmailVerified,
+		Admin:         p.Admin,
 		PasswordHash:  p.Password,
 		CreatedAt:     p.CreateTime,
 		UpdateTime:    time.Time(p.UpdateTime),
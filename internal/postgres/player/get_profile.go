@@ -0,0 +1,124 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// GetProfile returns author profile with published public packs which are not hidden,
+// most recently published first. Plays and ratings are aggregated over the packs.
+func (r *Repository) GetProfile(ctx context.Context, nickname string) (*entity.AuthorProfile, error) {
+	// 1. Get player
+	sql, args, err := r.Builder.
+		Select("nickname, display_name, create_time").
+		From(playerTable).
+		Where(sq.Eq{"nickname": nickname}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		profile     entity.AuthorProfile
+		displayName zeronull.Text
+	)
+
+	err = r.Pool.QueryRow(ctx, sql, args...).Scan(&profile.Nickname, &displayName, &profile.JoinTime)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.PlayerNotFound
+		}
+
+		return nil, fmt.Errorf("error getting player: %w", err)
+	}
+
+	profile.DisplayName = string(displayName)
+
+	// 2. Get packs with ratings
+	sql, args, err = r.Builder.
+		Select(
+			"p.id as id",
+			"p.name as name",
+			"p.cover_url as cover_url",
+			"p.language as language",
+			"p.create_time as create_time",
+			"p.round_count as round_count",
+			"p.topic_count as topic_count",
+			"p.question_count as question_count",
+			"p.video_count as video_count",
+			"p.audio_count as audio_count",
+			"p.image_count as image_count",
+			"p.play_count as play_count",
+			"COALESCE(sum(pr.rating), 0) as rating_sum",
+			"count(pr.rating) as rating_count",
+		).
+		From("packs p").
+		LeftJoin("pack_ratings pr ON pr.pack_id = p.id").
+		Where(sq.Eq{
+			"p.author":       nickname,
+			"p.is_published": true,
+			"p.is_hidden":    false,
+			"p.visibility":   entity.PackVisibilityPublic,
+		}).
+		GroupBy("p.id").
+		OrderBy("p.publish_time DESC", "p.id DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query: %w", err)
+	}
+
+	pp, err := pgx.CollectRows(rows, pgx.RowToStructByName[authorPack])
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	profile.Packs = make([]entity.PackWithStats, len(pp))
+
+	var ratingSum, ratingCount int64
+
+	for i, p := range pp {
+		profile.Packs[i] = entity.PackWithStats{
+			Pack: entity.Pack{
+				ID:         p.ID,
+				Name:       p.Name,
+				Author:     nickname,
+				Published:  true,
+				CoverURL:   string(p.CoverURL),
+				Language:   p.Language,
+				Visibility: entity.PackVisibilityPublic,
+				CreateTime: p.CreateTime,
+			},
+			Stats: entity.PackStats{
+				RoundCount:    int16(p.RoundCount),
+				TopicCount:    int16(p.TopicCount),
+				QuestionCount: int16(p.QuestionCount),
+				VideoCount:    int16(p.VideoCount),
+				AudioCount:    int16(p.AudioCount),
+				ImageCount:    int16(p.ImageCount),
+				PlayCount:     p.PlayCount,
+			},
+		}
+
+		profile.PlayCount += int64(p.PlayCount)
+		ratingSum += p.RatingSum
+		ratingCount += p.RatingCount
+	}
+
+	if ratingCount > 0 {
+		profile.AverageRating = float32(ratingSum) / float32(ratingCount)
+	}
+
+	return &profile, nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
 )
 
 type player struct {
@@ -11,7 +12,25 @@ type player struct {
 	Email         string               `db:"email"`
 	DisplayName   zeronull.Text        `db:"display_name"`
 	EmailVerified bool                 `db:"email_verified"`
+	Admin         bool                 `db:"is_admin"`
 	Password      string               `db:"password"`
 	CreateTime    time.Time            `db:"create_time"`
 	UpdateTime    zeronull.Timestamptz `db:"update_time"`
 }
+
+type authorPack struct {
+	ID            int32           `db:"id"`
+	Name          string          `db:"name"`
+	CoverURL      zeronull.Text   `db:"cover_url"`
+	Language      entity.Language `db:"language"`
+	CreateTime    time.Time       `db:"create_time"`
+	RoundCount    zeronull.Int2   `db:"round_count"`
+	TopicCount    zeronull.Int2   `db:"topic_count"`
+	QuestionCount zeronull.Int2   `db:"question_count"`
+	VideoCount    zeronull.Int2   `db:"video_count"`
+	AudioCount    zeronull.Int2   `db:"audio_count"`
+	ImageCount    zeronull.Int2   `db:"image_count"`
+	PlayCount     int32           `db:"play_count"`
+	RatingSum     int64           `db:"rating_sum"`
+	RatingCount   int64           `db:"rating_count"`
+}
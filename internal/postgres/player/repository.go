@@ -2,7 +2,10 @@ package player
 
 import "github.com/ysomad/answersuck/internal/pkg/pgclient"
 
-const playerTable = "players"
+const (
+	playerTable        = "players"
+	BannedAuthorsTable = "banned_authors"
+)
 
 type Repository struct {
 	*pgclient.Client
@@ -0,0 +1,35 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// SaveBannedAuthor bans player from publishing packs, already published packs are not affected.
+func (r *Repository) SaveBannedAuthor(ctx context.Context, nickname, bannedBy string, banTime time.Time) error {
+	sql, args, err := r.Builder.
+		Insert(BannedAuthorsTable).
+		Columns("nickname, banned_by, create_time").
+		Values(nickname, bannedBy, banTime).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Pool.Exec(ctx, sql, args...); err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "banned_authors_nickname_fkey" {
+			return apperr.PlayerNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
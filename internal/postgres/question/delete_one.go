@@ -0,0 +1,67 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// DeleteOne deletes question with its answer if question is not used in any pack.
+func (r *Repository) DeleteOne(ctx context.Context, questionID int32) error {
+	txFunc := func(tx pgx.Tx) error {
+		// 1. Delete question
+		sql, args, err := r.Builder.
+			Delete(questionTable).
+			Where(squirrel.Eq{"id": questionID}).
+			Suffix("RETURNING answer_id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var answerID int32
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&answerID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.QuestionNotFound
+			}
+
+			return err
+		}
+
+		// 2. Delete answer of the question if other questions don't use it
+		sql, args, err = r.Builder.
+			Delete(answerTable).
+			Where(squirrel.And{
+				squirrel.Eq{"id": answerID},
+				squirrel.Expr("NOT EXISTS (SELECT 1 FROM "+questionTable+" WHERE answer_id = ?)", answerID),
+			}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, txFunc); err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "round_questions_question_id_fkey" {
+			return apperr.QuestionInUse
+		}
+
+		return fmt.Errorf("error deleting question: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/pgsearch"
+)
+
+// FullTextSearch returns at most limit questions found by full-text search query, most relevant first.
+// Only questions of the author or used in published public packs which are not hidden are searched,
+// questions of all languages are searched if lang is not specified.
+// Answers of found questions are not returned.
+func (r *Repository) FullTextSearch(
+	ctx context.Context, query string, lang entity.Language, author string, limit uint64) ([]entity.Question, error) {
+	b := r.Builder.
+		Select("q.id, q.text, q.author, q.media_url, q.language, q.create_time").
+		From(questionTable + " q").
+		Where(visibleTo(author)).
+		Limit(limit)
+
+	if lang != 0 {
+		conf := pgsearch.ConfByLang(lang)
+		b = pgsearch.Where(b.Where(squirrel.Eq{"q.language": lang}), query, conf)
+		b = pgsearch.OrderBy(b, query, conf, pgsearch.OrderDefault)
+	} else {
+		b = pgsearch.WhereByLang(b, query, "q.language")
+		b = pgsearch.OrderByLang(b, query, "q.language", pgsearch.OrderDefault)
+	}
+
+	sql, args, err := b.OrderBy("q.id").ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query: %w", err)
+	}
+
+	qq, err := pgx.CollectRows(rows, pgx.RowToStructByName[foundQuestion])
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	res := make([]entity.Question, len(qq))
+
+	for i, q := range qq {
+		res[i] = entity.Question{
+			ID:         q.ID,
+			Text:       q.Text,
+			Author:     q.Author,
+			MediaURL:   string(q.MediaURL),
+			Language:   q.Language,
+			CreateTime: q.CreateTime,
+		}
+	}
+
+	return res, nil
+}
+
+// visibleTo returns predicate of questions which are visible to the author in search results:
+// his own questions and questions used in published public packs which are not hidden.
+func visibleTo(author string) squirrel.Sqlizer {
+	return squirrel.Or{
+		squirrel.Eq{"q.author": author},
+		squirrel.Expr("EXISTS (SELECT 1 FROM "+roundQuestionTable+" rq "+
+			"INNER JOIN round_topics rt ON rq.round_topic_id = rt.id "+
+			"INNER JOIN rounds r ON rt.round_id = r.id "+
+			"INNER JOIN packs p ON r.pack_id = p.id "+
+			"WHERE rq.question_id = q.id AND p.is_published AND NOT p.is_hidden AND p.visibility = ?)", entity.PackVisibilityPublic),
+	}
+}
@@ -0,0 +1,53 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// GetAllUsages returns all places in packs where question is used.
+func (r *Repository) GetAllUsages(ctx context.Context, questionID int32) ([]entity.QuestionUsage, error) {
+	sql, args, err := r.Builder.
+		Select(
+			"rq.id as round_question_id",
+			"p.id as pack_id",
+			"p.name as pack_name",
+			"p.author as pack_author",
+			"r.id as round_id",
+			"r.name as round_name",
+			"t.id as topic_id",
+			"t.title as topic_title").
+		From(roundQuestionTable+" rq").
+		InnerJoin("round_topics rt ON rq.round_topic_id = rt.id").
+		InnerJoin("topics t ON rt.topic_id = t.id").
+		InnerJoin("rounds r ON rt.round_id = r.id").
+		InnerJoin("packs p ON r.pack_id = p.id").
+		Where(squirrel.Eq{"rq.question_id": questionID}).
+		OrderBy("p.id", "r.position", "rt.position", "rq.position").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query: %w", err)
+	}
+
+	usages, err := pgx.CollectRows(rows, pgx.RowToStructByName[questionUsage])
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	res := make([]entity.QuestionUsage, len(usages))
+
+	for i, u := range usages {
+		res[i] = entity.QuestionUsage(u)
+	}
+
+	return res, nil
+}
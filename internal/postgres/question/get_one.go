@@ -18,12 +18,22 @@ func (r *Repository) GetOne(ctx context.Context, questionID int32) (*entity.Ques
 			"q.text as text",
 			"q.author as author",
 			"q.media_url as media_url",
+			"m.type as media_type",
+			"q.media_start as media_start",
+			"q.media_end as media_end",
+			"q.language as language",
 			"q.create_time as create_time",
+			"(SELECT count(*) FROM "+roundQuestionTable+" rq WHERE rq.question_id = q.id)::int as usage_count",
 			"a.id as answer_id",
 			"a.text as answer",
-			"a.media_url as answer_media_url").
+			"a.media_url as answer_media_url",
+			"a.media_start as answer_media_start",
+			"a.media_end as answer_media_end",
+			"a.alternatives as answer_alternatives",
+			"a.wrong_variants as answer_wrong_variants").
 		From(questionTable + " q").
 		InnerJoin(answerTable + " a ON q.answer_id = a.id").
+		LeftJoin(mediaTable + " m ON q.media_url = m.url").
 		Where(squirrel.Eq{"q.id": questionID}).
 		ToSql()
 	if err != nil {
@@ -44,16 +54,80 @@ func (r *Repository) GetOne(ctx context.Context, questionID int32) (*entity.Ques
 		return nil, fmt.Errorf("pgx.CollectOneRow: %w", err)
 	}
 
+	parts, err := r.getParts(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting question parts: %w", err)
+	}
+
+	// question without parts is shown as text and optional media
+	if len(parts) == 0 {
+		parts = append(parts, entity.QuestionPart{
+			Type: entity.QPartTypeText,
+			Text: q.Text,
+		})
+
+		if q.MediaURL != "" {
+			parts = append(parts, entity.QuestionPart{
+				Type:      entity.NewQuestionPartType(entity.MediaType(q.MediaType)),
+				MediaURL:  string(q.MediaURL),
+				MediaClip: entity.MediaClip{Start: q.MediaStart, End: q.MediaEnd},
+			})
+		}
+	}
+
 	return &entity.Question{
 		ID:   q.ID,
 		Text: q.Text,
 		Answer: entity.Answer{
-			ID:       q.AnswerID,
-			Text:     q.Answer,
-			MediaURL: string(q.AnswerMediaURL),
+			ID:            q.AnswerID,
+			Text:          q.Answer,
+			MediaURL:      string(q.AnswerMediaURL),
+			MediaClip:     entity.MediaClip{Start: q.AnswerMediaStart, End: q.AnswerMediaEnd},
+			Alternatives:  q.AnswerAlternatives,
+			WrongVariants: q.AnswerWrongVariants,
 		},
 		Author:     q.Author,
 		MediaURL:   string(q.MediaURL),
+		MediaClip:  entity.MediaClip{Start: q.MediaStart, End: q.MediaEnd},
+		Parts:      parts,
+		Language:   q.Language,
 		CreateTime: q.CreateTime,
+		UsageCount: q.UsageCount,
 	}, nil
 }
+
+func (r *Repository) getParts(ctx context.Context, questionID int32) ([]entity.QuestionPart, error) {
+	sql, args, err := r.Builder.
+		Select("type, text, media_url, media_start, media_end, duration").
+		From(questionPartTable).
+		Where(squirrel.Eq{"question_id": questionID}).
+		OrderBy("position").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	pp, err := pgx.CollectRows(rows, pgx.RowToStructByName[questionPart])
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]entity.QuestionPart, len(pp))
+
+	for i, p := range pp {
+		parts[i] = entity.QuestionPart{
+			Type:      p.Type,
+			Text:      string(p.Text),
+			MediaURL:  string(p.MediaURL),
+			MediaClip: entity.MediaClip{Start: p.MediaStart, End: p.MediaEnd},
+			Duration:  p.Duration,
+		}
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,62 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+const (
+	// Minimal pg_trgm similarity of question texts to consider them duplicates.
+	minTextSimilarity = 0.6
+
+	// Minimal pg_trgm similarity of answer texts to consider questions duplicates.
+	minAnswerSimilarity = 0.5
+)
+
+// GetSimilar returns at most limit questions with text and answer similar to given ones,
+// most similar questions go first. Only questions visible to the author are returned,
+// the same as in FullTextSearch.
+func (r *Repository) GetSimilar(
+	ctx context.Context, text, answer, author string, limit uint64) ([]entity.SimilarQuestion, error) {
+	sql, args, err := r.Builder.
+		Select(
+			"q.id as id",
+			"q.text as text").
+		Column(squirrel.Expr("similarity(q.text, ?) as similarity", text)).
+		From(questionTable+" q").
+		InnerJoin(answerTable+" a ON q.answer_id = a.id").
+		Where(squirrel.And{
+			squirrel.Expr("q.text % ?", text),
+			squirrel.Expr("similarity(q.text, ?) >= ?", text, minTextSimilarity),
+			squirrel.Expr("similarity(a.text, ?) >= ?", answer, minAnswerSimilarity),
+			visibleTo(author),
+		}).
+		OrderBy("similarity DESC", "q.id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query: %w", err)
+	}
+
+	qq, err := pgx.CollectRows(rows, pgx.RowToStructByName[similarQuestion])
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	res := make([]entity.SimilarQuestion, len(qq))
+
+	for i, q := range qq {
+		res[i] = entity.SimilarQuestion(q)
+	}
+
+	return res, nil
+}
@@ -4,15 +4,62 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
 )
 
 type question struct {
-	ID             int32         `db:"id"`
-	Text           string        `db:"text"`
-	Author         string        `db:"author"`
-	MediaURL       zeronull.Text `db:"media_url"`
-	CreateTime     time.Time     `db:"create_time"`
-	AnswerID       int32         `db:"answer_id"`
-	Answer         string        `db:"answer"`
-	AnswerMediaURL zeronull.Text `db:"answer_media_url"`
+	ID             int32           `db:"id"`
+	Text           string          `db:"text"`
+	Author         string          `db:"author"`
+	MediaURL       zeronull.Text   `db:"media_url"`
+	MediaType      zeronull.Int2   `db:"media_type"`
+	MediaStart     time.Duration   `db:"media_start"`
+	MediaEnd       time.Duration   `db:"media_end"`
+	Language       entity.Language `db:"language"`
+	CreateTime     time.Time       `db:"create_time"`
+	UsageCount     int32           `db:"usage_count"`
+	AnswerID       int32           `db:"answer_id"`
+	Answer         string          `db:"answer"`
+	AnswerMediaURL zeronull.Text   `db:"answer_media_url"`
+
+	AnswerMediaStart time.Duration `db:"answer_media_start"`
+	AnswerMediaEnd   time.Duration `db:"answer_media_end"`
+
+	AnswerAlternatives  []string `db:"answer_alternatives"`
+	AnswerWrongVariants []string `db:"answer_wrong_variants"`
+}
+
+type foundQuestion struct {
+	ID         int32           `db:"id"`
+	Text       string          `db:"text"`
+	Author     string          `db:"author"`
+	MediaURL   zeronull.Text   `db:"media_url"`
+	Language   entity.Language `db:"language"`
+	CreateTime time.Time       `db:"create_time"`
+}
+
+type questionUsage struct {
+	RoundQuestionID int32  `db:"round_question_id"`
+	PackID          int32  `db:"pack_id"`
+	PackName        string `db:"pack_name"`
+	PackAuthor      string `db:"pack_author"`
+	RoundID         int32  `db:"round_id"`
+	RoundName       string `db:"round_name"`
+	TopicID         int32  `db:"topic_id"`
+	TopicTitle      string `db:"topic_title"`
+}
+
+type similarQuestion struct {
+	ID         int32   `db:"id"`
+	Text       string  `db:"text"`
+	Similarity float32 `db:"similarity"`
+}
+
+type questionPart struct {
+	Type       entity.QuestionPartType `db:"type"`
+	Text       zeronull.Text           `db:"text"`
+	MediaURL   zeronull.Text           `db:"media_url"`
+	MediaStart time.Duration           `db:"media_start"`
+	MediaEnd   time.Duration           `db:"media_end"`
+	Duration   time.Duration           `db:"duration"`
 }
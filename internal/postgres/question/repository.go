@@ -5,6 +5,10 @@ import "github.com/ysomad/answersuck/internal/pkg/pgclient"
 const (
 	questionTable = "questions"
 	answerTable   = "answers"
+
+	questionPartTable  = "question_parts"
+	roundQuestionTable = "round_questions"
+	mediaTable         = "media"
 )
 
 type Repository struct {
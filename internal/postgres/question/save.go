@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
@@ -14,10 +15,20 @@ import (
 
 func (r *Repository) Save(ctx context.Context, q *entity.Question) (questionID int32, err error) {
 	txFunc := func(tx pgx.Tx) error {
+		if err := r.checkMedia(ctx, tx, q); err != nil {
+			return err
+		}
+
 		sql, args, err := r.Builder.
 			Insert(answerTable).
-			Columns("text, media_url").
-			Values(q.Answer.Text, zeronull.Text(q.Answer.MediaURL)).
+			Columns("text, media_url, media_start, media_end, alternatives, wrong_variants").
+			Values(
+				q.Answer.Text,
+				zeronull.Text(q.Answer.MediaURL),
+				q.Answer.MediaClip.Start,
+				q.Answer.MediaClip.End,
+				nonNilStrings(q.Answer.Alternatives),
+				nonNilStrings(q.Answer.WrongVariants)).
 			Suffix("RETURNING id").
 			ToSql()
 		if err != nil {
@@ -30,8 +41,16 @@ func (r *Repository) Save(ctx context.Context, q *entity.Question) (questionID i
 
 		sql, args, err = r.Builder.
 			Insert(questionTable).
-			Columns("text, answer_id, author, media_url, create_time").
-			Values(q.Text, q.Answer.ID, q.Author, zeronull.Text(q.MediaURL), q.CreateTime).
+			Columns("text, answer_id, author, media_url, media_start, media_end, language, create_time").
+			Values(
+				q.Text,
+				q.Answer.ID,
+				q.Author,
+				zeronull.Text(q.CompatMediaURL()),
+				q.CompatMediaClip().Start,
+				q.CompatMediaClip().End,
+				q.Language,
+				q.CreateTime).
 			Suffix("RETURNING id").
 			ToSql()
 		if err != nil {
@@ -42,15 +61,17 @@ func (r *Repository) Save(ctx context.Context, q *entity.Question) (questionID i
 			return fmt.Errorf("error saving question: %w", err)
 		}
 
+		if err := r.insertParts(ctx, tx, q.ID, q.Parts); err != nil {
+			return fmt.Errorf("error saving question parts: %w", err)
+		}
+
 		return nil
 	}
 
 	if err := pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, txFunc); err != nil {
 		var pgErr *pgconn.PgError
 
-		if errors.As(err, &pgErr) &&
-			pgErr.ConstraintName == "questions_media_url_fkey" ||
-			pgErr.ConstraintName == "answers_media_url_fkey" {
+		if errors.As(err, &pgErr) && isMediaFKey(pgErr.ConstraintName) {
 			return 0, apperr.MediaNotFound
 		}
 
@@ -59,3 +80,151 @@ func (r *Repository) Save(ctx context.Context, q *entity.Question) (questionID i
 
 	return q.ID, nil
 }
+
+func isMediaFKey(constraint string) bool {
+	switch constraint {
+	case "questions_media_url_fkey", "answers_media_url_fkey", "question_parts_media_url_fkey":
+		return true
+	}
+
+	return false
+}
+
+// checkMedia checks media of question, its answer and parts.
+// Returns apperr.MediaNotFound if media doesn't exist, apperr.QuestionPartMismatch if part type
+// differs from type of its media and apperr.MediaClipInvalid if media clip is set for image or
+// doesn't fit into known media duration.
+func (r *Repository) checkMedia(ctx context.Context, tx pgx.Tx, q *entity.Question) error {
+	urls := make([]string, 0, len(q.Parts)+2)
+
+	if q.MediaURL != "" {
+		urls = append(urls, q.MediaURL)
+	}
+
+	if q.Answer.MediaURL != "" {
+		urls = append(urls, q.Answer.MediaURL)
+	}
+
+	for _, p := range q.Parts {
+		if p.MediaURL != "" {
+			urls = append(urls, p.MediaURL)
+		}
+	}
+
+	if len(urls) == 0 {
+		return nil
+	}
+
+	// 1. Get media
+	sql, args, err := r.Builder.
+		Select("url, type, duration").
+		From(mediaTable).
+		Where(squirrel.Eq{"url": urls}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	media := make(map[string]entity.Media, len(urls))
+
+	var m entity.Media
+
+	_, err = pgx.ForEachRow(rows, []any{&m.URL, &m.Type, &m.Duration}, func() error {
+		media[m.URL] = m
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 2. Check media clips and types
+	checkClip := func(url string, clip entity.MediaClip) (entity.Media, error) {
+		m, ok := media[url]
+		if !ok {
+			return entity.Media{}, apperr.MediaNotFound
+		}
+
+		if !clip.IsZero() && (m.Type == entity.MediaTypeImage || !clip.Fits(m.Duration)) {
+			return entity.Media{}, apperr.MediaClipInvalid
+		}
+
+		return m, nil
+	}
+
+	if q.MediaURL != "" {
+		if _, err := checkClip(q.MediaURL, q.MediaClip); err != nil {
+			return err
+		}
+	}
+
+	if q.Answer.MediaURL != "" {
+		if _, err := checkClip(q.Answer.MediaURL, q.Answer.MediaClip); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range q.Parts {
+		if p.MediaURL == "" {
+			continue
+		}
+
+		m, err := checkClip(p.MediaURL, p.MediaClip)
+		if err != nil {
+			return err
+		}
+
+		if m.Type != p.Type.MediaType() {
+			return apperr.QuestionPartMismatch
+		}
+	}
+
+	return nil
+}
+
+// insertParts saves question parts in order of the slice.
+func (r *Repository) insertParts(ctx context.Context, tx pgx.Tx, questionID int32, parts []entity.QuestionPart) error {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	b := r.Builder.
+		Insert(questionPartTable).
+		Columns("question_id, position, type, text, media_url, media_start, media_end, duration")
+
+	for i, p := range parts {
+		b = b.Values(
+			questionID,
+			i+1,
+			p.Type,
+			zeronull.Text(p.Text),
+			zeronull.Text(p.MediaURL),
+			p.MediaClip.Start,
+			p.MediaClip.End,
+			p.Duration)
+	}
+
+	sql, args, err := b.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// nonNilStrings returns empty slice instead of nil since pgx encodes nil slice as NULL.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
@@ -0,0 +1,211 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// UpdateOne updates question and its answer if question is not used in any published pack.
+func (r *Repository) UpdateOne(ctx context.Context, q *entity.Question) error {
+	txFunc := func(tx pgx.Tx) error {
+		// 1. Lock question
+		sql, args, err := r.Builder.
+			Select("answer_id").
+			From(questionTable).
+			Where(squirrel.Eq{"id": q.ID}).
+			Suffix("FOR UPDATE").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&q.Answer.ID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.QuestionNotFound
+			}
+
+			return err
+		}
+
+		// 2. Check question is not published
+		published, err := r.isPublished(ctx, tx, q.ID)
+		if err != nil {
+			return err
+		}
+
+		if published {
+			return apperr.QuestionPublished
+		}
+
+		// 3. Check media
+		if err := r.checkMedia(ctx, tx, q); err != nil {
+			return err
+		}
+
+		// 4. Save answer, shared answer is copied to not affect other questions
+		shared, err := r.isAnswerShared(ctx, tx, q.Answer.ID, q.ID)
+		if err != nil {
+			return err
+		}
+
+		if shared {
+			sql, args, err = r.Builder.
+				Insert(answerTable).
+				Columns("text, media_url, media_start, media_end, alternatives, wrong_variants").
+				Values(
+					q.Answer.Text,
+					zeronull.Text(q.Answer.MediaURL),
+					q.Answer.MediaClip.Start,
+					q.Answer.MediaClip.End,
+					nonNilStrings(q.Answer.Alternatives),
+					nonNilStrings(q.Answer.WrongVariants)).
+				Suffix("RETURNING id").
+				ToSql()
+			if err != nil {
+				return err
+			}
+
+			if err := tx.QueryRow(ctx, sql, args...).Scan(&q.Answer.ID); err != nil {
+				return err
+			}
+		} else {
+			sql, args, err = r.Builder.
+				Update(answerTable).
+				SetMap(map[string]interface{}{
+					"text":           q.Answer.Text,
+					"media_url":      zeronull.Text(q.Answer.MediaURL),
+					"media_start":    q.Answer.MediaClip.Start,
+					"media_end":      q.Answer.MediaClip.End,
+					"alternatives":   nonNilStrings(q.Answer.Alternatives),
+					"wrong_variants": nonNilStrings(q.Answer.WrongVariants),
+				}).
+				Where(squirrel.Eq{"id": q.Answer.ID}).
+				ToSql()
+			if err != nil {
+				return err
+			}
+
+			if _, err := tx.Exec(ctx, sql, args...); err != nil {
+				return err
+			}
+		}
+
+		// 5. Update question, language is not changed if not specified
+		values := map[string]interface{}{
+			"text":        q.Text,
+			"media_url":   zeronull.Text(q.CompatMediaURL()),
+			"media_start": q.CompatMediaClip().Start,
+			"media_end":   q.CompatMediaClip().End,
+			"answer_id":   q.Answer.ID,
+		}
+
+		if q.Language != 0 {
+			values["language"] = q.Language
+		}
+
+		sql, args, err = r.Builder.
+			Update(questionTable).
+			SetMap(values).
+			Where(squirrel.Eq{"id": q.ID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		// 6. Replace question parts
+		sql, args, err = r.Builder.
+			Delete(questionPartTable).
+			Where(squirrel.Eq{"question_id": q.ID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		if err := r.insertParts(ctx, tx, q.ID, q.Parts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, txFunc); err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && isMediaFKey(pgErr.ConstraintName) {
+			return apperr.MediaNotFound
+		}
+
+		return fmt.Errorf("error updating question: %w", err)
+	}
+
+	return nil
+}
+
+// isPublished reports whether question is used in any published pack.
+func (r *Repository) isPublished(ctx context.Context, tx pgx.Tx, questionID int32) (bool, error) {
+	sql, args, err := r.Builder.
+		Select("rq.id").
+		From(roundQuestionTable + " rq").
+		InnerJoin("round_topics rt ON rq.round_topic_id = rt.id").
+		InnerJoin("rounds r ON rt.round_id = r.id").
+		InnerJoin("packs p ON r.pack_id = p.id").
+		Where(squirrel.And{
+			squirrel.Eq{"rq.question_id": questionID},
+			squirrel.Eq{"p.is_published": true},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var published bool
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&published); err != nil {
+		return false, err
+	}
+
+	return published, nil
+}
+
+// isAnswerShared reports whether answer is used by questions other than exceptQuestionID.
+func (r *Repository) isAnswerShared(ctx context.Context, tx pgx.Tx, answerID, exceptQuestionID int32) (bool, error) {
+	sql, args, err := r.Builder.
+		Select("id").
+		From(questionTable).
+		Where(squirrel.And{
+			squirrel.Eq{"answer_id": answerID},
+			squirrel.NotEq{"id": exceptQuestionID},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var shared bool
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&shared); err != nil {
+		return false, err
+	}
+
+	return shared, nil
+}
@@ -0,0 +1,59 @@
+package report
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+)
+
+// GetAll returns reports with provided status, oldest first.
+// Reports with any status are returned if status is not specified.
+func (r *Repository) GetAll(ctx context.Context, status entity.ReportStatus, p paging.Params) (paging.List[entity.Report], error) {
+	limit, offset, err := paging.OffsetToken(p.PageToken).Decode()
+	if err != nil {
+		return paging.List[entity.Report]{}, err
+	}
+
+	// use limit from params only if token has no limit
+	if limit == 0 {
+		limit = uint64(p.PageSize)
+	}
+
+	b := r.Builder.
+		Select("id, pack_id, question_id, pack_name, question_text, reason, reporter, status, resolved_by, create_time, resolve_time").
+		From(reportsTable).
+		OrderBy("create_time", "id").
+		Limit(limit + 1).
+		Offset(offset)
+
+	if status != 0 {
+		b = b.Where(squirrel.Eq{"status": status})
+	}
+
+	sql, args, err := b.ToSql()
+	if err != nil {
+		return paging.List[entity.Report]{}, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return paging.List[entity.Report]{}, fmt.Errorf("r.Pool.Query: %w", err)
+	}
+
+	rr, err := pgx.CollectRows(rows, pgx.RowToStructByName[report])
+	if err != nil {
+		return paging.List[entity.Report]{}, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	reports := make([]entity.Report, len(rr))
+
+	for i, rep := range rr {
+		reports[i] = newReport(rep)
+	}
+
+	return paging.NewListWithOffset(reports, limit, offset)
+}
@@ -0,0 +1,40 @@
+package report
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) GetOne(ctx context.Context, reportID int32) (*entity.Report, error) {
+	sql, args, err := r.Builder.
+		Select("id, pack_id, question_id, pack_name, question_text, reason, reporter, status, resolved_by, create_time, resolve_time").
+		From(reportsTable).
+		Where(squirrel.Eq{"id": reportID}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rep, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[report])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.ReportNotFound
+		}
+
+		return nil, err
+	}
+
+	res := newReport(rep)
+
+	return &res, nil
+}
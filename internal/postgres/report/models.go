@@ -0,0 +1,38 @@
+package report
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+type report struct {
+	ID           int32                `db:"id"`
+	PackID       zeronull.Int4        `db:"pack_id"`
+	QuestionID   zeronull.Int4        `db:"question_id"`
+	PackName     zeronull.Text        `db:"pack_name"`
+	QuestionText zeronull.Text        `db:"question_text"`
+	Reason       string               `db:"reason"`
+	Reporter     string               `db:"reporter"`
+	Status       entity.ReportStatus  `db:"status"`
+	ResolvedBy   zeronull.Text        `db:"resolved_by"`
+	CreateTime   time.Time            `db:"create_time"`
+	ResolveTime  zeronull.Timestamptz `db:"resolve_time"`
+}
+
+func newReport(r report) entity.Report {
+	return entity.Report{
+		ID:           r.ID,
+		PackID:       int32(r.PackID),
+		QuestionID:   int32(r.QuestionID),
+		PackName:     string(r.PackName),
+		QuestionText: string(r.QuestionText),
+		Reason:       r.Reason,
+		Reporter:     r.Reporter,
+		Status:       r.Status,
+		ResolvedBy:   string(r.ResolvedBy),
+		CreateTime:   r.CreateTime,
+		ResolveTime:  time.Time(r.ResolveTime),
+	}
+}
@@ -0,0 +1,13 @@
+package report
+
+import "github.com/ysomad/answersuck/internal/pkg/pgclient"
+
+const reportsTable = "reports"
+
+type Repository struct {
+	*pgclient.Client
+}
+
+func NewRepository(c *pgclient.Client) *Repository {
+	return &Repository{c}
+}
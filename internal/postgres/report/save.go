@@ -0,0 +1,64 @@
+package report
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Save saves report together with reported pack name or question text,
+// so content stays reviewable after it's deleted.
+func (r *Repository) Save(ctx context.Context, rep entity.Report) (int32, error) {
+	var (
+		content     squirrel.SelectBuilder
+		columns     string
+		errNotFound error
+	)
+
+	if rep.PackID != 0 {
+		columns = "pack_id, pack_name"
+		errNotFound = apperr.PackNotFound
+		content = r.Builder.
+			Select("id, name").
+			From("packs").
+			Where(squirrel.Eq{"id": rep.PackID})
+	} else {
+		columns = "question_id, question_text"
+		errNotFound = apperr.QuestionNotFound
+		content = r.Builder.
+			Select("id, text").
+			From("questions").
+			Where(squirrel.Eq{"id": rep.QuestionID})
+	}
+
+	sql, args, err := r.Builder.
+		Insert(reportsTable).
+		Columns(columns, "reason, reporter, status, create_time").
+		Select(content.
+			// types are specified since they're not inferred from insert columns in select
+			Column(squirrel.Expr("?::varchar", rep.Reason)).
+			Column(squirrel.Expr("?::varchar", rep.Reporter)).
+			Column(squirrel.Expr("?::smallint", rep.Status)).
+			Column(squirrel.Expr("?::timestamptz", rep.CreateTime))).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var reportID int32
+
+	if err := r.Pool.QueryRow(ctx, sql, args...).Scan(&reportID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errNotFound
+		}
+
+		return 0, err
+	}
+
+	return reportID, nil
+}
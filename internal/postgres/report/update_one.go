@@ -0,0 +1,37 @@
+package report
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgtype/zeronull"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// UpdateOne updates status of the report.
+func (r *Repository) UpdateOne(ctx context.Context, rep *entity.Report) error {
+	sql, args, err := r.Builder.
+		Update(reportsTable).
+		SetMap(map[string]any{
+			"status":       rep.Status,
+			"resolved_by":  zeronull.Text(rep.ResolvedBy),
+			"resolve_time": zeronull.Timestamptz(rep.ResolveTime),
+		}).
+		Where(squirrel.Eq{"id": rep.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if ct.RowsAffected() == 0 {
+		return apperr.ReportNotFound
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ func (r *Repository) GetAll(ctx context.Context, packID int32) ([]entity.Round,
 		Select("id, name, pack_id, position").
 		From(RoundsTable).
 		Where(squirrel.Eq{"pack_id": packID}).
+		OrderBy("position").
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,39 @@
+package roundquestion
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// GetAll returns questions of the round ordered by topic and question positions.
+func (r *Repository) GetAll(ctx context.Context, roundID int32) ([]entity.RoundQuestionDetailed, error) {
+	sql, args, err := r.selectDetailed().
+		Where(squirrel.Eq{"rp.round_id": roundID}).
+		OrderBy("rp.position", "rq.position").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	qq, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[roundQuestion])
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]entity.RoundQuestionDetailed, len(qq))
+
+	for i, q := range qq {
+		res[i] = *newRoundQuestionDetailed(q)
+	}
+
+	return res, nil
+}
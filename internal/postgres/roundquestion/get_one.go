@@ -13,29 +13,7 @@ import (
 
 func (r *Repository) GetOne(
 	ctx context.Context, id int32) (*entity.RoundQuestionDetailed, error) {
-	sql, args, err := r.Builder.
-		Select(
-			"rq.id as id",
-			"rq.question_type as question_type",
-			"rq.cost as question_cost",
-			"rq.answer_time as answer_time",
-			"rq.host_comment as host_comment",
-			"rq.secret_topic as secret_topic",
-			"rq.secret_cost as secret_cost",
-			"rq.is_keepable as is_keepable",
-			"rq.transfer_type as transfer_type",
-			"rq.question_id as question_id",
-			"q.text as question",
-			"q.media_url as question_media_url",
-			"q.answer_id as answer_id",
-			"a.text as answer",
-			"a.media_url as answer_media_url",
-			"rp.round_id as round_id",
-			"rp.topic_id as topic_id").
-		From("round_questions rq").
-		InnerJoin("questions q ON rq.question_id = q.id").
-		InnerJoin("answers a ON q.answer_id = a.id").
-		InnerJoin("round_topics rp ON rq.round_topic_id = rp.id").
+	sql, args, err := r.selectDetailed().
 		Where(squirrel.Eq{"rq.id": id}).
 		ToSql()
 	if err != nil {
@@ -56,6 +34,46 @@ func (r *Repository) GetOne(
 		return nil, err
 	}
 
+	return newRoundQuestionDetailed(q), nil
+}
+
+// selectDetailed returns builder which selects round questions with question, answer and topic.
+func (r *Repository) selectDetailed() squirrel.SelectBuilder {
+	return r.Builder.
+		Select(
+			"rq.id as id",
+			"rq.question_type as question_type",
+			"rq.cost as question_cost",
+			"rq.answer_time as answer_time",
+			"rq.host_comment as host_comment",
+			"rq.secret_topic as secret_topic",
+			"rq.secret_cost as secret_cost",
+			"rq.secret_cost_min as secret_cost_min",
+			"rq.secret_cost_max as secret_cost_max",
+			"rq.secret_cost_step as secret_cost_step",
+			"rq.is_keepable as is_keepable",
+			"rq.transfer_type as transfer_type",
+			"rq.question_id as question_id",
+			"q.text as question",
+			"q.media_url as question_media_url",
+			"q.media_start as question_media_start",
+			"q.media_end as question_media_end",
+			"q.answer_id as answer_id",
+			"a.text as answer",
+			"a.media_url as answer_media_url",
+			"a.media_start as answer_media_start",
+			"a.media_end as answer_media_end",
+			"rp.round_id as round_id",
+			"rp.topic_id as topic_id",
+			"t.title as topic_title").
+		From(RoundQuestionsTable + " rq").
+		InnerJoin("questions q ON rq.question_id = q.id").
+		InnerJoin("answers a ON q.answer_id = a.id").
+		InnerJoin(roundTopicsTable + " rp ON rq.round_topic_id = rp.id").
+		InnerJoin("topics t ON rp.topic_id = t.id")
+}
+
+func newRoundQuestionDetailed(q *roundQuestion) *entity.RoundQuestionDetailed {
 	return &entity.RoundQuestionDetailed{
 		RoundQuestion: entity.RoundQuestion{
 			ID:           q.ID,
@@ -70,11 +88,19 @@ func (r *Repository) GetOne(
 			SecretCost:   int32(q.SecretCost),
 			Keepable:     q.Keepable.Bool,
 			TransferType: entity.QuestionTransferType(q.TransferType),
+			SecretCostRange: entity.SecretCostRange{
+				Min:  int32(q.SecretMin),
+				Max:  int32(q.SecretMax),
+				Step: int32(q.SecretStep),
+			},
 		},
-		Question:         q.Question,
-		QuestionMediaURL: string(q.QuestionMediaURL),
-		AnswerID:         q.AnswerID,
-		Answer:           q.Answer,
-		AnswerMediaURL:   string(q.AnswerMediaURL),
-	}, nil
+		Topic:             q.Topic,
+		Question:          q.Question,
+		QuestionMediaURL:  string(q.QuestionMediaURL),
+		QuestionMediaClip: entity.MediaClip{Start: q.QuestionMediaStart, End: q.QuestionMediaEnd},
+		AnswerID:          q.AnswerID,
+		Answer:            q.Answer,
+		AnswerMediaURL:    string(q.AnswerMediaURL),
+		AnswerMediaClip:   entity.MediaClip{Start: q.AnswerMediaStart, End: q.AnswerMediaEnd},
+	}
 }
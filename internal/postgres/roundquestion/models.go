@@ -19,14 +19,25 @@ type roundQuestion struct {
 	HostComment  zeronull.Text       `db:"host_comment"`
 	SecretTopic  zeronull.Text       `db:"secret_topic"`
 	SecretCost   zeronull.Int2       `db:"secret_cost"`
+	SecretMin    zeronull.Int2       `db:"secret_cost_min"`
+	SecretMax    zeronull.Int2       `db:"secret_cost_max"`
+	SecretStep   zeronull.Int2       `db:"secret_cost_step"`
 	Keepable     pgtype.Bool         `db:"is_keepable"`
 	TransferType zeronull.Int2       `db:"transfer_type"`
 
+	Topic string `db:"topic_title"`
+
 	QuestionID       int32         `db:"question_id"`
 	Question         string        `db:"question"`
 	QuestionMediaURL zeronull.Text `db:"question_media_url"`
 
+	QuestionMediaStart time.Duration `db:"question_media_start"`
+	QuestionMediaEnd   time.Duration `db:"question_media_end"`
+
 	AnswerID       int32         `db:"answer_id"`
 	Answer         string        `db:"answer"`
 	AnswerMediaURL zeronull.Text `db:"answer_media_url"`
+
+	AnswerMediaStart time.Duration `db:"answer_media_start"`
+	AnswerMediaEnd   time.Duration `db:"answer_media_end"`
 }
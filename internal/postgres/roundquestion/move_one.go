@@ -0,0 +1,233 @@
+package roundquestion
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// MoveOne moves round question to position in topic of destination round
+// shifting positions of other questions. If position is greater than amount of questions
+// in destination topic, question is moved to the end.
+func (r *Repository) MoveOne(ctx context.Context, id, dstRoundID, dstTopicID int32, pos int16) error {
+	return pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// 1. Get current question position
+		sql, args, err := r.Builder.
+			Select("round_topic_id, question_id, position").
+			From(RoundQuestionsTable).
+			Where(squirrel.Eq{"id": id}).
+			Suffix("FOR UPDATE").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var (
+			roundTopicID int32
+			questionID   int32
+			oldPos       int16
+		)
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&roundTopicID, &questionID, &oldPos); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.RoundQuestionNotFound
+			}
+
+			return err
+		}
+
+		// 2. Check question is not added to destination pack yet
+		if err := r.checkNotInPack(ctx, tx, questionID, dstRoundID, id); err != nil {
+			return err
+		}
+
+		// 3. Get destination round topic
+		sql, args, err = r.Builder.
+			Select("id").
+			From(roundTopicsTable).
+			Where(squirrel.And{
+				squirrel.Eq{"round_id": dstRoundID},
+				squirrel.Eq{"topic_id": dstTopicID},
+			}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var dstRoundTopicID int32
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&dstRoundTopicID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.RoundTopicNotFound
+			}
+
+			return err
+		}
+
+		// 4. Lock source and destination round topics
+		if err := r.lockRoundTopics(ctx, tx, roundTopicID, dstRoundTopicID); err != nil {
+			return err
+		}
+
+		// 5. Check source and destination packs are not published
+		published, err := r.isPublished(ctx, tx, roundTopicID, dstRoundTopicID)
+		if err != nil {
+			return err
+		}
+
+		if published {
+			return apperr.PackPublished
+		}
+
+		// 6. Shift positions of other questions
+		questionCount, err := r.countQuestions(ctx, tx, dstRoundTopicID)
+		if err != nil {
+			return err
+		}
+
+		if roundTopicID == dstRoundTopicID {
+			pos = min(pos, questionCount)
+
+			switch {
+			case pos < oldPos:
+				err = r.shiftPositions(ctx, tx, roundTopicID, 1, squirrel.And{
+					squirrel.GtOrEq{"position": pos},
+					squirrel.Lt{"position": oldPos},
+				})
+			case pos > oldPos:
+				err = r.shiftPositions(ctx, tx, roundTopicID, -1, squirrel.And{
+					squirrel.Gt{"position": oldPos},
+					squirrel.LtOrEq{"position": pos},
+				})
+			}
+
+			if err != nil {
+				return err
+			}
+		} else {
+			if questionCount >= entity.MaxTopicQuestions {
+				return apperr.RoundQuestionNotMoved
+			}
+
+			pos = min(pos, questionCount+1)
+
+			if err = r.shiftPositions(ctx, tx, roundTopicID, -1, squirrel.Gt{"position": oldPos}); err != nil {
+				return err
+			}
+
+			if err = r.shiftPositions(ctx, tx, dstRoundTopicID, 1, squirrel.GtOrEq{"position": pos}); err != nil {
+				return err
+			}
+		}
+
+		// 7. Move question
+		sql, args, err = r.Builder.
+			Update(RoundQuestionsTable).
+			SetMap(map[string]interface{}{
+				"round_topic_id": dstRoundTopicID,
+				"position":       pos,
+			}).
+			Where(squirrel.Eq{"id": id}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) lockRoundTopics(ctx context.Context, tx pgx.Tx, roundTopicIDs ...int32) error {
+	sql, args, err := r.Builder.
+		Select("id").
+		From(roundTopicsTable).
+		Where(squirrel.Eq{"id": roundTopicIDs}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isPublished reports whether pack of any of the round topics is published.
+func (r *Repository) isPublished(ctx context.Context, tx pgx.Tx, roundTopicIDs ...int32) (bool, error) {
+	sql, args, err := r.Builder.
+		Select("rt.id").
+		From(roundTopicsTable + " rt").
+		InnerJoin("rounds r ON rt.round_id = r.id").
+		InnerJoin("packs p ON r.pack_id = p.id").
+		Where(squirrel.And{
+			squirrel.Eq{"rt.id": roundTopicIDs},
+			squirrel.Eq{"p.is_published": true},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var published bool
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&published); err != nil {
+		return false, err
+	}
+
+	return published, nil
+}
+
+func (r *Repository) countQuestions(ctx context.Context, tx pgx.Tx, roundTopicID int32) (int16, error) {
+	sql, args, err := r.Builder.
+		Select("count(*)").
+		From(RoundQuestionsTable).
+		Where(squirrel.Eq{"round_topic_id": roundTopicID}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int16
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// shiftPositions adds delta to positions of round topic questions matching pred.
+func (r *Repository) shiftPositions(
+	ctx context.Context, tx pgx.Tx, roundTopicID int32, delta int16, pred squirrel.Sqlizer) error {
+	sql, args, err := r.Builder.
+		Update(RoundQuestionsTable).
+		Set("position", squirrel.Expr("position + ?", delta)).
+		Where(squirrel.And{
+			squirrel.Eq{"round_topic_id": roundTopicID},
+			pred,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,7 +2,12 @@ package roundquestion
 
 import "github.com/ysomad/answersuck/internal/pkg/pgclient"
 
-const RoundQuestionsTable = "round_questions"
+const (
+	RoundQuestionsTable = "round_questions"
+	roundTopicsTable    = "round_topics"
+	roundsTable         = "rounds"
+	packsTable          = "packs"
+)
 
 type Repository struct {
 	*pgclient.Client
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype/zeronull"
 	"github.com/ysomad/answersuck/internal/entity"
@@ -12,52 +14,72 @@ import (
 )
 
 func (r *Repository) Save(ctx context.Context, q *entity.RoundQuestion) (int32, error) {
-	sql, args, err := r.Builder.
-		Insert(RoundQuestionsTable).
-		Columns(
-			"round_id",
-			"topic_id",
-			"question_id",
-			"question_type",
-			"cost",
-			"answer_time",
-			"host_comment",
-			"secret_topic",
-			"secret_cost",
-			"transfer_type",
-			"is_keepable",
-		).
-		Values(
-			q.RoundID,
-			q.TopicID,
-			q.QuestionID,
-			q.Type,
-			q.Cost,
-			q.AnswerTime,
-			zeronull.Text(q.HostComment),
-			zeronull.Text(q.SecretTopic),
-			zeronull.Int4(q.SecretCost),
-			zeronull.Int2(q.TransferType),
-			q.Keepable,
-		).
-		Suffix("RETURNING id").
-		ToSql()
-	if err != nil {
-		return 0, err
-	}
-
 	var id int32
 
-	if err := r.Pool.QueryRow(ctx, sql, args...).Scan(&id); err != nil {
+	txFunc := func(tx pgx.Tx) error {
+		// 1. Check question is not added to pack of the round yet
+		if err := r.checkNotInPack(ctx, tx, q.QuestionID, q.RoundID, 0); err != nil {
+			return err
+		}
+
+		// 2. Save round question
+		sql, args, err := r.Builder.
+			Insert(RoundQuestionsTable).
+			Columns(
+				"round_topic_id",
+				"question_id",
+				"position",
+				"question_type",
+				"cost",
+				"answer_time",
+				"host_comment",
+				"secret_topic",
+				"secret_cost",
+				"transfer_type",
+				"is_keepable",
+				"secret_cost_min",
+				"secret_cost_max",
+				"secret_cost_step",
+			).
+			Values(
+				squirrel.Expr(
+					"(SELECT id FROM "+roundTopicsTable+" WHERE round_id = ? AND topic_id = ?)",
+					q.RoundID, q.TopicID),
+				q.QuestionID,
+				squirrel.Expr(
+					"(SELECT COALESCE(MAX(rq.position), 0) + 1 FROM "+RoundQuestionsTable+" rq "+
+						"INNER JOIN "+roundTopicsTable+" rt ON rq.round_topic_id = rt.id "+
+						"WHERE rt.round_id = ? AND rt.topic_id = ?)",
+					q.RoundID, q.TopicID),
+				q.Type,
+				q.Cost,
+				q.AnswerTime,
+				zeronull.Text(q.HostComment),
+				zeronull.Text(q.SecretTopic),
+				zeronull.Int4(q.SecretCost),
+				zeronull.Int2(q.TransferType),
+				q.Keepable,
+				zeronull.Int2(q.SecretCostRange.Min),
+				zeronull.Int2(q.SecretCostRange.Max),
+				zeronull.Int2(q.SecretCostRange.Step),
+			).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, sql, args...).Scan(&id)
+	}
+
+	if err := pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, txFunc); err != nil {
 		var pgErr *pgconn.PgError
 
 		if errors.As(err, &pgErr) {
-			switch pgErr.ConstraintName {
-			case "round_questions_round_id_fkey":
-				return 0, apperr.RoundNotFound
-			case "round_questions_topic_id_fkey":
-				return 0, apperr.TopicNotFound
-			case "round_questions_question_id_fkey":
+			switch {
+			case pgErr.ColumnName == "round_topic_id":
+				return 0, apperr.RoundTopicNotFound
+			case pgErr.ConstraintName == "round_questions_question_id_fkey":
 				return 0, apperr.QuestionNotFound
 			}
 		}
@@ -67,3 +89,59 @@ func (r *Repository) Save(ctx context.Context, q *entity.RoundQuestion) (int32,
 
 	return id, nil
 }
+
+// checkNotInPack locks pack of the round and returns error if question is already used in it.
+// Round question with exceptID is not taken into account.
+func (r *Repository) checkNotInPack(ctx context.Context, tx pgx.Tx, questionID, roundID, exceptID int32) error {
+	// 1. Lock pack
+	sql, args, err := r.Builder.
+		Select("p.id").
+		From(packsTable + " p").
+		InnerJoin(roundsTable + " r ON r.pack_id = p.id").
+		Where(squirrel.Eq{"r.id": roundID}).
+		Suffix("FOR UPDATE OF p").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var packID int32
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&packID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.RoundNotFound
+		}
+
+		return err
+	}
+
+	// 2. Check question usage in pack
+	sql, args, err = r.Builder.
+		Select("rq.id").
+		From(RoundQuestionsTable + " rq").
+		InnerJoin(roundTopicsTable + " rt ON rq.round_topic_id = rt.id").
+		InnerJoin(roundsTable + " r ON rt.round_id = r.id").
+		Where(squirrel.And{
+			squirrel.Eq{"r.pack_id": packID},
+			squirrel.Eq{"rq.question_id": questionID},
+			squirrel.NotEq{"rq.id": exceptID},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+		return err
+	}
+
+	if exists {
+		return apperr.RoundQuestionInPack
+	}
+
+	return nil
+}
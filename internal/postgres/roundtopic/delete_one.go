@@ -2,31 +2,38 @@ package roundtopic
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
 )
 
 func (r *Repository) DeleteOne(ctx context.Context, roundID, topicID int32) error {
-	sql, args, err := r.Builder.
-		Delete(roundTopicsTable).
-		Where(squirrel.And{
-			squirrel.Eq{"round_id": roundID},
-			squirrel.Eq{"topic_id": topicID},
-		}).
-		ToSql()
-	if err != nil {
-		return err
-	}
+	return pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		sql, args, err := r.Builder.
+			Delete(roundTopicsTable).
+			Where(squirrel.And{
+				squirrel.Eq{"round_id": roundID},
+				squirrel.Eq{"topic_id": topicID},
+			}).
+			Suffix("RETURNING position").
+			ToSql()
+		if err != nil {
+			return err
+		}
 
-	ct, err := r.Pool.Exec(ctx, sql, args...)
-	if err != nil {
-		return err
-	}
+		var pos int16
 
-	if ct.RowsAffected() == 0 {
-		return apperr.RoundTopicNotDeleted
-	}
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&pos); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.RoundTopicNotDeleted
+			}
 
-	return nil
+			return err
+		}
+
+		// close the gap left by deleted topic
+		return r.shiftPositions(ctx, tx, roundID, -1, squirrel.Gt{"position": pos})
+	})
 }
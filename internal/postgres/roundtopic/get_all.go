@@ -19,6 +19,7 @@ func (r *Repository) GetAll(ctx context.Context, roundID int32) ([]entity.Topic,
 		From(roundTopicsTable + " rt").
 		InnerJoin(topic.TopicsTable + " t ON rt.topic_id = t.id").
 		Where(squirrel.Eq{"rt.round_id": roundID}).
+		OrderBy("rt.position").
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,225 @@
+package roundtopic
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/postgres/round"
+)
+
+// MoveOne moves topic to position in destination round shifting positions of other topics.
+// If position is greater than amount of topics in destination round, topic is moved to the end.
+func (r *Repository) MoveOne(ctx context.Context, roundID, topicID, dstRoundID int32, pos int16) error {
+	return pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// 1. Lock source and destination rounds
+		if err := r.lockRounds(ctx, tx, roundID, dstRoundID); err != nil {
+			return err
+		}
+
+		// 2. Check source and destination packs are not published
+		published, err := r.isPublished(ctx, tx, roundID, dstRoundID)
+		if err != nil {
+			return err
+		}
+
+		if published {
+			return apperr.PackPublished
+		}
+
+		// 3. Get current topic position
+		sql, args, err := r.Builder.
+			Select("id, position").
+			From(roundTopicsTable).
+			Where(squirrel.And{
+				squirrel.Eq{"round_id": roundID},
+				squirrel.Eq{"topic_id": topicID},
+			}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var (
+			roundTopicID int32
+			oldPos       int16
+		)
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&roundTopicID, &oldPos); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.RoundTopicNotFound
+			}
+
+			return err
+		}
+
+		// 4. Shift positions of other topics
+		topicCount, err := r.countTopics(ctx, tx, dstRoundID)
+		if err != nil {
+			return err
+		}
+
+		if roundID == dstRoundID {
+			pos = min(pos, topicCount)
+
+			switch {
+			case pos < oldPos:
+				err = r.shiftPositions(ctx, tx, roundID, 1, squirrel.And{
+					squirrel.GtOrEq{"position": pos},
+					squirrel.Lt{"position": oldPos},
+				})
+			case pos > oldPos:
+				err = r.shiftPositions(ctx, tx, roundID, -1, squirrel.And{
+					squirrel.Gt{"position": oldPos},
+					squirrel.LtOrEq{"position": pos},
+				})
+			}
+
+			if err != nil {
+				return err
+			}
+		} else {
+			if topicCount >= entity.MaxRoundTopics {
+				return apperr.RoundTopicNotAdded
+			}
+
+			pos = min(pos, topicCount+1)
+
+			if err = r.shiftPositions(ctx, tx, roundID, -1, squirrel.Gt{"position": oldPos}); err != nil {
+				return err
+			}
+
+			if err = r.shiftPositions(ctx, tx, dstRoundID, 1, squirrel.GtOrEq{"position": pos}); err != nil {
+				return err
+			}
+		}
+
+		// 5. Move topic
+		sql, args, err = r.Builder.
+			Update(roundTopicsTable).
+			SetMap(map[string]interface{}{
+				"round_id": dstRoundID,
+				"position": pos,
+			}).
+			Where(squirrel.Eq{"id": roundTopicID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			var pgErr *pgconn.PgError
+
+			if errors.As(err, &pgErr) && pgErr.ConstraintName == "round_topics_round_id_topic_id_key" {
+				return apperr.RoundTopicAlreadyExists
+			}
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) lockRounds(ctx context.Context, tx pgx.Tx, roundIDs ...int32) error {
+	sql, args, err := r.Builder.
+		Select("id").
+		From(round.RoundsTable).
+		Where(squirrel.Eq{"id": roundIDs}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	locked, err := pgx.CollectRows(rows, pgx.RowTo[int32])
+	if err != nil {
+		return err
+	}
+
+	for _, id := range roundIDs {
+		if !slices.Contains(locked, id) {
+			return apperr.RoundNotFound
+		}
+	}
+
+	return nil
+}
+
+// isPublished reports whether pack of any of the rounds is published.
+func (r *Repository) isPublished(ctx context.Context, tx pgx.Tx, roundIDs ...int32) (bool, error) {
+	sql, args, err := r.Builder.
+		Select("r.id").
+		From(round.RoundsTable + " r").
+		InnerJoin("packs p ON r.pack_id = p.id").
+		Where(squirrel.And{
+			squirrel.Eq{"r.id": roundIDs},
+			squirrel.Eq{"p.is_published": true},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var published bool
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&published); err != nil {
+		return false, err
+	}
+
+	return published, nil
+}
+
+func (r *Repository) countTopics(ctx context.Context, tx pgx.Tx, roundID int32) (int16, error) {
+	sql, args, err := r.Builder.
+		Select("count(*)").
+		From(roundTopicsTable).
+		Where(squirrel.Eq{"round_id": roundID}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int16
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// shiftPositions adds delta to positions of round topics matching pred.
+func (r *Repository) shiftPositions(
+	ctx context.Context, tx pgx.Tx, roundID int32, delta int16, pred squirrel.Sqlizer) error {
+	sql, args, err := r.Builder.
+		Update(roundTopicsTable).
+		Set("position", squirrel.Expr("position + ?", delta)).
+		Where(squirrel.And{
+			squirrel.Eq{"round_id": roundID},
+			pred,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
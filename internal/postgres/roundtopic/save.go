@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
 )
@@ -11,8 +12,9 @@ import (
 func (r *Repository) Save(ctx context.Context, roundID, topicID int32) (int32, error) {
 	sql, args, err := r.Builder.
 		Insert(roundTopicsTable).
-		Columns("round_id, topic_id").
-		Values(roundID, topicID).
+		Columns("round_id, topic_id, position").
+		Values(roundID, topicID, squirrel.Expr(
+			"(SELECT COALESCE(MAX(position), 0) + 1 FROM "+roundTopicsTable+" WHERE round_id = ?)", roundID)).
 		Suffix("RETURNING id").
 		ToSql()
 	if err != nil {
@@ -24,7 +26,7 @@ func (r *Repository) Save(ctx context.Context, roundID, topicID int32) (int32, e
 	if err := r.Pool.QueryRow(ctx, sql, args...).Scan(&id); err != nil {
 		var pgErr *pgconn.PgError
 
-		if errors.As(err, &pgErr) && pgErr.ConstraintName == "round_topics_pkey" {
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "round_topics_round_id_topic_id_key" {
 			return 0, apperr.RoundTopicAlreadyExists
 		}
 
@@ -0,0 +1,38 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// DeleteOne deletes tag if it's not used in any pack.
+func (r *Repository) DeleteOne(ctx context.Context, name string) error {
+	sql, args, err := r.Builder.
+		Delete(TagsTable).
+		Where(squirrel.Eq{"name": name}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "pack_tags_tag_fkey" {
+			return apperr.TagInUse
+		}
+
+		return err
+	}
+
+	if ct.RowsAffected() == 0 {
+		return apperr.TagNotFound
+	}
+
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/ysomad/answersuck/internal/pkg/sort"
 )
 
-func (r *repository) GetAll(ctx context.Context, p paging.Params, sorts []sort.Sort) (paging.List[entity.Tag], error) {
+func (r *Repository) GetAll(ctx context.Context, p paging.Params, sorts []sort.Sort) (paging.List[entity.Tag], error) {
 	limit, offset, err := paging.OffsetToken(p.PageToken).Decode()
 	if err != nil {
 		return paging.List[entity.Tag]{}, err
@@ -22,8 +22,8 @@ func (r *repository) GetAll(ctx context.Context, p paging.Params, sorts []sort.S
 	}
 
 	b := r.Builder.
-		Select("name, author, create_time").
-		From(TagsTable).
+		Select("t.name, t.author, t.create_time", usageCountColumn).
+		From(TagsTable + " t").
 		Limit(limit + 1).
 		Offset(offset)
 
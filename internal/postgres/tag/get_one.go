@@ -0,0 +1,38 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) GetOne(ctx context.Context, name string) (*entity.Tag, error) {
+	sql, args, err := r.Builder.
+		Select("t.name, t.author, t.create_time", usageCountColumn).
+		From(TagsTable + " t").
+		Where(squirrel.Eq{"t.name": name}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByPos[entity.Tag])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.TagNotFound
+		}
+
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,149 @@
+package tag
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// MergeAll merges src tags into dst tag. Packs tagged with src tags are tagged with dst instead,
+// src tags are deleted and their names become aliases of dst.
+// Returns apperr.TagMergeInvalid if src tags contain duplicates or dst.
+func (r *Repository) MergeAll(ctx context.Context, src []string, dst string, mergeTime time.Time) error {
+	seen := map[string]struct{}{dst: {}}
+
+	for _, name := range src {
+		if _, ok := seen[name]; ok {
+			return apperr.TagMergeInvalid
+		}
+
+		seen[name] = struct{}{}
+	}
+
+	return pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// 1. Lock tags
+		sql, args, err := r.Builder.
+			Select("name").
+			From(TagsTable).
+			Where(squirrel.Eq{"name": append([]string{dst}, src...)}).
+			OrderBy("name").
+			Suffix("FOR UPDATE").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+
+		// names are unique, so some of the tags are missing
+		if len(names) != len(src)+1 {
+			return apperr.TagNotFound
+		}
+
+		// 2. Tag packs with dst tag
+		sql, args, err = r.Builder.
+			Insert(packTagsTable).
+			Columns("pack_id, tag").
+			Select(r.Builder.
+				Select("DISTINCT pack_id").
+				Column(squirrel.Expr("?::varchar", dst)).
+				From(packTagsTable).
+				Where(squirrel.Eq{"tag": src})).
+			Suffix("ON CONFLICT DO NOTHING").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		// 3. Untag packs from src tags
+		sql, args, err = r.Builder.
+			Delete(packTagsTable).
+			Where(squirrel.Eq{"tag": src}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		// 4. Move aliases of src tags to dst
+		sql, args, err = r.Builder.
+			Update(TagAliasesTable).
+			Set("tag", dst).
+			Where(squirrel.Eq{"tag": src}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		// 5. Delete src tags
+		sql, args, err = r.Builder.
+			Delete(TagsTable).
+			Where(squirrel.Eq{"name": src}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		// 6. Save src tag names as aliases of dst
+		b := r.Builder.
+			Insert(TagAliasesTable).
+			Columns("alias, tag, create_time").
+			Suffix("ON CONFLICT (alias) DO UPDATE SET tag = EXCLUDED.tag")
+
+		// dst tag itself is found case-insensitively and needs no alias
+		aliases := map[string]struct{}{strings.ToLower(dst): {}}
+
+		for _, name := range src {
+			alias := strings.ToLower(name)
+
+			if _, ok := aliases[alias]; ok {
+				continue
+			}
+
+			aliases[alias] = struct{}{}
+			b = b.Values(alias, dst, mergeTime)
+		}
+
+		if len(aliases) == 1 {
+			return nil
+		}
+
+		sql, args, err = b.ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
@@ -2,12 +2,20 @@ package tag
 
 import "github.com/ysomad/answersuck/internal/pkg/pgclient"
 
-const TagsTable = "tags"
+const (
+	TagsTable       = "tags"
+	TagAliasesTable = "tag_aliases"
+	BannedTagsTable = "banned_tags"
+	packTagsTable   = "pack_tags"
+)
 
-type repository struct {
+// usageCountColumn is amount of packs tagged with the tag, tags table must be aliased as t.
+const usageCountColumn = "(SELECT count(*) FROM " + packTagsTable + " pt WHERE pt.tag = t.name)::int AS usage_count"
+
+type Repository struct {
 	*pgclient.Client
 }
 
-func NewRepository(c *pgclient.Client) *repository {
-	return &repository{c}
+func NewRepository(c *pgclient.Client) *Repository {
+	return &Repository{c}
 }
@@ -3,26 +3,43 @@ package tag
 import (
 	"context"
 	"errors"
+	"strings"
 
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/ysomad/answersuck/internal/entity"
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
 )
 
-func (r *repository) Save(ctx context.Context, t entity.Tag) error {
-	sql, args, err := r.Builder.
-		Insert(TagsTable).
-		Columns("name", "author", "create_time").
-		Values(t.Name, t.Author, t.CreateTime).
-		ToSql()
-	if err != nil {
-		return err
+func (r *Repository) Save(ctx context.Context, t entity.Tag) error {
+	txFunc := func(tx pgx.Tx) error {
+		// 1. Check tag name is not banned and not an alias of another tag
+		if err := r.checkName(ctx, tx, t.Name); err != nil {
+			return err
+		}
+
+		// 2. Save tag
+		sql, args, err := r.Builder.
+			Insert(TagsTable).
+			Columns("name", "author", "create_time").
+			Values(t.Name, t.Author, t.CreateTime).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		return nil
 	}
 
-	if _, err := r.Pool.Exec(ctx, sql, args...); err != nil {
+	if err := pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, txFunc); err != nil {
 		var pgErr *pgconn.PgError
 
-		if errors.As(err, &pgErr) && (pgErr.ConstraintName == "tags_pkey" || pgErr.ConstraintName == "players_email_key") {
+		if errors.As(err, &pgErr) && (pgErr.ConstraintName == "tags_pkey" || pgErr.ConstraintName == "tags_name_lower_key") {
 			return apperr.TagAlreadyExists
 		}
 
@@ -31,3 +48,32 @@ func (r *repository) Save(ctx context.Context, t entity.Tag) error {
 
 	return nil
 }
+
+// checkName returns error if tag name is banned or already used as alias of another tag.
+func (r *Repository) checkName(ctx context.Context, tx pgx.Tx, name string) error {
+	name = strings.ToLower(name)
+
+	sql, args, err := r.Builder.
+		Select().
+		Column(squirrel.Expr("EXISTS (SELECT 1 FROM "+BannedTagsTable+" WHERE name = ?)", name)).
+		Column(squirrel.Expr("EXISTS (SELECT 1 FROM "+TagAliasesTable+" WHERE alias = ?)", name)).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var banned, alias bool
+
+	if err := tx.QueryRow(ctx, sql, args...).Scan(&banned, &alias); err != nil {
+		return err
+	}
+
+	switch {
+	case banned:
+		return apperr.TagBanned
+	case alias:
+		return apperr.TagAlreadyExists
+	}
+
+	return nil
+}
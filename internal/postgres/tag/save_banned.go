@@ -0,0 +1,27 @@
+package tag
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// SaveBanned adds tag name to list of banned tags, banned tags cannot be created or added to packs.
+// Existing tag with the name is not deleted.
+func (r *Repository) SaveBanned(ctx context.Context, name, bannedBy string, banTime time.Time) error {
+	sql, args, err := r.Builder.
+		Insert(BannedTagsTable).
+		Columns("name, banned_by, create_time").
+		Values(strings.ToLower(name), bannedBy, banTime).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Pool.Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`) //nolint:gochecknoglobals // replacer is immutable
+
+// Search returns at most limit tags which names start with query or similar to it.
+// Tags matched by prefix go first, then most similar and most used ones.
+// If query is empty most used tags are returned.
+func (r *Repository) Search(ctx context.Context, query string, limit uint64) ([]entity.Tag, error) {
+	b := r.Builder.
+		Select("t.name, t.author, t.create_time", usageCountColumn).
+		From(TagsTable + " t").
+		Limit(limit)
+
+	if query == "" {
+		b = b.OrderBy("usage_count DESC", "t.name")
+	} else {
+		prefix := likeEscaper.Replace(query) + "%"
+
+		b = b.
+			Where(squirrel.Or{
+				squirrel.ILike{"t.name": prefix},
+				squirrel.Expr("t.name % ?", query),
+			}).
+			OrderByClause("t.name ILIKE ? DESC", prefix).
+			OrderByClause("similarity(t.name, ?) DESC", query).
+			OrderBy("usage_count DESC", "t.name")
+	}
+
+	sql, args, err := b.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("b.ToSql: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("r.Pool.Query: %w", err)
+	}
+
+	tags, err := pgx.CollectRows(rows, pgx.RowToStructByPos[entity.Tag])
+	if err != nil {
+		return nil, fmt.Errorf("pgx.CollectRows: %w", err)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,95 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// UpdateOne renames tag if it's not used in any published pack,
+// pack tags are renamed as well.
+func (r *Repository) UpdateOne(ctx context.Context, name, newName string) error {
+	txFunc := func(tx pgx.Tx) error {
+		// 1. Lock tag
+		sql, args, err := r.Builder.
+			Select("name").
+			From(TagsTable).
+			Where(squirrel.Eq{"name": name}).
+			Suffix("FOR UPDATE").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&name); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.TagNotFound
+			}
+
+			return err
+		}
+
+		// 2. Check tag is not published
+		sql, args, err = r.Builder.
+			Select("pt.pack_id").
+			From(packTagsTable + " pt").
+			InnerJoin("packs p ON pt.pack_id = p.id").
+			Where(squirrel.And{
+				squirrel.Eq{"pt.tag": name},
+				squirrel.Eq{"p.is_published": true},
+			}).
+			Prefix("SELECT EXISTS (").
+			Suffix(")").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var published bool
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&published); err != nil {
+			return err
+		}
+
+		if published {
+			return apperr.TagPublished
+		}
+
+		// 3. Check new name is not banned and not an alias of another tag
+		if err := r.checkName(ctx, tx, newName); err != nil {
+			return err
+		}
+
+		// 4. Rename tag, pack tags are updated by foreign key cascade
+		sql, args, err = r.Builder.
+			Update(TagsTable).
+			Set("name", newName).
+			Where(squirrel.Eq{"name": name}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, txFunc); err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && (pgErr.ConstraintName == "tags_pkey" || pgErr.ConstraintName == "tags_name_lower_key") {
+			return apperr.TagAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
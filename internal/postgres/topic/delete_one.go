@@ -0,0 +1,38 @@
+package topic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// DeleteOne deletes topic if it's not used in any pack.
+func (r *Repository) DeleteOne(ctx context.Context, topicID int32) error {
+	sql, args, err := r.Builder.
+		Delete(TopicsTable).
+		Where(squirrel.Eq{"id": topicID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	ct, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "round_topics_topic_id_fkey" {
+			return apperr.TopicInUse
+		}
+
+		return err
+	}
+
+	if ct.RowsAffected() == 0 {
+		return apperr.TopicNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package topic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+func (r *Repository) GetOne(ctx context.Context, topicID int32) (*entity.Topic, error) {
+	sql, args, err := r.Builder.
+		Select("id, title, author, language, create_time").
+		From(TopicsTable).
+		Where(squirrel.Eq{"id": topicID}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[Topic])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.TopicNotFound
+		}
+
+		return nil, err
+	}
+
+	return (*entity.Topic)(&t), nil
+}
@@ -1,10 +1,15 @@
 package topic
 
-import "time"
+import (
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
 
 type Topic struct {
-	ID         int32     `db:"id"`
-	Title      string    `db:"title"`
-	Author     string    `db:"author"`
-	CreateTime time.Time `db:"create_time"`
+	ID         int32           `db:"id"`
+	Title      string          `db:"title"`
+	Author     string          `db:"author"`
+	Language   entity.Language `db:"language"`
+	CreateTime time.Time       `db:"create_time"`
 }
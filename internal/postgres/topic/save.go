@@ -9,8 +9,8 @@ import (
 func (r *Repository) Save(ctx context.Context, t entity.Topic) (int32, error) {
 	sql, args, err := r.Builder.
 		Insert(TopicsTable).
-		Columns("title, author, create_time").
-		Values(t.Title, t.Author, t.CreateTime).
+		Columns("title, author, language, create_time").
+		Values(t.Title, t.Author, t.Language, t.CreateTime).
 		Suffix("RETURNING id").
 		ToSql()
 	if err != nil {
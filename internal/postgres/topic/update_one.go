@@ -0,0 +1,77 @@
+package topic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// UpdateOne updates topic title if topic is not used in any published pack.
+func (r *Repository) UpdateOne(ctx context.Context, topicID int32, title string) error {
+	return pgx.BeginTxFunc(ctx, r.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// 1. Lock topic
+		sql, args, err := r.Builder.
+			Select("id").
+			From(TopicsTable).
+			Where(squirrel.Eq{"id": topicID}).
+			Suffix("FOR UPDATE").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&topicID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.TopicNotFound
+			}
+
+			return err
+		}
+
+		// 2. Check topic is not published
+		sql, args, err = r.Builder.
+			Select("rt.id").
+			From("round_topics rt").
+			InnerJoin("rounds r ON rt.round_id = r.id").
+			InnerJoin("packs p ON r.pack_id = p.id").
+			Where(squirrel.And{
+				squirrel.Eq{"rt.topic_id": topicID},
+				squirrel.Eq{"p.is_published": true},
+			}).
+			Prefix("SELECT EXISTS (").
+			Suffix(")").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var published bool
+
+		if err := tx.QueryRow(ctx, sql, args...).Scan(&published); err != nil {
+			return err
+		}
+
+		if published {
+			return apperr.TopicPublished
+		}
+
+		// 3. Update topic
+		sql, args, err = r.Builder.
+			Update(TopicsTable).
+			Set("title", title).
+			Where(squirrel.Eq{"id": topicID}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,156 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Judge judges answer of the player who is answering current question.
+func (s *Service) Judge(ctx context.Context, roomID string, correct bool, reason string) error {
+	host, _, err := s.hostRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.game.HandleFunc(ctx, roomID, game.Judge{Player: host, Correct: correct}, func(g *game.Game) error {
+		return s.saveAction(ctx, entity.HostAction{
+			RoomID:  roomID,
+			Host:    host,
+			Type:    entity.HostActionJudge,
+			Player:  g.Answerer(),
+			Correct: correct,
+			Reason:  reason,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error judging answer: %w", err)
+	}
+
+	return nil
+}
+
+// SetScore sets score of the player.
+func (s *Service) SetScore(ctx context.Context, roomID, player string, score int32, reason string) error {
+	host, _, err := s.hostRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	err = s.handle(ctx, roomID, game.SetScore{Player: host, Target: player, Score: score}, entity.HostAction{
+		RoomID: roomID,
+		Host:   host,
+		Type:   entity.HostActionSetScore,
+		Player: player,
+		Score:  score,
+		Reason: reason,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting score: %w", err)
+	}
+
+	return nil
+}
+
+// Pause pauses or resumes the game.
+func (s *Service) Pause(ctx context.Context, roomID string, paused bool, reason string) error {
+	host, _, err := s.hostRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	t := entity.HostActionPause
+	if !paused {
+		t = entity.HostActionResume
+	}
+
+	err = s.handle(ctx, roomID, game.Pause{Player: host, Paused: paused}, entity.HostAction{
+		RoomID: roomID,
+		Host:   host,
+		Type:   t,
+		Reason: reason,
+	})
+	if err != nil {
+		return fmt.Errorf("error pausing game: %w", err)
+	}
+
+	return nil
+}
+
+// Skip skips current question and reveals its answer.
+func (s *Service) Skip(ctx context.Context, roomID, reason string) error {
+	host, _, err := s.hostRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	err = s.handle(ctx, roomID, game.Skip{Player: host}, entity.HostAction{
+		RoomID: roomID,
+		Host:   host,
+		Type:   entity.HostActionSkip,
+		Reason: reason,
+	})
+	if err != nil {
+		return fmt.Errorf("error skipping question: %w", err)
+	}
+
+	return nil
+}
+
+// hostRoom returns nickname of current user and the room if current user is host of the room.
+func (s *Service) hostRoom(ctx context.Context, roomID string) (string, *entity.Room, error) {
+	nickname, room, err := s.currentRoom(ctx, roomID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch room.Host() {
+	case "":
+		return "", nil, apperr.RoomNoHost
+	case nickname:
+		return nickname, room, nil
+	}
+
+	return "", nil, apperr.RoomNotHost
+}
+
+// currentRoom returns nickname of current user and the room.
+func (s *Service) currentRoom(ctx context.Context, roomID string) (string, *entity.Room, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return "", nil, apperr.Unauthorized
+	}
+
+	room, err := s.room.GetOne(ctx, roomID)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting room: %w", err)
+	}
+
+	return nickname, room, nil
+}
+
+// handle saves host action only if the command is accepted by the game and keeps changes
+// of the command only after the action is saved, so the game never has changes which are not
+// in the host action log, rejected commands are not logged and failed action can be retried.
+func (s *Service) handle(ctx context.Context, roomID string, cmd game.Command, a entity.HostAction) error {
+	_, err := s.game.HandleFunc(ctx, roomID, cmd, func(*game.Game) error {
+		return s.saveAction(ctx, a)
+	})
+
+	return err
+}
+
+func (s *Service) saveAction(ctx context.Context, a entity.HostAction) error {
+	a.CreateTime = time.Now()
+
+	if _, err := s.hostAction.Save(ctx, a); err != nil {
+		return fmt.Errorf("error saving host action: %w", err)
+	}
+
+	return nil
+}
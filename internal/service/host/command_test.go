@@ -0,0 +1,308 @@
+package host
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+const (
+	roomID = "room"
+	owner  = "owner"
+	host   = "host"
+	alice  = "alice"
+)
+
+var errSave = errors.New("save failed")
+
+type roomRepositoryMock struct {
+	room *entity.Room
+}
+
+func (m *roomRepositoryMock) GetOne(context.Context, string) (*entity.Room, error) {
+	return m.room.Clone(), nil
+}
+
+func (m *roomRepositoryMock) UpdateOne(
+	_ context.Context, _ string, update func(*entity.Room) error) (*entity.Room, error) {
+	r := m.room.Clone()
+
+	if err := update(r); err != nil {
+		return nil, err
+	}
+
+	m.room = r
+
+	return r.Clone(), nil
+}
+
+func (m *roomRepositoryMock) DeleteOne(context.Context, string) error {
+	m.room = nil
+	return nil
+}
+
+// packRepositoryMock records players saved as players of the pack.
+type packRepositoryMock struct {
+	players []string
+}
+
+func (m *packRepositoryMock) IncrementPlayCount(context.Context, int32) error {
+	return nil
+}
+
+func (m *packRepositoryMock) SavePlayers(_ context.Context, _ int32, players []string, _ time.Time) error {
+	m.players = append(m.players, players...)
+	return nil
+}
+
+// gameRepositoryMock records commands which were applied to the game,
+// commands are rejected by the game with err if it's set.
+type gameRepositoryMock struct {
+	applied []game.Command
+	err     error
+}
+
+func (m *gameRepositoryMock) Save(context.Context, string, *game.Game, []game.Event) error {
+	return nil
+}
+
+func (m *gameRepositoryMock) HandleFunc(
+	_ context.Context, _ string, cmd game.Command, fn func(*game.Game) error) ([]game.Event, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	if err := fn(nil); err != nil {
+		return nil, err
+	}
+
+	m.applied = append(m.applied, cmd)
+
+	return nil, nil
+}
+
+type hostActionRepositoryMock struct {
+	saved []entity.HostAction
+	err   error
+}
+
+func (m *hostActionRepositoryMock) Save(_ context.Context, a entity.HostAction) (int64, error) {
+	if m.err != nil {
+		return 0, m.err
+	}
+
+	m.saved = append(m.saved, a)
+
+	return int64(len(m.saved)), nil
+}
+
+func testRoom() *entity.Room {
+	return &entity.Room{
+		ID:    roomID,
+		Owner: owner,
+		Members: []entity.RoomMember{
+			{Nickname: owner, Role: entity.RoomRolePlayer},
+			{Nickname: host, Role: entity.RoomRoleHost},
+			{Nickname: alice, Role: entity.RoomRolePlayer},
+		},
+		Started: true,
+	}
+}
+
+func TestService_HostActions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		nickname    string
+		room        func(*entity.Room)
+		saveErr     error
+		gameErr     error
+		do          func(context.Context, *Service) error
+		wantErr     error
+		wantApplied []game.Command
+		wantSaved   []entity.HostActionType
+	}{
+		{
+			name:     "set score",
+			nickname: host,
+			do: func(ctx context.Context, s *Service) error {
+				return s.SetScore(ctx, roomID, alice, 500, "typo in answer")
+			},
+			wantApplied: []game.Command{game.SetScore{Player: host, Target: alice, Score: 500}},
+			wantSaved:   []entity.HostActionType{entity.HostActionSetScore},
+		},
+		{
+			name:     "set score not applied if action is not saved",
+			nickname: host,
+			saveErr:  errSave,
+			do: func(ctx context.Context, s *Service) error {
+				return s.SetScore(ctx, roomID, alice, 500, "typo in answer")
+			},
+			wantErr: errSave,
+		},
+		{
+			name:     "pause not applied if action is not saved",
+			nickname: host,
+			saveErr:  errSave,
+			do: func(ctx context.Context, s *Service) error {
+				return s.Pause(ctx, roomID, true, "")
+			},
+			wantErr: errSave,
+		},
+		{
+			name:     "resume",
+			nickname: host,
+			do: func(ctx context.Context, s *Service) error {
+				return s.Pause(ctx, roomID, false, "")
+			},
+			wantApplied: []game.Command{game.Pause{Player: host, Paused: false}},
+			wantSaved:   []entity.HostActionType{entity.HostActionResume},
+		},
+		{
+			name:     "skip not applied if action is not saved",
+			nickname: host,
+			saveErr:  errSave,
+			do: func(ctx context.Context, s *Service) error {
+				return s.Skip(ctx, roomID, "")
+			},
+			wantErr: errSave,
+		},
+		{
+			name:     "rejected command is not saved",
+			nickname: host,
+			gameErr:  game.ErrUnexpectedCommand,
+			do: func(ctx context.Context, s *Service) error {
+				return s.Skip(ctx, roomID, "")
+			},
+			wantErr: game.ErrUnexpectedCommand,
+		},
+		{
+			name:     "not host",
+			nickname: owner,
+			do: func(ctx context.Context, s *Service) error {
+				return s.Skip(ctx, roomID, "")
+			},
+			wantErr: apperr.RoomNotHost,
+		},
+		{
+			name:     "no host",
+			nickname: owner,
+			room: func(r *entity.Room) {
+				r.Members = r.Members[:1]
+			},
+			do: func(ctx context.Context, s *Service) error {
+				return s.Skip(ctx, roomID, "")
+			},
+			wantErr: apperr.RoomNoHost,
+		},
+		{
+			name:     "start game not by owner",
+			nickname: host,
+			room: func(r *entity.Room) {
+				r.Started = false
+			},
+			do: func(ctx context.Context, s *Service) error {
+				return s.StartGame(ctx, roomID, "", "")
+			},
+			wantErr: apperr.RoomNotOwner,
+		},
+		{
+			name:     "start game without host",
+			nickname: owner,
+			room: func(r *entity.Room) {
+				r.Started = false
+				r.Members = append(r.Members[:1], r.Members[2])
+			},
+			do: func(ctx context.Context, s *Service) error {
+				return s.StartGame(ctx, roomID, "", "")
+			},
+			wantErr: apperr.RoomNoHost,
+		},
+		{
+			name:     "start game without players",
+			nickname: owner,
+			room: func(r *entity.Room) {
+				r.Started = false
+				r.Members = r.Members[1:2]
+				r.Members[0].Role = entity.RoomRoleHost
+			},
+			do: func(ctx context.Context, s *Service) error {
+				return s.StartGame(ctx, roomID, "", "")
+			},
+			wantErr: apperr.RoomNotEnoughPlayers,
+		},
+		{
+			name:     "start game not saved",
+			nickname: owner,
+			room: func(r *entity.Room) {
+				r.Started = false
+			},
+			saveErr: errSave,
+			do: func(ctx context.Context, s *Service) error {
+				return s.StartGame(ctx, roomID, "", "")
+			},
+			wantErr: errSave,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			room := testRoom()
+			if tt.room != nil {
+				tt.room(room)
+			}
+
+			rr := &roomRepositoryMock{room: room}
+			gr := &gameRepositoryMock{err: tt.gameErr}
+			har := &hostActionRepositoryMock{err: tt.saveErr}
+			s := NewService(rr, gr, har, nil, nil, nil)
+
+			ctx := context.WithValue(context.Background(), appctx.NicknameKey{}, tt.nickname)
+
+			err := tt.do(ctx, s)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			assert.Equal(t, tt.wantApplied, gr.applied)
+
+			var saved []entity.HostActionType
+			for _, a := range har.saved {
+				saved = append(saved, a.Type)
+			}
+
+			assert.Equal(t, tt.wantSaved, saved)
+
+			// room is not left started if the game wasn't started
+			if tt.wantErr != nil {
+				assert.Equal(t, room.Started, rr.room.Started)
+			}
+		})
+	}
+}
+
+func TestService_FinishGame(t *testing.T) {
+	t.Parallel()
+
+	room := testRoom()
+	room.Members = append(room.Members, entity.RoomMember{Nickname: "bob", Role: entity.RoomRoleSpectator})
+
+	rr := &roomRepositoryMock{room: room}
+	pr := &packRepositoryMock{}
+	s := NewService(rr, &gameRepositoryMock{}, &hostActionRepositoryMock{}, pr, nil, nil)
+
+	require.NoError(t, s.FinishGame(context.Background(), roomID))
+
+	assert.Nil(t, rr.room)
+	assert.Equal(t, []string{owner, host, alice}, pr.players)
+}
@@ -0,0 +1,36 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// FinishGame removes the room of finished game and saves its players and host
+// as players of the pack, so they're allowed to rate it. Spectators are not saved.
+func (s *Service) FinishGame(ctx context.Context, roomID string) error {
+	room, err := s.room.GetOne(ctx, roomID)
+	if err != nil {
+		return fmt.Errorf("error getting room: %w", err)
+	}
+
+	if err := s.room.DeleteOne(ctx, roomID); err != nil {
+		return fmt.Errorf("error deleting room: %w", err)
+	}
+
+	players := make([]string, 0, len(room.Members))
+
+	for _, m := range room.Members {
+		if m.Role == entity.RoomRolePlayer || m.Role == entity.RoomRoleHost {
+			players = append(players, m.Nickname)
+		}
+	}
+
+	if err := s.pack.SavePlayers(ctx, room.PackID, players, time.Now()); err != nil {
+		return fmt.Errorf("error saving pack players: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package host
+
+import (
+	"context"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game"
+)
+
+type roomRepository interface {
+	GetOne(ctx context.Context, roomID string) (*entity.Room, error)
+	UpdateOne(ctx context.Context, roomID string, update func(*entity.Room) error) (*entity.Room, error)
+	DeleteOne(ctx context.Context, roomID string) error
+}
+
+// gameRepository is a registry of running games, commands to the game must be applied sequentially.
+type gameRepository interface {
+	Save(ctx context.Context, roomID string, g *game.Game, events []game.Event) error
+	HandleFunc(ctx context.Context, roomID string, cmd game.Command, fn func(*game.Game) error) ([]game.Event, error)
+}
+
+type hostActionRepository interface {
+	Save(context.Context, entity.HostAction) (int64, error)
+}
+
+type packRepository interface {
+	IncrementPlayCount(ctx context.Context, packID int32) error
+	SavePlayers(ctx context.Context, packID int32, players []string, playTime time.Time) error
+}
+
+type roundRepository interface {
+	GetAll(ctx context.Context, packID int32) ([]entity.Round, error)
+}
+
+type roundQuestionRepository interface {
+	GetAll(ctx context.Context, roundID int32) ([]entity.RoundQuestionDetailed, error)
+}
+
+type Service struct {
+	room          roomRepository
+	game          gameRepository
+	hostAction    hostActionRepository
+	pack          packRepository
+	round         roundRepository
+	roundQuestion roundQuestionRepository
+}
+
+func NewService(
+	rr roomRepository, gr gameRepository, har hostActionRepository,
+	pr packRepository, rdr roundRepository, rqr roundQuestionRepository) *Service {
+	return &Service{
+		room:          rr,
+		game:          gr,
+		hostAction:    har,
+		pack:          pr,
+		round:         rdr,
+		roundQuestion: rqr,
+	}
+}
@@ -0,0 +1,118 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// StartGame starts the game in the room, all members except host become players in order they joined.
+// Only creator of the room can start the game regardless of his role in the room.
+// First player chooses first question if firstPlayer is not specified.
+func (s *Service) StartGame(ctx context.Context, roomID, firstPlayer, reason string) error {
+	nickname, room, err := s.currentRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	if room.Owner != nickname {
+		return apperr.RoomNotOwner
+	}
+
+	var (
+		host    string
+		players []string
+	)
+
+	// room is marked as started first so the game can't be started twice, members are taken
+	// in the same update so everyone who joined before start is seated
+	room, err = s.room.UpdateOne(ctx, roomID, func(r *entity.Room) error {
+		if r.Started {
+			return apperr.RoomGameStarted
+		}
+
+		host = r.Host()
+		if host == "" {
+			return apperr.RoomNoHost
+		}
+
+		players = nil
+
+		for _, m := range r.Members {
+			if m.Role == entity.RoomRolePlayer {
+				players = append(players, m.Nickname)
+			}
+		}
+
+		if len(players) == 0 {
+			return apperr.RoomNotEnoughPlayers
+		}
+
+		r.Started = true
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error marking room as started: %w", err)
+	}
+
+	err = s.saveAction(ctx, entity.HostAction{
+		RoomID: roomID,
+		Host:   nickname,
+		Type:   entity.HostActionStartGame,
+		Player: firstPlayer,
+		Reason: reason,
+	})
+	if err == nil {
+		err = s.startGame(ctx, room, host, players, firstPlayer)
+	}
+
+	if err != nil {
+		if _, rerr := s.room.UpdateOne(ctx, roomID, func(r *entity.Room) error {
+			r.Started = false
+			return nil
+		}); rerr != nil {
+			return fmt.Errorf("error reverting room start: %w", rerr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) startGame(ctx context.Context, room *entity.Room, host string, players []string, firstPlayer string) error {
+	pack, err := game.LoadPack(ctx, s.round, s.roundQuestion, room.PackID)
+	if err != nil {
+		return fmt.Errorf("error loading pack: %w", err)
+	}
+
+	g, events, err := game.New(pack, game.Config{
+		Host:         host,
+		Players:      players,
+		FirstChooser: firstPlayer,
+		Settings:     room.Settings,
+	}, time.Now())
+	if err != nil {
+		return fmt.Errorf("error creating game: %w", err)
+	}
+
+	if err := s.game.Save(ctx, room.ID, g, events); err != nil {
+		return fmt.Errorf("error saving game: %w", err)
+	}
+
+	// game is already running, so it's not reverted if play is not counted
+	if err := s.pack.IncrementPlayCount(ctx, room.PackID); err != nil {
+		slog.Error("error incrementing pack play count",
+			slog.String("room_id", room.ID),
+			slog.Int("pack_id", int(room.PackID)),
+			slog.String("error", err.Error()))
+	}
+
+	return nil
+}
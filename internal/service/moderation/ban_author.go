@@ -0,0 +1,41 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// BanAuthor bans player from publishing packs, only admin is allowed to ban authors.
+func (s *Service) BanAuthor(ctx context.Context, nickname string) error {
+	admin, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	if err := s.playerSvc.VerifyAdmin(ctx); err != nil {
+		return fmt.Errorf("error verifying admin: %w", err)
+	}
+
+	if err := s.player.SaveBannedAuthor(ctx, nickname, admin, time.Now()); err != nil {
+		return fmt.Errorf("error banning author: %w", err)
+	}
+
+	return nil
+}
+
+// UnbanAuthor allows banned player to publish packs again, only admin is allowed to unban authors.
+func (s *Service) UnbanAuthor(ctx context.Context, nickname string) error {
+	if err := s.playerSvc.VerifyAdmin(ctx); err != nil {
+		return fmt.Errorf("error verifying admin: %w", err)
+	}
+
+	if err := s.player.DeleteBannedAuthor(ctx, nickname); err != nil {
+		return fmt.Errorf("error unbanning author: %w", err)
+	}
+
+	return nil
+}
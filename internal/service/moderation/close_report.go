@@ -0,0 +1,38 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// CloseReport resolves or dismisses open report, only admin is allowed to close reports.
+func (s *Service) CloseReport(ctx context.Context, reportID int32, status entity.ReportStatus) (*entity.Report, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return nil, apperr.Unauthorized
+	}
+
+	if err := s.playerSvc.VerifyAdmin(ctx); err != nil {
+		return nil, fmt.Errorf("error verifying admin: %w", err)
+	}
+
+	r, err := s.report.GetOne(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting report: %w", err)
+	}
+
+	if err := r.Close(status, nickname, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.report.UpdateOne(ctx, r); err != nil {
+		return nil, fmt.Errorf("error updating report: %w", err)
+	}
+
+	return r, nil
+}
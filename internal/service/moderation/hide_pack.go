@@ -0,0 +1,20 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+)
+
+// SetPackHidden hides pack from catalog or returns it back, only admin is allowed to hide packs.
+// Hidden pack stays readable by players who have access to it.
+func (s *Service) SetPackHidden(ctx context.Context, packID int32, hidden bool) error {
+	if err := s.playerSvc.VerifyAdmin(ctx); err != nil {
+		return fmt.Errorf("error verifying admin: %w", err)
+	}
+
+	if err := s.pack.UpdateHidden(ctx, packID, hidden); err != nil {
+		return fmt.Errorf("error updating pack: %w", err)
+	}
+
+	return nil
+}
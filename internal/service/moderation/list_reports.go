@@ -0,0 +1,24 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+)
+
+// ListReports returns moderation queue, only admin is allowed to see reports.
+func (s *Service) ListReports(
+	ctx context.Context, status entity.ReportStatus, p paging.Params) (paging.List[entity.Report], error) {
+	if err := s.playerSvc.VerifyAdmin(ctx); err != nil {
+		return paging.List[entity.Report]{}, fmt.Errorf("error verifying admin: %w", err)
+	}
+
+	reports, err := s.report.GetAll(ctx, status, p)
+	if err != nil {
+		return paging.List[entity.Report]{}, fmt.Errorf("error getting reports: %w", err)
+	}
+
+	return reports, nil
+}
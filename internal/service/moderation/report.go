@@ -0,0 +1,53 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Report adds report of current user on pack or question to moderation queue,
+// only content readable by current user can be reported.
+func (s *Service) Report(ctx context.Context, r entity.Report, shareToken string) (int32, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return 0, apperr.Unauthorized
+	}
+
+	r.Reporter = nickname
+	r.Status = entity.ReportStatusOpen
+	r.CreateTime = time.Now()
+
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	if err := s.verifyReadAccess(ctx, r, shareToken); err != nil {
+		return 0, err
+	}
+
+	reportID, err := s.report.Save(ctx, r)
+	if err != nil {
+		return 0, fmt.Errorf("error saving report: %w", err)
+	}
+
+	return reportID, nil
+}
+
+// verifyReadAccess returns apperr.PackNotFound or apperr.QuestionNotFound
+// if reported content is not readable by current user.
+func (s *Service) verifyReadAccess(ctx context.Context, r entity.Report, shareToken string) error {
+	if r.PackID != 0 {
+		return s.packSvc.VerifyReadAccess(ctx, r.PackID, shareToken)
+	}
+
+	if _, err := s.questionSvc.Get(ctx, r.QuestionID, shareToken); err != nil {
+		return fmt.Errorf("error getting question: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package moderation
+
+import (
+	"context"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+)
+
+type reportRepository interface {
+	Save(context.Context, entity.Report) (int32, error)
+	GetOne(ctx context.Context, reportID int32) (*entity.Report, error)
+	GetAll(context.Context, entity.ReportStatus, paging.Params) (paging.List[entity.Report], error)
+	UpdateOne(context.Context, *entity.Report) error
+}
+
+type packRepository interface {
+	UpdateHidden(ctx context.Context, packID int32, hidden bool) error
+}
+
+type playerRepository interface {
+	SaveBannedAuthor(ctx context.Context, nickname, bannedBy string, banTime time.Time) error
+	DeleteBannedAuthor(ctx context.Context, nickname string) error
+}
+
+type packService interface {
+	VerifyReadAccess(ctx context.Context, packID int32, shareToken string) error
+}
+
+type questionService interface {
+	Get(ctx context.Context, questionID int32, shareToken string) (*entity.Question, error)
+}
+
+type playerService interface {
+	VerifyAdmin(ctx context.Context) error
+}
+
+type Service struct {
+	report      reportRepository
+	pack        packRepository
+	player      playerRepository
+	packSvc     packService
+	questionSvc questionService
+	playerSvc   playerService
+}
+
+func NewService(
+	rr reportRepository, pr packRepository, plr playerRepository,
+	ps packService, qs questionService, pls playerService) *Service {
+	return &Service{
+		report:      rr,
+		pack:        pr,
+		player:      plr,
+		packSvc:     ps,
+		questionSvc: qs,
+		playerSvc:   pls,
+	}
+}
@@ -0,0 +1,22 @@
+package pack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// Get returns pack with tags if current user can read it.
+func (s *Service) Get(ctx context.Context, packID int32, shareToken string) (*entity.PackWithTags, error) {
+	if err := s.VerifyReadAccess(ctx, packID, shareToken); err != nil {
+		return nil, err
+	}
+
+	p, err := s.repo.GetWithTags(ctx, packID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting pack with tags: %w", err)
+	}
+
+	return p, nil
+}
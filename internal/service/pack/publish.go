@@ -0,0 +1,45 @@
+package pack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Publish publishes pack if its content meets publishing requirements,
+// only author of the pack who is not banned from publishing can publish it.
+func (s *Service) Publish(ctx context.Context, packID int32) (*entity.PackWithStats, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return nil, apperr.Unauthorized
+	}
+
+	p, err := s.repo.GetOne(ctx, packID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting pack: %w", err)
+	}
+
+	if p.Author != nickname {
+		return nil, apperr.PackNotAuthor
+	}
+
+	if p.Published {
+		return nil, apperr.PackPublished
+	}
+
+	stats, err := s.repo.UpdatePublished(ctx, packID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error publishing pack: %w", err)
+	}
+
+	p.Published = true
+
+	return &entity.PackWithStats{
+		Pack:  *p,
+		Stats: stats,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package pack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Rate rates the pack on behalf of current player who finished a game with the pack,
+// author cannot rate his own pack. Player must have read access to the pack,
+// so share token is required for unlisted and private packs.
+func (s *Service) Rate(ctx context.Context, packID int32, rating int16, shareToken string) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	if rating < entity.PackRatingMin || rating > entity.PackRatingMax {
+		return apperr.PackInvalidRating
+	}
+
+	pack, err := s.repo.GetOne(ctx, packID)
+	if err != nil {
+		return fmt.Errorf("error getting pack: %w", err)
+	}
+
+	if pack.Author == nickname {
+		return apperr.PackRatedByAuthor
+	}
+
+	if err := s.verifyReadAccess(ctx, pack, shareToken); err != nil {
+		return err
+	}
+
+	played, err := s.repo.HasPlayed(ctx, packID, nickname)
+	if err != nil {
+		return fmt.Errorf("error checking pack is played: %w", err)
+	}
+
+	if !played {
+		return apperr.PackNotPlayed
+	}
+
+	err = s.repo.SaveRating(ctx, entity.PackRating{
+		PackID:     packID,
+		Player:     nickname,
+		Rating:     rating,
+		CreateTime: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("error saving pack rating: %w", err)
+	}
+
+	return nil
+}
@@ -2,13 +2,24 @@ package pack
 
 import (
 	"context"
+	"time"
 
 	"github.com/ysomad/answersuck/internal/entity"
 )
 
 type repository interface {
 	GetOne(context.Context, int32) (*entity.Pack, error)
+	GetWithTags(ctx context.Context, packID int32) (*entity.PackWithTags, error)
 	GetRoundAuthor(ctx context.Context, roundID int32) (string, error)
+	GetRoundPack(ctx context.Context, roundID int32) (*entity.Pack, error)
+	UpdateVisibility(ctx context.Context, packID int32, v entity.PackVisibility) error
+	UpdatePublished(ctx context.Context, packID int32, publishTime time.Time) (entity.PackStats, error)
+	SaveShareToken(context.Context, entity.PackShareToken) error
+	GetShareToken(ctx context.Context, token string) (*entity.PackShareToken, error)
+	GetAllShareTokens(ctx context.Context, packID int32) ([]entity.PackShareToken, error)
+	DeleteShareToken(ctx context.Context, token string) error
+	SaveRating(context.Context, entity.PackRating) error
+	HasPlayed(ctx context.Context, packID int32, player string) (bool, error)
 }
 
 type Service struct {
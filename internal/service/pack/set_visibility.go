@@ -0,0 +1,20 @@
+package pack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+func (s *Service) SetVisibility(ctx context.Context, packID int32, v entity.PackVisibility) error {
+	if err := s.VerifyAuthorship(ctx, packID); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateVisibility(ctx, packID, v); err != nil {
+		return fmt.Errorf("error updating pack visibility: %w", err)
+	}
+
+	return nil
+}
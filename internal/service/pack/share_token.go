@@ -0,0 +1,70 @@
+package pack
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+const shareTokenLen = 32
+
+// CreateShareToken creates new share token which grants read access to the pack.
+func (s *Service) CreateShareToken(ctx context.Context, packID int32) (entity.PackShareToken, error) {
+	if err := s.VerifyAuthorship(ctx, packID); err != nil {
+		return entity.PackShareToken{}, err
+	}
+
+	b := make([]byte, shareTokenLen)
+
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return entity.PackShareToken{}, fmt.Errorf("error generating share token: %w", err)
+	}
+
+	t := entity.PackShareToken{
+		Token:      base64.RawURLEncoding.EncodeToString(b),
+		PackID:     packID,
+		CreateTime: time.Now(),
+	}
+
+	if err := s.repo.SaveShareToken(ctx, t); err != nil {
+		return entity.PackShareToken{}, fmt.Errorf("error saving share token: %w", err)
+	}
+
+	return t, nil
+}
+
+func (s *Service) ListShareTokens(ctx context.Context, packID int32) ([]entity.PackShareToken, error) {
+	if err := s.VerifyAuthorship(ctx, packID); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.repo.GetAllShareTokens(ctx, packID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting share tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// RevokeShareToken deletes share token, players which used it lose access to the pack.
+func (s *Service) RevokeShareToken(ctx context.Context, token string) error {
+	t, err := s.repo.GetShareToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("error getting share token: %w", err)
+	}
+
+	if err := s.VerifyAuthorship(ctx, t.PackID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteShareToken(ctx, token); err != nil {
+		return fmt.Errorf("error deleting share token: %w", err)
+	}
+
+	return nil
+}
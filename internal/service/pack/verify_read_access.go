@@ -0,0 +1,85 @@
+package pack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// VerifyReadAccess returns no error if current user can read the pack.
+// Returns apperr.PackNotFound if pack is not readable to not reveal its existence.
+func (s *Service) VerifyReadAccess(ctx context.Context, packID int32, shareToken string) error {
+	pack, err := s.repo.GetOne(ctx, packID)
+	if err != nil {
+		return fmt.Errorf("error getting pack: %w", err)
+	}
+
+	return s.verifyReadAccess(ctx, pack, shareToken)
+}
+
+// VerifyRoundReadAccess returns no error if current user can read pack of the round.
+// Returns apperr.RoundNotFound if pack is not readable.
+func (s *Service) VerifyRoundReadAccess(ctx context.Context, roundID int32, shareToken string) error {
+	pack, err := s.repo.GetRoundPack(ctx, roundID)
+	if err != nil {
+		return fmt.Errorf("error getting round pack: %w", err)
+	}
+
+	if err := s.verifyReadAccess(ctx, pack, shareToken); err != nil {
+		if errors.Is(err, apperr.PackNotFound) {
+			return apperr.RoundNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// verifyReadAccess checks access to the pack:
+// author reads any pack, others read published packs only,
+// public pack is readable by anyone, unlisted pack requires share token and
+// private pack requires share token and authenticated player.
+func (s *Service) verifyReadAccess(ctx context.Context, pack *entity.Pack, shareToken string) error {
+	nickname, authenticated := appctx.GetNickname(ctx)
+
+	if authenticated && pack.Author == nickname {
+		return nil
+	}
+
+	if !pack.Published {
+		return apperr.PackNotFound
+	}
+
+	switch pack.Visibility {
+	case entity.PackVisibilityPublic:
+		return nil
+	case entity.PackVisibilityPrivate:
+		if !authenticated {
+			return apperr.PackNotFound
+		}
+	}
+
+	if shareToken == "" {
+		return apperr.PackNotFound
+	}
+
+	t, err := s.repo.GetShareToken(ctx, shareToken)
+	if err != nil {
+		if errors.Is(err, apperr.PackShareTokenNotFound) {
+			return apperr.PackNotFound
+		}
+
+		return fmt.Errorf("error getting share token: %w", err)
+	}
+
+	if t.PackID != pack.ID {
+		return apperr.PackNotFound
+	}
+
+	return nil
+}
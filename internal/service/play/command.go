@@ -0,0 +1,74 @@
+package play
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// SelectQuestion selects question from the grid of current round.
+func (s *Service) SelectQuestion(ctx context.Context, roomID string, roundQuestionID int32) error {
+	return s.handle(ctx, roomID, "error selecting question", func(player string) game.Command {
+		return game.SelectQuestion{Player: player, RoundQuestionID: roundQuestionID}
+	})
+}
+
+// Buzz presses the button, rtt is round-trip time measured by the client.
+func (s *Service) Buzz(ctx context.Context, roomID string, rtt time.Duration) error {
+	return s.handle(ctx, roomID, "error pressing button", func(player string) game.Command {
+		return game.Buzz{Player: player, RTT: rtt}
+	})
+}
+
+// Answer gives answer to current question.
+func (s *Service) Answer(ctx context.Context, roomID, text string) error {
+	return s.handle(ctx, roomID, "error answering question", func(player string) game.Command {
+		return game.Answer{Player: player, Text: text}
+	})
+}
+
+// Pass refuses to buzz on current question or to bid on auction question.
+func (s *Service) Pass(ctx context.Context, roomID string) error {
+	return s.handle(ctx, roomID, "error passing question", func(player string) game.Command {
+		return game.Pass{Player: player}
+	})
+}
+
+// Bid makes bid on auction question.
+func (s *Service) Bid(ctx context.Context, roomID string, amount int32, allIn bool) error {
+	return s.handle(ctx, roomID, "error making bid", func(player string) game.Command {
+		return game.Bid{Player: player, Amount: amount, AllIn: allIn}
+	})
+}
+
+// Transfer transfers secret question to another player.
+func (s *Service) Transfer(ctx context.Context, roomID, to string) error {
+	return s.handle(ctx, roomID, "error transferring question", func(player string) game.Command {
+		return game.Transfer{Player: player, To: to}
+	})
+}
+
+// ChooseCost chooses cost of secret question.
+func (s *Service) ChooseCost(ctx context.Context, roomID string, cost int32) error {
+	return s.handle(ctx, roomID, "error choosing cost", func(player string) game.Command {
+		return game.ChooseCost{Player: player, Cost: cost}
+	})
+}
+
+// handle applies command issued by current user to the game of the room.
+func (s *Service) handle(ctx context.Context, roomID, errMsg string, cmd func(player string) game.Command) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	if _, err := s.game.Handle(ctx, roomID, cmd(nickname)); err != nil {
+		return fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package play
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ysomad/answersuck/internal/game"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+const (
+	roomID = "room"
+	alice  = "alice"
+)
+
+// gameRepositoryMock records commands which were applied to the game,
+// commands are rejected by the game with err if it's set.
+type gameRepositoryMock struct {
+	applied []game.Command
+	err     error
+}
+
+func (m *gameRepositoryMock) Handle(_ context.Context, _ string, cmd game.Command) ([]game.Event, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	m.applied = append(m.applied, cmd)
+
+	return nil, nil
+}
+
+func TestService_Commands(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		gameErr     error
+		do          func(context.Context, *Service) error
+		wantErr     error
+		wantApplied []game.Command
+	}{
+		{
+			name: "select question",
+			do: func(ctx context.Context, s *Service) error {
+				return s.SelectQuestion(ctx, roomID, 1)
+			},
+			wantApplied: []game.Command{game.SelectQuestion{Player: alice, RoundQuestionID: 1}},
+		},
+		{
+			name: "buzz with round-trip time",
+			do: func(ctx context.Context, s *Service) error {
+				return s.Buzz(ctx, roomID, 150*time.Millisecond)
+			},
+			wantApplied: []game.Command{game.Buzz{Player: alice, RTT: 150 * time.Millisecond}},
+		},
+		{
+			name: "answer",
+			do: func(ctx context.Context, s *Service) error {
+				return s.Answer(ctx, roomID, "answer")
+			},
+			wantApplied: []game.Command{game.Answer{Player: alice, Text: "answer"}},
+		},
+		{
+			name: "pass",
+			do: func(ctx context.Context, s *Service) error {
+				return s.Pass(ctx, roomID)
+			},
+			wantApplied: []game.Command{game.Pass{Player: alice}},
+		},
+		{
+			name: "bid",
+			do: func(ctx context.Context, s *Service) error {
+				return s.Bid(ctx, roomID, 300, true)
+			},
+			wantApplied: []game.Command{game.Bid{Player: alice, Amount: 300, AllIn: true}},
+		},
+		{
+			name: "transfer",
+			do: func(ctx context.Context, s *Service) error {
+				return s.Transfer(ctx, roomID, "bob")
+			},
+			wantApplied: []game.Command{game.Transfer{Player: alice, To: "bob"}},
+		},
+		{
+			name: "choose cost",
+			do: func(ctx context.Context, s *Service) error {
+				return s.ChooseCost(ctx, roomID, 500)
+			},
+			wantApplied: []game.Command{game.ChooseCost{Player: alice, Cost: 500}},
+		},
+		{
+			name:    "rejected command",
+			gameErr: game.ErrNotYourTurn,
+			do: func(ctx context.Context, s *Service) error {
+				return s.Pass(ctx, roomID)
+			},
+			wantErr: game.ErrNotYourTurn,
+		},
+		{
+			name: "unauthorized",
+			ctx:  context.Background(),
+			do: func(ctx context.Context, s *Service) error {
+				return s.Pass(ctx, roomID)
+			},
+			wantErr: apperr.Unauthorized,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gr := &gameRepositoryMock{err: tt.gameErr}
+			s := NewService(gr)
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.WithValue(context.Background(), appctx.NicknameKey{}, alice)
+			}
+
+			err := tt.do(ctx, s)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantApplied, gr.applied)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package play
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/game"
+)
+
+// gameRepository is a registry of running games, commands to the game must be applied sequentially.
+type gameRepository interface {
+	Handle(ctx context.Context, roomID string, cmd game.Command) ([]game.Event, error)
+}
+
+type Service struct {
+	game gameRepository
+}
+
+func NewService(gr gameRepository) *Service {
+	return &Service{
+		game: gr,
+	}
+}
@@ -0,0 +1,18 @@
+package player
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// GetProfile returns public author profile of the player.
+func (s *Service) GetProfile(ctx context.Context, nickname string) (*entity.AuthorProfile, error) {
+	p, err := s.repo.GetProfile(ctx, nickname)
+	if err != nil {
+		return nil, fmt.Errorf("error getting author profile: %w", err)
+	}
+
+	return p, nil
+}
@@ -9,6 +9,7 @@ import (
 type repository interface {
 	Save(context.Context, *entity.Player) error
 	GetOne(ctx context.Context, login string, lt entity.LoginType) (*entity.Player, error)
+	GetProfile(ctx context.Context, nickname string) (*entity.AuthorProfile, error)
 }
 
 type Service struct {
@@ -0,0 +1,29 @@
+package player
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// VerifyAdmin returns no error if current user from session is admin.
+func (s *Service) VerifyAdmin(ctx context.Context) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	p, err := s.repo.GetOne(ctx, nickname, entity.LoginTypeNickname)
+	if err != nil {
+		return fmt.Errorf("error getting player: %w", err)
+	}
+
+	if !p.Admin {
+		return apperr.PlayerNotAdmin
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package preview
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// Get returns current preview of the player.
+func (s *Service) Get(ctx context.Context) (*entity.Preview, error) {
+	return s.update(ctx, func(*entity.Preview) error { return nil })
+}
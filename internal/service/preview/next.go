@@ -0,0 +1,14 @@
+package preview
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// Next moves current preview of the player to the next stage.
+func (s *Service) Next(ctx context.Context) (*entity.Preview, error) {
+	return s.update(ctx, func(p *entity.Preview) error {
+		return p.Next()
+	})
+}
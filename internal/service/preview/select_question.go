@@ -0,0 +1,14 @@
+package preview
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// SelectQuestion selects question from the grid of current preview round.
+func (s *Service) SelectQuestion(ctx context.Context, roundQuestionID int32) (*entity.Preview, error) {
+	return s.update(ctx, func(p *entity.Preview) error {
+		return p.Select(roundQuestionID)
+	})
+}
@@ -0,0 +1,64 @@
+package preview
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+type roundRepository interface {
+	GetAll(ctx context.Context, packID int32) ([]entity.Round, error)
+}
+
+type roundQuestionRepository interface {
+	GetAll(ctx context.Context, roundID int32) ([]entity.RoundQuestionDetailed, error)
+}
+
+type packService interface {
+	VerifyAuthorship(ctx context.Context, packID int32) error
+}
+
+// Service keeps one preview per player in memory, previews are lost on restart.
+type Service struct {
+	round         roundRepository
+	roundQuestion roundQuestionRepository
+	pack          packService
+
+	mu       sync.Mutex
+	previews map[string]*entity.Preview
+}
+
+func NewService(r roundRepository, rq roundQuestionRepository, ps packService) *Service {
+	return &Service{
+		round:         r,
+		roundQuestion: rq,
+		pack:          ps,
+		previews:      make(map[string]*entity.Preview),
+	}
+}
+
+// update applies f to current preview of the player and returns copy of updated preview.
+// Returns apperr.PreviewNotFound if player has no preview.
+func (s *Service) update(ctx context.Context, f func(*entity.Preview) error) (*entity.Preview, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return nil, apperr.Unauthorized
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.previews[nickname]
+	if !ok {
+		return nil, apperr.PreviewNotFound
+	}
+
+	if err := f(p); err != nil {
+		return nil, err
+	}
+
+	return p.Clone(), nil
+}
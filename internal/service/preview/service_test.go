@@ -0,0 +1,243 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+const (
+	author = "author"
+	packID = int32(1)
+)
+
+var errRepo = errors.New("repository error")
+
+type roundRepositoryMock struct {
+	rounds []entity.Round
+	err    error
+}
+
+func (m roundRepositoryMock) GetAll(context.Context, int32) ([]entity.Round, error) {
+	return m.rounds, m.err
+}
+
+type roundQuestionRepositoryMock map[int32][]entity.RoundQuestionDetailed
+
+func (m roundQuestionRepositoryMock) GetAll(_ context.Context, roundID int32) ([]entity.RoundQuestionDetailed, error) {
+	return m[roundID], nil
+}
+
+type packServiceMock struct {
+	err error
+}
+
+func (m packServiceMock) VerifyAuthorship(context.Context, int32) error {
+	return m.err
+}
+
+func testService(r roundRepositoryMock, ps packServiceMock) *Service {
+	rq := roundQuestionRepositoryMock{
+		1: {{RoundQuestion: entity.RoundQuestion{ID: 1, Type: entity.QTypeStandard}}},
+	}
+
+	return NewService(r, rq, ps)
+}
+
+func TestService_Start(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		nickname  string
+		r         roundRepositoryMock
+		ps        packServiceMock
+		wantStage entity.PreviewStage
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			nickname:  author,
+			r:         roundRepositoryMock{rounds: []entity.Round{{ID: 1, PackID: packID}}},
+			wantStage: entity.PreviewStageRoundStart,
+		},
+		{
+			name:      "pack without rounds",
+			nickname:  author,
+			r:         roundRepositoryMock{err: apperr.PackNotFound},
+			wantStage: entity.PreviewStageFinished,
+		},
+		{
+			name:    "unauthorized",
+			wantErr: apperr.Unauthorized,
+		},
+		{
+			name:     "not author",
+			nickname: "player",
+			ps:       packServiceMock{err: apperr.PackNotAuthor},
+			wantErr:  apperr.PackNotAuthor,
+		},
+		{
+			name:     "repository error",
+			nickname: author,
+			r:        roundRepositoryMock{err: errRepo},
+			wantErr:  errRepo,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.nickname != "" {
+				ctx = context.WithValue(ctx, appctx.NicknameKey{}, tt.nickname)
+			}
+
+			s := testService(tt.r, tt.ps)
+
+			p, err := s.Start(ctx, packID)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr != nil {
+				assert.Nil(t, p)
+				return
+			}
+
+			assert.Equal(t, packID, p.PackID)
+			assert.Equal(t, tt.wantStage, p.Stage)
+
+			got, err := s.Get(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, p, got)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		started   bool
+		do        func(context.Context, *Service) (*entity.Preview, error)
+		wantStage entity.PreviewStage
+		wantErr   error
+	}{
+		{
+			name:    "next",
+			started: true,
+			do: func(ctx context.Context, s *Service) (*entity.Preview, error) {
+				return s.Next(ctx)
+			},
+			wantStage: entity.PreviewStageGrid,
+		},
+		{
+			name:    "select question",
+			started: true,
+			do: func(ctx context.Context, s *Service) (*entity.Preview, error) {
+				if _, err := s.Next(ctx); err != nil {
+					return nil, err
+				}
+
+				return s.SelectQuestion(ctx, 1)
+			},
+			wantStage: entity.PreviewStageQuestion,
+		},
+		{
+			name:    "invalid transition",
+			started: true,
+			do: func(ctx context.Context, s *Service) (*entity.Preview, error) {
+				return s.SelectQuestion(ctx, 1)
+			},
+			wantStage: entity.PreviewStageRoundStart,
+			wantErr:   entity.ErrPreviewNotGrid,
+		},
+		{
+			name: "next without preview",
+			do: func(ctx context.Context, s *Service) (*entity.Preview, error) {
+				return s.Next(ctx)
+			},
+			wantErr: apperr.PreviewNotFound,
+		},
+		{
+			name: "select question without preview",
+			do: func(ctx context.Context, s *Service) (*entity.Preview, error) {
+				return s.SelectQuestion(ctx, 1)
+			},
+			wantErr: apperr.PreviewNotFound,
+		},
+		{
+			name: "get without preview",
+			do: func(ctx context.Context, s *Service) (*entity.Preview, error) {
+				return s.Get(ctx)
+			},
+			wantErr: apperr.PreviewNotFound,
+		},
+		{
+			name:    "stopped",
+			started: true,
+			do: func(ctx context.Context, s *Service) (*entity.Preview, error) {
+				if err := s.Stop(ctx); err != nil {
+					return nil, err
+				}
+
+				return s.Get(ctx)
+			},
+			wantErr: apperr.PreviewNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.WithValue(context.Background(), appctx.NicknameKey{}, author)
+			s := testService(roundRepositoryMock{rounds: []entity.Round{{ID: 1, PackID: packID}}}, packServiceMock{})
+
+			if tt.started {
+				_, err := s.Start(ctx, packID)
+				require.NoError(t, err)
+			}
+
+			p, err := tt.do(ctx, s)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr != nil {
+				assert.Nil(t, p)
+			} else {
+				assert.Equal(t, tt.wantStage, p.Stage)
+			}
+
+			// failed transition doesn't change stored preview
+			if tt.started && tt.wantErr != nil && !errors.Is(tt.wantErr, apperr.PreviewNotFound) {
+				got, err := s.Get(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantStage, got.Stage)
+			}
+		})
+	}
+}
+
+func TestService_Stop(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), appctx.NicknameKey{}, author)
+	s := testService(roundRepositoryMock{}, packServiceMock{})
+
+	require.ErrorIs(t, s.Stop(context.Background()), apperr.Unauthorized)
+	require.ErrorIs(t, s.Stop(ctx), apperr.PreviewNotFound)
+
+	_, err := s.Start(ctx, packID)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Stop(ctx))
+	require.ErrorIs(t, s.Stop(ctx), apperr.PreviewNotFound)
+}
@@ -0,0 +1,51 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Start starts preview of the pack for its author, previous preview of the author is replaced.
+func (s *Service) Start(ctx context.Context, packID int32) (*entity.Preview, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return nil, apperr.Unauthorized
+	}
+
+	if err := s.pack.VerifyAuthorship(ctx, packID); err != nil {
+		return nil, err
+	}
+
+	rounds, err := s.round.GetAll(ctx, packID)
+	if err != nil && !errors.Is(err, apperr.PackNotFound) {
+		return nil, fmt.Errorf("error getting rounds: %w", err)
+	}
+
+	previewRounds := make([]entity.PreviewRound, len(rounds))
+
+	for i, r := range rounds {
+		qq, err := s.roundQuestion.GetAll(ctx, r.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting round questions: %w", err)
+		}
+
+		previewRounds[i] = entity.PreviewRound{
+			Round:     r,
+			Questions: qq,
+		}
+	}
+
+	p := entity.NewPreview(packID, previewRounds)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.previews[nickname] = p
+
+	return p.Clone(), nil
+}
@@ -0,0 +1,27 @@
+package preview
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Stop deletes current preview of the player.
+func (s *Service) Stop(ctx context.Context) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.previews[nickname]; !ok {
+		return apperr.PreviewNotFound
+	}
+
+	delete(s.previews, nickname)
+
+	return nil
+}
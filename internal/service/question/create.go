@@ -0,0 +1,35 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Maximum amount of similar questions returned on question creation.
+const similarLimit = 5
+
+// Create saves new question and returns existing questions similar to it
+// which are visible to author of the question.
+// If strict is true and similar questions found, question is not saved and
+// apperr.QuestionAlreadyExists is returned together with similar questions.
+func (s *Service) Create(
+	ctx context.Context, q *entity.Question, strict bool) (int32, []entity.SimilarQuestion, error) {
+	similar, err := s.repo.GetSimilar(ctx, q.Text, q.Answer.Text, q.Author, similarLimit)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error getting similar questions: %w", err)
+	}
+
+	if strict && len(similar) > 0 {
+		return 0, similar, apperr.QuestionAlreadyExists
+	}
+
+	questionID, err := s.repo.Save(ctx, q)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error saving question: %w", err)
+	}
+
+	return questionID, similar, nil
+}
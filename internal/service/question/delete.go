@@ -0,0 +1,18 @@
+package question
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *Service) Delete(ctx context.Context, questionID int32) error {
+	if err := s.verifyAuthorship(ctx, questionID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteOne(ctx, questionID); err != nil {
+		return fmt.Errorf("error deleting question: %w", err)
+	}
+
+	return nil
+}
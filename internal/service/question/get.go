@@ -0,0 +1,52 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Get returns question if current user is its author or can read any pack the question is used in,
+// answer is visible only to author of the question.
+// Returns apperr.QuestionNotFound if question is not readable.
+func (s *Service) Get(ctx context.Context, questionID int32, shareToken string) (*entity.Question, error) {
+	q, err := s.repo.GetOne(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting question: %w", err)
+	}
+
+	if nickname, ok := appctx.GetNickname(ctx); ok && q.Author == nickname {
+		return q, nil
+	}
+
+	usages, err := s.repo.GetAllUsages(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting question usages: %w", err)
+	}
+
+	checked := make(map[int32]struct{}, len(usages))
+
+	for _, u := range usages {
+		if _, ok := checked[u.PackID]; ok {
+			continue
+		}
+
+		checked[u.PackID] = struct{}{}
+
+		err := s.pack.VerifyReadAccess(ctx, u.PackID, shareToken)
+		if err == nil {
+			q.HideAnswer()
+			return q, nil
+		}
+
+		if !errors.Is(err, apperr.PackNotFound) {
+			return nil, err
+		}
+	}
+
+	return nil, apperr.QuestionNotFound
+}
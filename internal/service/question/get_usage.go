@@ -0,0 +1,23 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// GetUsage returns packs and rounds where question is used,
+// only author of the question is allowed to see them.
+func (s *Service) GetUsage(ctx context.Context, questionID int32) ([]entity.QuestionUsage, error) {
+	if err := s.verifyAuthorship(ctx, questionID); err != nil {
+		return nil, err
+	}
+
+	usages, err := s.repo.GetAllUsages(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting question usages: %w", err)
+	}
+
+	return usages, nil
+}
@@ -0,0 +1,22 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+)
+
+// Search returns questions of current user and questions used in public packs found by query.
+func (s *Service) Search(
+	ctx context.Context, query string, lang entity.Language, limit uint64) ([]entity.Question, error) {
+	nickname, _ := appctx.GetNickname(ctx)
+
+	qq, err := s.repo.FullTextSearch(ctx, query, lang, nickname, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error searching questions: %w", err)
+	}
+
+	return qq, nil
+}
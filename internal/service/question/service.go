@@ -0,0 +1,33 @@
+package question
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+type repository interface {
+	Save(ctx context.Context, q *entity.Question) (int32, error)
+	GetSimilar(ctx context.Context, text, answer, author string, limit uint64) ([]entity.SimilarQuestion, error)
+	GetOne(ctx context.Context, questionID int32) (*entity.Question, error)
+	GetAllUsages(ctx context.Context, questionID int32) ([]entity.QuestionUsage, error)
+	UpdateOne(ctx context.Context, q *entity.Question) error
+	DeleteOne(ctx context.Context, questionID int32) error
+	FullTextSearch(ctx context.Context, query string, lang entity.Language, author string, limit uint64) ([]entity.Question, error)
+}
+
+type packService interface {
+	VerifyReadAccess(ctx context.Context, packID int32, shareToken string) error
+}
+
+type Service struct {
+	repo repository
+	pack packService
+}
+
+func NewService(r repository, ps packService) *Service {
+	return &Service{
+		repo: r,
+		pack: ps,
+	}
+}
@@ -0,0 +1,20 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+func (s *Service) Update(ctx context.Context, q *entity.Question) error {
+	if err := s.verifyAuthorship(ctx, q.ID); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateOne(ctx, q); err != nil {
+		return fmt.Errorf("error updating question: %w", err)
+	}
+
+	return nil
+}
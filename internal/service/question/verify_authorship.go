@@ -0,0 +1,28 @@
+package question
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// verifyAuthorship returns no error if current user from session is author of question.
+func (s *Service) verifyAuthorship(ctx context.Context, questionID int32) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	q, err := s.repo.GetOne(ctx, questionID)
+	if err != nil {
+		return fmt.Errorf("error getting question: %w", err)
+	}
+
+	if q.Author != nickname {
+		return apperr.QuestionNotAuthor
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package room
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/argon2"
+)
+
+const roomIDLen = 12
+
+// Create creates room with published pack, random pack is used if room pack is not specified.
+// Current user becomes owner of the room and joins it with provided role.
+func (s *Service) Create(
+	ctx context.Context, room *entity.Room, password string, role entity.RoomRole, shareToken string) (*entity.Room, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return nil, apperr.Unauthorized
+	}
+
+	if err := room.Settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	room.PackID, err = s.playablePack(ctx, room.PackID, shareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, roomIDLen)
+
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, fmt.Errorf("error generating room id: %w", err)
+	}
+
+	room.ID = base64.RawURLEncoding.EncodeToString(b)
+	room.Owner = nickname
+	room.CreateTime = time.Now()
+
+	if password != "" {
+		room.PasswordHash, err = argon2.GenerateFromPassword(password, argon2.DefaultParams)
+		if err != nil {
+			return nil, fmt.Errorf("error hashing room password: %w", err)
+		}
+	}
+
+	if err := room.Join(nickname, role, room.CreateTime); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Save(ctx, room); err != nil {
+		return nil, fmt.Errorf("error saving room: %w", err)
+	}
+
+	return room, nil
+}
+
+// playablePack returns id of the pack if it can be played or id of random pack if packID is not specified.
+func (s *Service) playablePack(ctx context.Context, packID int32, shareToken string) (int32, error) {
+	if packID == 0 {
+		p, err := s.pack.GetRandom(ctx)
+		if err != nil {
+			if errors.Is(err, apperr.PackNotFound) {
+				return 0, apperr.RoomNoPlayablePacks
+			}
+
+			return 0, fmt.Errorf("error getting random pack: %w", err)
+		}
+
+		return p.ID, nil
+	}
+
+	p, err := s.packSvc.Get(ctx, packID, shareToken)
+	if err != nil {
+		return 0, fmt.Errorf("error getting pack: %w", err)
+	}
+
+	if !p.Published || p.Hidden {
+		return 0, apperr.RoomPackNotPlayable
+	}
+
+	return p.ID, nil
+}
@@ -0,0 +1,46 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/argon2"
+)
+
+// Join adds current user to the room, password is required if room has one.
+// Players already in the room are not checked for password.
+func (s *Service) Join(ctx context.Context, roomID, password string, role entity.RoomRole) (*entity.Room, error) {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return nil, apperr.Unauthorized
+	}
+
+	room, err := s.repo.GetOne(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting room: %w", err)
+	}
+
+	if _, joined := room.Member(nickname); !joined && room.HasPassword() {
+		ok, err := argon2.CompareHashAndPassword(password, room.PasswordHash)
+		if err != nil {
+			return nil, fmt.Errorf("error comparing room password: %w", err)
+		}
+
+		if !ok {
+			return nil, apperr.RoomWrongPassword
+		}
+	}
+
+	room, err = s.repo.UpdateOne(ctx, roomID, func(r *entity.Room) error {
+		return r.Join(nickname, role, time.Now())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error joining room: %w", err)
+	}
+
+	return room, nil
+}
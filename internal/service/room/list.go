@@ -0,0 +1,19 @@
+package room
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+)
+
+// List returns rooms which names contain query, all rooms are returned if query is empty.
+func (s *Service) List(ctx context.Context, query string, p paging.Params) (paging.List[entity.Room], error) {
+	rooms, err := s.repo.GetAll(ctx, query, p)
+	if err != nil {
+		return paging.List[entity.Room]{}, fmt.Errorf("error getting rooms: %w", err)
+	}
+
+	return rooms, nil
+}
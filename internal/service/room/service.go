@@ -0,0 +1,38 @@
+package room
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+)
+
+// repository is a registry of rooms, UpdateOne must apply update atomically.
+type repository interface {
+	Save(context.Context, *entity.Room) error
+	GetOne(ctx context.Context, roomID string) (*entity.Room, error)
+	GetAll(ctx context.Context, query string, p paging.Params) (paging.List[entity.Room], error)
+	UpdateOne(ctx context.Context, roomID string, update func(*entity.Room) error) (*entity.Room, error)
+}
+
+type packRepository interface {
+	GetRandom(context.Context) (*entity.Pack, error)
+}
+
+type packService interface {
+	Get(ctx context.Context, packID int32, shareToken string) (*entity.PackWithTags, error)
+}
+
+type Service struct {
+	repo    repository
+	pack    packRepository
+	packSvc packService
+}
+
+func NewService(r repository, pr packRepository, ps packService) *Service {
+	return &Service{
+		repo:    r,
+		pack:    pr,
+		packSvc: ps,
+	}
+}
@@ -0,0 +1,22 @@
+package round
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+// List returns rounds of the pack if current user can read it.
+func (s *Service) List(ctx context.Context, packID int32, shareToken string) ([]entity.Round, error) {
+	if err := s.pack.VerifyReadAccess(ctx, packID, shareToken); err != nil {
+		return nil, err
+	}
+
+	rounds, err := s.repo.GetAll(ctx, packID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rounds: %w", err)
+	}
+
+	return rounds, nil
+}
@@ -0,0 +1,24 @@
+package round
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *Service) MoveTopic(ctx context.Context, roundID, topicID, dstRoundID int32, pos int16) error {
+	if err := s.pack.VerifyRoundAuthorship(ctx, roundID); err != nil {
+		return fmt.Errorf("error verifying round authorship: %w", err)
+	}
+
+	if dstRoundID != roundID {
+		if err := s.pack.VerifyRoundAuthorship(ctx, dstRoundID); err != nil {
+			return fmt.Errorf("error verifying destination round authorship: %w", err)
+		}
+	}
+
+	if err := s.roundTopic.MoveOne(ctx, roundID, topicID, dstRoundID, pos); err != nil {
+		return fmt.Errorf("error moving round topic: %w", err)
+	}
+
+	return nil
+}
@@ -9,17 +9,20 @@ import (
 type packService interface {
 	VerifyAuthorship(ctx context.Context, packID int32) error
 	VerifyRoundAuthorship(ctx context.Context, roundID int32) error
+	VerifyReadAccess(ctx context.Context, packID int32, shareToken string) error
 }
 
 type roundTopicService interface {
 	GetAll(ctx context.Context, roundID int32) ([]entity.Topic, error)
 	Save(ctx context.Context, roundID, topicID int32) (int32, error)
 	DeleteOne(ctx context.Context, roundID, topicID int32) error
+	MoveOne(ctx context.Context, roundID, topicID, dstRoundID int32, pos int16) error
 }
 
 type repository interface {
 	Save(ctx context.Context, round entity.Round) (int32, error)
 	UpdateOne(context.Context, entity.Round) error
+	GetAll(ctx context.Context, packID int32) ([]entity.Round, error)
 }
 
 type Service struct {
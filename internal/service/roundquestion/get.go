@@ -0,0 +1,37 @@
+package roundquestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Get returns round question if current user can read its pack,
+// answer and host comment are visible only to author of the pack.
+func (s *Service) Get(ctx context.Context, id int32, shareToken string) (*entity.RoundQuestionDetailed, error) {
+	q, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting round question: %w", err)
+	}
+
+	if err := s.pack.VerifyRoundReadAccess(ctx, q.RoundID, shareToken); err != nil {
+		if errors.Is(err, apperr.RoundNotFound) {
+			return nil, apperr.RoundQuestionNotFound
+		}
+
+		return nil, err
+	}
+
+	if err := s.pack.VerifyRoundAuthorship(ctx, q.RoundID); err != nil {
+		if !errors.Is(err, apperr.PackNotAuthor) && !errors.Is(err, apperr.Unauthorized) {
+			return nil, err
+		}
+
+		q.HideAnswer()
+	}
+
+	return q, nil
+}
@@ -0,0 +1,29 @@
+package roundquestion
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *Service) Move(ctx context.Context, id, dstRoundID, dstTopicID int32, pos int16) error {
+	q, err := s.repo.GetOne(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error getting round question: %w", err)
+	}
+
+	if err := s.pack.VerifyRoundAuthorship(ctx, q.RoundID); err != nil {
+		return fmt.Errorf("error verifying round authorship: %w", err)
+	}
+
+	if dstRoundID != q.RoundID {
+		if err := s.pack.VerifyRoundAuthorship(ctx, dstRoundID); err != nil {
+			return fmt.Errorf("error verifying destination round authorship: %w", err)
+		}
+	}
+
+	if err := s.repo.MoveOne(ctx, id, dstRoundID, dstTopicID, pos); err != nil {
+		return fmt.Errorf("error moving round question: %w", err)
+	}
+
+	return nil
+}
@@ -8,14 +8,23 @@ import (
 
 type repository interface {
 	Save(ctx context.Context, round *entity.RoundQuestion) (int32, error)
+	GetOne(ctx context.Context, id int32) (*entity.RoundQuestionDetailed, error)
+	MoveOne(ctx context.Context, id, dstRoundID, dstTopicID int32, pos int16) error
+}
+
+type packService interface {
+	VerifyRoundAuthorship(ctx context.Context, roundID int32) error
+	VerifyRoundReadAccess(ctx context.Context, roundID int32, shareToken string) error
 }
 
 type Service struct {
 	repo repository
+	pack packService
 }
 
-func NewService(r repository) *Service {
+func NewService(r repository, ps packService) *Service {
 	return &Service{
 		repo: r,
+		pack: ps,
 	}
 }
@@ -0,0 +1,28 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// Ban bans tag name, only admin is allowed to ban tags.
+func (s *Service) Ban(ctx context.Context, name string) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	if err := s.player.VerifyAdmin(ctx); err != nil {
+		return fmt.Errorf("error verifying admin: %w", err)
+	}
+
+	if err := s.repo.SaveBanned(ctx, name, nickname, time.Now()); err != nil {
+		return fmt.Errorf("error banning tag: %w", err)
+	}
+
+	return nil
+}
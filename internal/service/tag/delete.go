@@ -0,0 +1,18 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *Service) Delete(ctx context.Context, name string) error {
+	if err := s.verifyAuthorship(ctx, name); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteOne(ctx, name); err != nil {
+		return fmt.Errorf("error deleting tag: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Merge merges src tags into dst tag, only admin is allowed to merge tags.
+func (s *Service) Merge(ctx context.Context, src []string, dst string) error {
+	if err := s.player.VerifyAdmin(ctx); err != nil {
+		return fmt.Errorf("error verifying admin: %w", err)
+	}
+
+	if err := s.repo.MergeAll(ctx, src, dst, time.Now()); err != nil {
+		return fmt.Errorf("error merging tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package tag
+
+import (
+	"context"
+	"time"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+type repository interface {
+	GetOne(ctx context.Context, name string) (*entity.Tag, error)
+	UpdateOne(ctx context.Context, name, newName string) error
+	DeleteOne(ctx context.Context, name string) error
+	MergeAll(ctx context.Context, src []string, dst string, mergeTime time.Time) error
+	SaveBanned(ctx context.Context, name, bannedBy string, banTime time.Time) error
+}
+
+type playerService interface {
+	VerifyAdmin(ctx context.Context) error
+}
+
+type Service struct {
+	repo   repository
+	player playerService
+}
+
+func NewService(r repository, ps playerService) *Service {
+	return &Service{
+		repo:   r,
+		player: ps,
+	}
+}
@@ -0,0 +1,18 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *Service) Update(ctx context.Context, name, newName string) error {
+	if err := s.verifyAuthorship(ctx, name); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateOne(ctx, name, newName); err != nil {
+		return fmt.Errorf("error updating tag: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// verifyAuthorship returns no error if current user from session is author of tag.
+func (s *Service) verifyAuthorship(ctx context.Context, name string) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	t, err := s.repo.GetOne(ctx, name)
+	if err != nil {
+		return fmt.Errorf("error getting tag: %w", err)
+	}
+
+	if t.Author != nickname {
+		return apperr.TagNotAuthor
+	}
+
+	return nil
+}
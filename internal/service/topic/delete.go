@@ -0,0 +1,18 @@
+package topic
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *Service) Delete(ctx context.Context, topicID int32) error {
+	if err := s.verifyAuthorship(ctx, topicID); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteOne(ctx, topicID); err != nil {
+		return fmt.Errorf("error deleting topic: %w", err)
+	}
+
+	return nil
+}
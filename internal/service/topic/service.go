@@ -0,0 +1,23 @@
+package topic
+
+import (
+	"context"
+
+	"github.com/ysomad/answersuck/internal/entity"
+)
+
+type repository interface {
+	GetOne(ctx context.Context, topicID int32) (*entity.Topic, error)
+	UpdateOne(ctx context.Context, topicID int32, title string) error
+	DeleteOne(ctx context.Context, topicID int32) error
+}
+
+type Service struct {
+	repo repository
+}
+
+func NewService(r repository) *Service {
+	return &Service{
+		repo: r,
+	}
+}
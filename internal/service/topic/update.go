@@ -0,0 +1,18 @@
+package topic
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *Service) Update(ctx context.Context, topicID int32, title string) error {
+	if err := s.verifyAuthorship(ctx, topicID); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateOne(ctx, topicID, title); err != nil {
+		return fmt.Errorf("error updating topic: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package topic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+)
+
+// verifyAuthorship returns no error if current user from session is author of topic.
+func (s *Service) verifyAuthorship(ctx context.Context, topicID int32) error {
+	nickname, ok := appctx.GetNickname(ctx)
+	if !ok {
+		return apperr.Unauthorized
+	}
+
+	t, err := s.repo.GetOne(ctx, topicID)
+	if err != nil {
+		return fmt.Errorf("error getting topic: %w", err)
+	}
+
+	if t.Author != nickname {
+		return apperr.TopicNotAuthor
+	}
+
+	return nil
+}
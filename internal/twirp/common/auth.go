@@ -23,3 +23,14 @@ func CheckPlayerVerification(ctx context.Context) (*session.Session, error) {
 
 	return s, nil
 }
+
+// CheckPlayerAuth verifies that request is authorized, player may be not verified.
+// Returns twirp errors.
+func CheckPlayerAuth(ctx context.Context) (*session.Session, error) {
+	s, ok := appctx.GetSession(ctx)
+	if !ok {
+		return nil, twirp.Unauthenticated.Error(apperr.MsgUnauthorized)
+	}
+
+	return s, nil
+}
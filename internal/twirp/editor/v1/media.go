@@ -3,8 +3,10 @@ package v1
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ysomad/answersuck/internal/entity"
 	pb "github.com/ysomad/answersuck/internal/gen/api/editor/v1"
@@ -62,6 +64,10 @@ func (h *MediaHandler) UploadMedia(ctx context.Context,
 		return nil, twirp.InvalidArgument.Error(err.Error())
 	}
 
+	if media.Type != entity.MediaTypeImage {
+		media.Duration = r.Duration.AsDuration()
+	}
+
 	answer, err := h.media.Save(ctx, media)
 	if err != nil {
 		return nil, twirp.InternalError(err.Error())
@@ -69,9 +75,38 @@ func (h *MediaHandler) UploadMedia(ctx context.Context,
 
 	return &pb.UploadMediaResponse{
 		Media: &pb.Media{
-			Url:    answer.URL,
-			Type:   pb.MediaType(answer.Type),
-			Author: answer.Uploader,
+			Url:      answer.URL,
+			Type:     pb.MediaType(answer.Type),
+			Author:   answer.Uploader,
+			Duration: newPBDuration(answer.Duration),
 		},
 	}, nil
 }
+
+// newPBDuration returns nil for zero duration.
+func newPBDuration(d time.Duration) *durationpb.Duration {
+	if d == 0 {
+		return nil
+	}
+
+	return durationpb.New(d)
+}
+
+func newMediaClip(c *pb.MediaClip) entity.MediaClip {
+	return entity.MediaClip{
+		Start: c.GetStart().AsDuration(),
+		End:   c.GetEnd().AsDuration(),
+	}
+}
+
+// newPBMediaClip returns nil for zero clip.
+func newPBMediaClip(c entity.MediaClip) *pb.MediaClip {
+	if c.IsZero() {
+		return nil
+	}
+
+	return &pb.MediaClip{
+		Start: durationpb.New(c.Start),
+		End:   newPBDuration(c.End),
+	}
+}
@@ -4,14 +4,17 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ysomad/answersuck/internal/entity"
 	pb "github.com/ysomad/answersuck/internal/gen/api/editor/v1"
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
 	"github.com/ysomad/answersuck/internal/pkg/session"
 	apptwirp "github.com/ysomad/answersuck/internal/twirp"
 	"github.com/ysomad/answersuck/internal/twirp/common"
@@ -26,7 +29,14 @@ var (
 
 type PackUseCase interface {
 	Save(ctx context.Context, p *entity.Pack, tags []string) (packID int32, err error)
-	GetWithTags(ctx context.Context, packID int32) (*entity.PackWithTags, error)
+	Get(ctx context.Context, packID int32, shareToken string) (*entity.PackWithTags, error)
+	GetAll(context.Context, entity.PackFilter, paging.Params) (paging.List[entity.Pack], error)
+	Publish(ctx context.Context, packID int32) (*entity.PackWithStats, error)
+	SetVisibility(ctx context.Context, packID int32, v entity.PackVisibility) error
+	CreateShareToken(ctx context.Context, packID int32) (entity.PackShareToken, error)
+	ListShareTokens(ctx context.Context, packID int32) ([]entity.PackShareToken, error)
+	RevokeShareToken(ctx context.Context, token string) error
+	Rate(ctx context.Context, packID int32, rating int16, shareToken string) error
 }
 
 type PackHandler struct {
@@ -67,11 +77,16 @@ func (h *PackHandler) CreatePack(
 		Name:       r.PackName,
 		Author:     session.User.ID,
 		CoverURL:   r.CoverUrl,
+		Language:   newLanguage(r.Language),
+		Visibility: newPackVisibility(r.Visibility),
 		CreateTime: time.Now(),
 	}, r.Tags)
 	if err != nil {
-		if errors.Is(err, apperr.MediaNotFound) {
+		switch {
+		case errors.Is(err, apperr.MediaNotFound):
 			return nil, twirp.InvalidArgument.Error(apperr.MsgPackCoverNotFound)
+		case errors.Is(err, apperr.TagBanned):
+			return nil, twirp.InvalidArgumentError("tags", apperr.MsgTagBanned)
 		}
 
 		return nil, twirp.InternalError(err.Error())
@@ -86,7 +101,7 @@ func (h *PackHandler) GetPack(
 		return nil, twirp.RequiredArgumentError("pack_id")
 	}
 
-	p, err := h.pack.GetWithTags(ctx, r.PackId)
+	p, err := h.pack.Get(ctx, r.PackId, r.ShareToken)
 	if err != nil {
 		if errors.Is(err, apperr.PackNotFound) {
 			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
@@ -96,20 +111,289 @@ func (h *PackHandler) GetPack(
 	}
 
 	return &pb.GetPackResponse{
-		Pack: &pb.Pack{
-			Id:          p.ID,
-			Name:        p.Name,
-			Author:      p.Author,
-			IsPublished: p.Published,
-			CoverUrl:    p.CoverURL,
-			CreateTime:  timestamppb.New(p.CreateTime),
-		},
+		Pack: newPBPack(p.Pack),
 		Tags: p.Tags,
 	}, nil
 }
 
+func (h *PackHandler) ListPacks(
+	ctx context.Context, r *pb.ListPacksRequest) (*pb.ListPacksResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	packList, err := h.pack.GetAll(ctx, entity.PackFilter{
+		Query:    strings.TrimSpace(r.Query),
+		Language: entity.Language(r.Language),
+	}, paging.Params{
+		PageSize:  r.PageSize,
+		PageToken: r.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, paging.ErrInvalidToken) {
+			return nil, twirp.InvalidArgumentError("page_token", err.Error())
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	packs := make([]*pb.Pack, len(packList.Items))
+
+	for i, p := range packList.Items {
+		packs[i] = newPBPack(p)
+	}
+
+	return &pb.ListPacksResponse{
+		Packs:         packs,
+		NextPageToken: packList.NextPageToken,
+	}, nil
+}
+
 func (h *PackHandler) PublishPack(
 	ctx context.Context,
 	r *pb.PublishPackRequest) (*pb.PublishPackResponse, error) {
-	return nil, nil
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackageId == 0 {
+		return nil, twirp.RequiredArgumentError("package_id")
+	}
+
+	p, err := h.pack.Publish(ctx, r.PackageId)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		case errors.Is(err, apperr.PackAuthorBanned):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackAuthorBanned)
+		case errors.Is(err, apperr.PackPublished):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgPackPublished)
+		case errors.Is(err, entity.ErrPackRoundCount),
+			errors.Is(err, entity.ErrPackTopicCount),
+			errors.Is(err, entity.ErrPackQuestionCount):
+			return nil, twirp.FailedPrecondition.Error(err.Error())
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &pb.PublishPackResponse{
+		Pack: &pb.PackWithStats{
+			Pack: newPBPack(p.Pack),
+			Stats: &pb.PackStats{
+				RoundCount:    int32(p.Stats.RoundCount),
+				TopicCount:    int32(p.Stats.TopicCount),
+				QuestionCount: int32(p.Stats.QuestionCount),
+				VideoCount:    int32(p.Stats.VideoCount),
+				AudioCount:    int32(p.Stats.AudioCount),
+				ImageCount:    int32(p.Stats.ImageCount),
+			},
+		},
+	}, nil
+}
+
+func (h *PackHandler) SetPackVisibility(
+	ctx context.Context,
+	r *pb.SetPackVisibilityRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackId == 0 {
+		return nil, twirp.RequiredArgumentError("pack_id")
+	}
+
+	if r.Visibility == pb.PackVisibility_PACK_VISIBILITY_UNSPECIFIED {
+		return nil, twirp.RequiredArgumentError("visibility")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.pack.SetVisibility(ctx, r.PackId, entity.PackVisibility(r.Visibility)); err != nil {
+		switch {
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PackHandler) CreateShareToken(
+	ctx context.Context,
+	r *pb.CreateShareTokenRequest) (*pb.CreateShareTokenResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackId == 0 {
+		return nil, twirp.RequiredArgumentError("pack_id")
+	}
+
+	t, err := h.pack.CreateShareToken(ctx, r.PackId)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &pb.CreateShareTokenResponse{ShareToken: newPBShareToken(t)}, nil
+}
+
+func (h *PackHandler) ListShareTokens(
+	ctx context.Context,
+	r *pb.ListShareTokensRequest) (*pb.ListShareTokensResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackId == 0 {
+		return nil, twirp.RequiredArgumentError("pack_id")
+	}
+
+	tt, err := h.pack.ListShareTokens(ctx, r.PackId)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	tokens := make([]*pb.ShareToken, len(tt))
+
+	for i, t := range tt {
+		tokens[i] = newPBShareToken(t)
+	}
+
+	return &pb.ListShareTokensResponse{ShareTokens: tokens}, nil
+}
+
+func (h *PackHandler) RevokeShareToken(
+	ctx context.Context,
+	r *pb.RevokeShareTokenRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.Token == "" {
+		return nil, twirp.RequiredArgumentError("token")
+	}
+
+	if err := h.pack.RevokeShareToken(ctx, r.Token); err != nil {
+		switch {
+		case errors.Is(err, apperr.PackShareTokenNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackShareTokenNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PackHandler) RatePack(
+	ctx context.Context,
+	r *pb.RatePackRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackId == 0 {
+		return nil, twirp.RequiredArgumentError("pack_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.pack.Rate(ctx, r.PackId, int16(r.Rating), r.ShareToken); err != nil {
+		switch {
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.PackRatedByAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackRatedByAuthor)
+		case errors.Is(err, apperr.PackNotPlayed):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgPackNotPlayed)
+		case errors.Is(err, apperr.PackInvalidRating):
+			return nil, twirp.InvalidArgumentError("rating", apperr.MsgPackInvalidRating)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func newPBPack(p entity.Pack) *pb.Pack {
+	return &pb.Pack{
+		Id:          p.ID,
+		Name:        p.Name,
+		Author:      p.Author,
+		IsPublished: p.Published,
+		IsHidden:    p.Hidden,
+		CoverUrl:    p.CoverURL,
+		Language:    pb.Language(p.Language),
+		Visibility:  pb.PackVisibility(p.Visibility),
+		CreateTime:  timestamppb.New(p.CreateTime),
+	}
+}
+
+// newPackVisibility returns entity.PackVisibilityPublic if visibility is not specified.
+func newPackVisibility(v pb.PackVisibility) entity.PackVisibility {
+	if v == pb.PackVisibility_PACK_VISIBILITY_UNSPECIFIED {
+		return entity.PackVisibilityPublic
+	}
+
+	return entity.PackVisibility(v)
+}
+
+func newPBShareToken(t entity.PackShareToken) *pb.ShareToken {
+	return &pb.ShareToken{
+		Token:      t.Token,
+		PackId:     t.PackID,
+		CreateTime: timestamppb.New(t.CreateTime),
+	}
+}
+
+// newLanguage returns entity.DefaultLanguage if language is not specified.
+func newLanguage(l pb.Language) entity.Language {
+	if l == pb.Language_LANGUAGE_UNSPECIFIED {
+		return entity.DefaultLanguage
+	}
+
+	return entity.Language(l)
+}
+
+// parseLanguage returns language of the request or twirp invalid argument error if language is unknown,
+// unspecified language is returned as 0.
+func parseLanguage(l pb.Language) (entity.Language, error) {
+	if l == pb.Language_LANGUAGE_UNSPECIFIED {
+		return 0, nil
+	}
+
+	if lang := entity.Language(l); lang.Valid() {
+		return lang, nil
+	}
+
+	return 0, twirp.InvalidArgumentError("language", "unknown language")
 }
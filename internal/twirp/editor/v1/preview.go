@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ysomad/answersuck/internal/entity"
+	pb "github.com/ysomad/answersuck/internal/gen/api/editor/v1"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/common"
+	"github.com/ysomad/answersuck/internal/twirp/hooks"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+)
+
+var (
+	_ apptwirp.Handler  = &PreviewHandler{}
+	_ pb.PreviewService = &PreviewHandler{}
+)
+
+type PreviewUseCase interface {
+	Start(ctx context.Context, packID int32) (*entity.Preview, error)
+	Get(context.Context) (*entity.Preview, error)
+	Next(context.Context) (*entity.Preview, error)
+	SelectQuestion(ctx context.Context, roundQuestionID int32) (*entity.Preview, error)
+	Stop(context.Context) error
+}
+
+type PreviewHandler struct {
+	preview PreviewUseCase
+	session *session.Manager
+}
+
+func NewPreviewHandler(uc PreviewUseCase, sm *session.Manager) *PreviewHandler {
+	return &PreviewHandler{
+		preview: uc,
+		session: sm,
+	}
+}
+
+func (h *PreviewHandler) Handle(m *http.ServeMux) {
+	s := pb.NewPreviewServiceServer(h,
+		twirp.WithServerHooks(hooks.WithSession(h.session)))
+	m.Handle(s.PathPrefix(), middleware.WithSessionID(s))
+}
+
+func (h *PreviewHandler) StartPreview(
+	ctx context.Context,
+	r *pb.StartPreviewRequest) (*pb.StartPreviewResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackId == 0 {
+		return nil, twirp.RequiredArgumentError("pack_id")
+	}
+
+	p, err := h.preview.Start(ctx, r.PackId)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &pb.StartPreviewResponse{Preview: newPBPreview(p)}, nil
+}
+
+func (h *PreviewHandler) GetPreview(
+	ctx context.Context,
+	_ *pb.GetPreviewRequest) (*pb.GetPreviewResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := h.preview.Get(ctx)
+	if err != nil {
+		return nil, previewError(err)
+	}
+
+	return &pb.GetPreviewResponse{Preview: newPBPreview(p)}, nil
+}
+
+func (h *PreviewHandler) NextPreviewStage(
+	ctx context.Context,
+	_ *pb.NextPreviewStageRequest) (*pb.NextPreviewStageResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := h.preview.Next(ctx)
+	if err != nil {
+		return nil, previewError(err)
+	}
+
+	return &pb.NextPreviewStageResponse{Preview: newPBPreview(p)}, nil
+}
+
+func (h *PreviewHandler) SelectPreviewQuestion(
+	ctx context.Context,
+	r *pb.SelectPreviewQuestionRequest) (*pb.SelectPreviewQuestionResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoundQuestionId == 0 {
+		return nil, twirp.RequiredArgumentError("round_question_id")
+	}
+
+	p, err := h.preview.SelectQuestion(ctx, r.RoundQuestionId)
+	if err != nil {
+		return nil, previewError(err)
+	}
+
+	return &pb.SelectPreviewQuestionResponse{Preview: newPBPreview(p)}, nil
+}
+
+func (h *PreviewHandler) StopPreview(
+	ctx context.Context,
+	_ *pb.StopPreviewRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.preview.Stop(ctx); err != nil {
+		return nil, previewError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+// previewError maps errors of preview use case to twirp errors.
+func previewError(err error) error {
+	switch {
+	case errors.Is(err, apperr.PreviewNotFound):
+		return twirp.NotFoundError(apperr.MsgPreviewNotFound)
+	case errors.Is(err, entity.ErrPreviewQuestionNotFound):
+		return twirp.InvalidArgumentError("round_question_id", err.Error())
+	case errors.Is(err, entity.ErrPreviewFinished),
+		errors.Is(err, entity.ErrPreviewQuestionRequired),
+		errors.Is(err, entity.ErrPreviewNotGrid):
+		return twirp.FailedPrecondition.Error(err.Error())
+	}
+
+	return twirp.InternalError(err.Error())
+}
+
+func newPBPreview(p *entity.Preview) *pb.Preview {
+	res := &pb.Preview{
+		PackId:     p.PackID,
+		Stage:      pb.PreviewStage(p.Stage),
+		RoundCount: int32(len(p.Rounds)),
+	}
+
+	if r := p.Round(); r != nil {
+		res.Round = &pb.Round{
+			Id:       r.ID,
+			Name:     r.Name,
+			Position: int32(r.Position),
+			PackId:   r.PackID,
+		}
+
+		res.Grid = make([]*pb.PreviewGridQuestion, len(r.Questions))
+
+		for i, q := range r.Questions {
+			res.Grid[i] = &pb.PreviewGridQuestion{
+				RoundQuestionId: q.ID,
+				TopicId:         q.TopicID,
+				TopicTitle:      q.Topic,
+				QuestionCost:    q.Cost,
+				IsPlayed:        p.IsPlayed(q.ID),
+			}
+		}
+	}
+
+	if q := p.Question(); q != nil {
+		// answer is revealed on answer stage only
+		if p.Stage != entity.PreviewStageAnswer {
+			hidden := *q
+			hidden.HideAnswer()
+			q = &hidden
+		}
+
+		res.Question = newPBRoundQuestion(q)
+	}
+
+	return res
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/twitchtv/twirp"
@@ -15,6 +17,8 @@ import (
 	"github.com/ysomad/answersuck/internal/twirp/common"
 	"github.com/ysomad/answersuck/internal/twirp/hooks"
 	"github.com/ysomad/answersuck/internal/twirp/middleware"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -24,8 +28,12 @@ var (
 )
 
 type QuestionUseCase interface {
-	Save(context.Context, *entity.Question) (int32, error)
-	GetOne(context.Context, int32) (*entity.Question, error)
+	Create(ctx context.Context, q *entity.Question, strict bool) (int32, []entity.SimilarQuestion, error)
+	Get(ctx context.Context, questionID int32, shareToken string) (*entity.Question, error)
+	GetUsage(context.Context, int32) ([]entity.QuestionUsage, error)
+	Update(context.Context, *entity.Question) error
+	Delete(context.Context, int32) error
+	Search(ctx context.Context, query string, lang entity.Language, limit uint64) ([]entity.Question, error)
 }
 
 type QuestionHandler struct {
@@ -67,25 +75,77 @@ func (h *QuestionHandler) CreateQuestion(
 		return nil, twirp.InvalidArgument.Error(err.Error())
 	}
 
-	questionID, err := h.question.Save(ctx, &entity.Question{
+	lang, err := parseLanguage(r.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	if lang == 0 {
+		lang = entity.DefaultLanguage
+	}
+
+	q := &entity.Question{
 		Text:       r.Question,
 		Author:     session.User.ID,
 		MediaURL:   r.QuestionMediaUrl,
+		MediaClip:  newMediaClip(r.QuestionMediaClip),
+		Parts:      newQuestionParts(r.QuestionParts),
+		Language:   lang,
 		CreateTime: time.Now(),
 		Answer: entity.Answer{
-			Text:     r.Answer,
-			MediaURL: r.AnswerMediaUrl,
+			Text:          r.Answer,
+			MediaURL:      r.AnswerMediaUrl,
+			MediaClip:     newMediaClip(r.AnswerMediaClip),
+			Alternatives:  r.AnswerAlternatives,
+			WrongVariants: r.AnswerWrongVariants,
 		},
-	})
+	}
+
+	if err := q.Validate(); err != nil {
+		if errors.Is(err, entity.ErrInvalidMediaClip) {
+			return nil, twirp.InvalidArgument.Error(err.Error())
+		}
+
+		return nil, twirp.InvalidArgumentError("question_parts", err.Error())
+	}
+
+	questionID, similar, err := h.question.Create(ctx, q, r.Strict)
 	if err != nil {
-		if errors.Is(err, apperr.MediaNotFound) {
+		switch {
+		case errors.Is(err, apperr.MediaNotFound):
 			return nil, twirp.InvalidArgument.Error(apperr.MsgQuestionMediaNotFound)
+		case errors.Is(err, apperr.QuestionPartMismatch):
+			return nil, twirp.InvalidArgumentError("question_parts", apperr.MsgQuestionPartMismatch)
+		case errors.Is(err, apperr.MediaClipInvalid):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgMediaClipInvalid)
+		case errors.Is(err, apperr.QuestionAlreadyExists):
+			ids := make([]string, len(similar))
+
+			for i, q := range similar {
+				ids[i] = strconv.Itoa(int(q.ID))
+			}
+
+			return nil, twirp.AlreadyExists.Error(apperr.MsgQuestionAlreadyExists).
+				WithMeta("question_ids", strings.Join(ids, ","))
 		}
 
 		return nil, twirp.InternalError(err.Error())
 	}
 
-	return &pb.CreateQuestionResponse{QuestionId: questionID}, nil
+	res := &pb.CreateQuestionResponse{
+		QuestionId:       questionID,
+		SimilarQuestions: make([]*pb.SimilarQuestion, len(similar)),
+	}
+
+	for i, q := range similar {
+		res.SimilarQuestions[i] = &pb.SimilarQuestion{
+			Id:         q.ID,
+			Text:       q.Text,
+			Similarity: q.Similarity,
+		}
+	}
+
+	return res, nil
 }
 
 func (h *QuestionHandler) GetQuestion(
@@ -95,27 +155,256 @@ func (h *QuestionHandler) GetQuestion(
 		return nil, twirp.RequiredArgumentError("question_id")
 	}
 
-	q, err := h.question.GetOne(ctx, r.QuestionId)
+	q, err := h.question.Get(ctx, r.QuestionId, r.ShareToken)
 	if err != nil {
 		if errors.Is(err, apperr.QuestionNotFound) {
-			return nil, twirp.NotFoundError(err.Error())
+			return nil, twirp.NotFoundError(apperr.MsgQuestionNotFound)
 		}
 
 		return nil, twirp.InternalError(err.Error())
 	}
 
-	return &pb.GetQuestionResponse{
+	res := &pb.GetQuestionResponse{
 		Question: &pb.Question{
 			Id:         q.ID,
 			Text:       q.Text,
 			Author:     q.Author,
 			MediaUrl:   q.MediaURL,
+			MediaClip:  newPBMediaClip(q.MediaClip),
+			UsageCount: q.UsageCount,
+			Parts:      newPBQuestionParts(q.Parts),
+			Language:   pb.Language(q.Language),
+			CreateTime: timestamppb.New(q.CreateTime),
+		},
+	}
+
+	if !q.AnswerHidden() {
+		res.Question.Answer = &pb.Answer{
+			Id:            q.Answer.ID,
+			Text:          q.Answer.Text,
+			MediaUrl:      q.Answer.MediaURL,
+			MediaClip:     newPBMediaClip(q.Answer.MediaClip),
+			Alternatives:  q.Answer.Alternatives,
+			WrongVariants: q.Answer.WrongVariants,
+		}
+	}
+
+	return res, nil
+}
+
+func (h *QuestionHandler) GetQuestionUsage(
+	ctx context.Context,
+	r *pb.GetQuestionUsageRequest) (*pb.GetQuestionUsageResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.QuestionId == 0 {
+		return nil, twirp.RequiredArgumentError("question_id")
+	}
+
+	usages, err := h.question.GetUsage(ctx, r.QuestionId)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.QuestionNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgQuestionNotFound)
+		case errors.Is(err, apperr.QuestionNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgQuestionNotAuthor)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	res := &pb.GetQuestionUsageResponse{
+		Usages: make([]*pb.QuestionUsage, len(usages)),
+	}
+
+	for i, u := range usages {
+		res.Usages[i] = &pb.QuestionUsage{
+			RoundQuestionId: u.RoundQuestionID,
+			PackId:          u.PackID,
+			PackName:        u.PackName,
+			PackAuthor:      u.PackAuthor,
+			RoundId:         u.RoundID,
+			RoundName:       u.RoundName,
+			TopicId:         u.TopicID,
+			TopicTitle:      u.TopicTitle,
+		}
+	}
+
+	return res, nil
+}
+
+func (h *QuestionHandler) SearchQuestions(
+	ctx context.Context,
+	r *pb.SearchQuestionsRequest) (*pb.SearchQuestionsResponse, error) {
+	if r.Query == "" {
+		return nil, twirp.RequiredArgumentError("query")
+	}
+
+	if r.Limit == 0 {
+		return nil, twirp.RequiredArgumentError("limit")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	lang, err := parseLanguage(r.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	qq, err := h.question.Search(ctx, strings.TrimSpace(r.Query), lang, uint64(r.Limit))
+	if err != nil {
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	questions := make([]*pb.Question, len(qq))
+
+	for i, q := range qq {
+		questions[i] = &pb.Question{
+			Id:         q.ID,
+			Text:       q.Text,
+			Author:     q.Author,
+			MediaUrl:   q.MediaURL,
+			Language:   pb.Language(q.Language),
 			CreateTime: timestamppb.New(q.CreateTime),
-			Answer: &pb.Answer{
-				Id:       q.Answer.ID,
-				Text:     q.Answer.Text,
-				MediaUrl: q.Answer.MediaURL,
-			},
+		}
+	}
+
+	return &pb.SearchQuestionsResponse{Questions: questions}, nil
+}
+
+func (h *QuestionHandler) UpdateQuestion(
+	ctx context.Context,
+	r *pb.UpdateQuestionRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.QuestionId == 0 {
+		return nil, twirp.RequiredArgumentError("question_id")
+	}
+
+	if r.Question == "" {
+		return nil, twirp.RequiredArgumentError("question")
+	}
+
+	if r.Answer == "" {
+		return nil, twirp.RequiredArgumentError("answer")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	lang, err := parseLanguage(r.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &entity.Question{
+		ID:        r.QuestionId,
+		Text:      r.Question,
+		MediaURL:  r.QuestionMediaUrl,
+		MediaClip: newMediaClip(r.QuestionMediaClip),
+		Parts:     newQuestionParts(r.QuestionParts),
+		Language:  lang,
+		Answer: entity.Answer{
+			Text:          r.Answer,
+			MediaURL:      r.AnswerMediaUrl,
+			MediaClip:     newMediaClip(r.AnswerMediaClip),
+			Alternatives:  r.AnswerAlternatives,
+			WrongVariants: r.AnswerWrongVariants,
 		},
-	}, nil
+	}
+
+	if err := q.Validate(); err != nil {
+		if errors.Is(err, entity.ErrInvalidMediaClip) {
+			return nil, twirp.InvalidArgument.Error(err.Error())
+		}
+
+		return nil, twirp.InvalidArgumentError("question_parts", err.Error())
+	}
+
+	if err := h.question.Update(ctx, q); err != nil {
+		switch {
+		case errors.Is(err, apperr.QuestionNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgQuestionNotFound)
+		case errors.Is(err, apperr.QuestionNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgQuestionNotAuthor)
+		case errors.Is(err, apperr.QuestionPublished):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgQuestionPublished)
+		case errors.Is(err, apperr.MediaNotFound):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgQuestionMediaNotFound)
+		case errors.Is(err, apperr.QuestionPartMismatch):
+			return nil, twirp.InvalidArgumentError("question_parts", apperr.MsgQuestionPartMismatch)
+		case errors.Is(err, apperr.MediaClipInvalid):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgMediaClipInvalid)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *QuestionHandler) DeleteQuestion(
+	ctx context.Context,
+	r *pb.DeleteQuestionRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.QuestionId == 0 {
+		return nil, twirp.RequiredArgumentError("question_id")
+	}
+
+	if err := h.question.Delete(ctx, r.QuestionId); err != nil {
+		switch {
+		case errors.Is(err, apperr.QuestionNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgQuestionNotFound)
+		case errors.Is(err, apperr.QuestionNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgQuestionNotAuthor)
+		case errors.Is(err, apperr.QuestionInUse):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgQuestionInUse)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func newQuestionParts(pp []*pb.QuestionPart) []entity.QuestionPart {
+	parts := make([]entity.QuestionPart, len(pp))
+
+	for i, p := range pp {
+		parts[i] = entity.QuestionPart{
+			Type:      entity.QuestionPartType(p.Type),
+			Text:      p.Text,
+			MediaURL:  p.MediaUrl,
+			MediaClip: newMediaClip(p.MediaClip),
+			Duration:  p.Duration.AsDuration(),
+		}
+	}
+
+	return parts
+}
+
+func newPBQuestionParts(parts []entity.QuestionPart) []*pb.QuestionPart {
+	pp := make([]*pb.QuestionPart, len(parts))
+
+	for i, p := range parts {
+		pp[i] = &pb.QuestionPart{
+			Type:      pb.QuestionPartType(p.Type),
+			Text:      p.Text,
+			MediaUrl:  p.MediaURL,
+			MediaClip: newPBMediaClip(p.MediaClip),
+			Duration:  durationpb.New(p.Duration),
+		}
+	}
+
+	return pp
 }
@@ -26,9 +26,10 @@ var (
 type RoundUseCase interface {
 	Create(ctx context.Context, r entity.Round) (roundID int32, err error)
 	Update(ctx context.Context, r entity.Round) error
-	GetAll(ctx context.Context, packID int32) ([]entity.Round, error)
+	List(ctx context.Context, packID int32, shareToken string) ([]entity.Round, error)
 	AddTopic(ctx context.Context, roundID, topicID int32) (int32, error)
 	RemoveTopic(ctx context.Context, roundID, topicID int32) error
+	MoveTopic(ctx context.Context, roundID, topicID, dstRoundID int32, pos int16) error
 }
 
 type RoundHandler struct {
@@ -166,7 +167,7 @@ func (h *RoundHandler) ListRounds(
 		return nil, twirp.RequiredArgumentError("pack_id")
 	}
 
-	rr, err := h.round.GetAll(ctx, r.PackId)
+	rr, err := h.round.List(ctx, r.PackId, r.ShareToken)
 	if err != nil {
 		if errors.Is(err, apperr.PackNotFound) {
 			return nil, twirp.InvalidArgument.Error(apperr.MsgPackNotFound)
@@ -254,6 +255,55 @@ func (h *RoundHandler) RemoveTopic(
 	return &emptypb.Empty{}, nil
 }
 
+func (h *RoundHandler) MoveTopic(
+	ctx context.Context,
+	r *pb.MoveTopicRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoundId == 0 {
+		return nil, twirp.RequiredArgumentError("round_id")
+	}
+
+	if r.TopicId == 0 {
+		return nil, twirp.RequiredArgumentError("topic_id")
+	}
+
+	if r.DstRoundId == 0 {
+		return nil, twirp.RequiredArgumentError("dst_round_id")
+	}
+
+	if r.Position == 0 {
+		return nil, twirp.RequiredArgumentError("position")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.round.MoveTopic(ctx, r.RoundId, r.TopicId, r.DstRoundId, int16(r.Position)); err != nil {
+		switch {
+		case errors.Is(err, apperr.RoundNotFound):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgRoundNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		case errors.Is(err, apperr.RoundTopicNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgRoundTopicNotFound)
+		case errors.Is(err, apperr.RoundTopicNotAdded):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgRoundTopicNotAdded)
+		case errors.Is(err, apperr.RoundTopicAlreadyExists):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgRoundTopicAlreadyExists)
+		case errors.Is(err, apperr.PackPublished):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgPackPublished)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (h *RoundHandler) GetQuestionGrid(
 	ctx context.Context,
 	r *pb.GetQuestionGridRequest) (*pb.GetQuestionGridResponse, error) {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/twitchtv/twirp"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ysomad/answersuck/internal/entity"
 	pb "github.com/ysomad/answersuck/internal/gen/api/editor/v1"
@@ -25,7 +26,8 @@ var (
 
 type RoundQuestionUseCase interface {
 	Save(ctx context.Context, q *entity.RoundQuestion) (int32, error)
-	GetOne(ctx context.Context, id int32) (*entity.RoundQuestionDetailed, error)
+	Get(ctx context.Context, id int32, shareToken string) (*entity.RoundQuestionDetailed, error)
+	Move(ctx context.Context, id, dstRoundID, dstTopicID int32, pos int16) error
 }
 
 type RoundQuestionHandler struct {
@@ -83,6 +85,11 @@ func (h *RoundQuestionHandler) CreateRoundQuestion(ctx context.Context,
 		SecretCost:   r.SecretCost,
 		Keepable:     r.IsKeepable,
 		TransferType: entity.QuestionTransferType(r.TransferType),
+		SecretCostRange: entity.SecretCostRange{
+			Min:  r.SecretCostRange.GetMin(),
+			Max:  r.SecretCostRange.GetMax(),
+			Step: r.SecretCostRange.GetStep(),
+		},
 	}
 
 	if err = q.Validate(); err != nil {
@@ -96,8 +103,14 @@ func (h *RoundQuestionHandler) CreateRoundQuestion(ctx context.Context,
 			return nil, twirp.NotFoundError(apperr.MsgRoundNotFound)
 		case errors.Is(err, apperr.TopicNotFound):
 			return nil, twirp.NotFoundError(apperr.MsgTopicNotFound)
+		case errors.Is(err, apperr.RoundTopicNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgRoundTopicNotFound)
 		case errors.Is(err, apperr.QuestionNotFound):
 			return nil, twirp.NotFoundError(apperr.MsgQuestionNotFound)
+		case errors.Is(err, apperr.RoundQuestionInPack):
+			return nil, twirp.AlreadyExists.Error(apperr.MsgRoundQuestionInPack)
+		case errors.Is(err, apperr.PackPublished):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgPackPublished)
 		}
 
 		return nil, twirp.InternalError(err.Error())
@@ -113,7 +126,7 @@ func (h *RoundQuestionHandler) GetRoundQuestion(
 		return nil, twirp.RequiredArgumentError("round_question_id")
 	}
 
-	q, err := h.round.GetOne(ctx, r.RoundQuestionId)
+	q, err := h.round.Get(ctx, r.RoundQuestionId, r.ShareToken)
 	if err != nil {
 		if errors.Is(err, apperr.RoundQuestionNotFound) {
 			return nil, twirp.NotFoundError(apperr.MsgRoundQuestionNotFound)
@@ -122,29 +135,98 @@ func (h *RoundQuestionHandler) GetRoundQuestion(
 		return nil, twirp.InternalError(err.Error())
 	}
 
-	return &pb.GetRoundQuestionResponse{
-		RoundQuestion: &pb.RoundQuestion{
-			Id:      q.ID,
-			RoundId: q.RoundID,
-			TopicId: q.TopicID,
-			Question: &pb.RoundQuestion_Question{
-				Id:       q.QuestionID,
-				Text:     q.Question,
-				MediaUrl: q.AnswerMediaURL,
-			},
-			QuestionType: pb.RoundQuestionType(q.Type),
-			QuestionCost: q.Cost,
-			Answer: &pb.RoundQuestion_Answer{
-				Id:       q.AnswerID,
-				Text:     q.Answer,
-				MediaUrl: q.AnswerMediaURL,
-			},
-			AnswerTime:   durationpb.New(q.AnswerTime),
-			HostComment:  q.HostComment,
-			SecretTopic:  q.SecretTopic,
-			SecretCost:   q.SecretCost,
-			TransferType: pb.TransferType(q.TransferType),
-			IsKeepable:   q.Keepable,
+	return &pb.GetRoundQuestionResponse{RoundQuestion: newPBRoundQuestion(q)}, nil
+}
+
+func (h *RoundQuestionHandler) MoveRoundQuestion(
+	ctx context.Context,
+	r *pb.MoveRoundQuestionRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoundQuestionId == 0 {
+		return nil, twirp.RequiredArgumentError("round_question_id")
+	}
+
+	if r.DstRoundId == 0 {
+		return nil, twirp.RequiredArgumentError("dst_round_id")
+	}
+
+	if r.DstTopicId == 0 {
+		return nil, twirp.RequiredArgumentError("dst_topic_id")
+	}
+
+	if r.Position == 0 {
+		return nil, twirp.RequiredArgumentError("position")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	err := h.round.Move(ctx, r.RoundQuestionId, r.DstRoundId, r.DstTopicId, int16(r.Position))
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.RoundQuestionNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgRoundQuestionNotFound)
+		case errors.Is(err, apperr.RoundNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgRoundNotFound)
+		case errors.Is(err, apperr.RoundTopicNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgRoundTopicNotFound)
+		case errors.Is(err, apperr.PackNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPackNotAuthor)
+		case errors.Is(err, apperr.RoundQuestionNotMoved):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgRoundQuestionNotMoved)
+		case errors.Is(err, apperr.RoundQuestionInPack):
+			return nil, twirp.AlreadyExists.Error(apperr.MsgRoundQuestionInPack)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// newPBRoundQuestion returns round question without answer if answer is hidden.
+func newPBRoundQuestion(q *entity.RoundQuestionDetailed) *pb.RoundQuestion {
+	rq := &pb.RoundQuestion{
+		Id:         q.ID,
+		RoundId:    q.RoundID,
+		TopicId:    q.TopicID,
+		TopicTitle: q.Topic,
+		Question: &pb.RoundQuestion_Question{
+			Id:        q.QuestionID,
+			Text:      q.Question,
+			MediaUrl:  q.QuestionMediaURL,
+			MediaClip: newPBMediaClip(q.QuestionMediaClip),
 		},
-	}, nil
+		QuestionType: pb.RoundQuestionType(q.Type),
+		QuestionCost: q.Cost,
+		AnswerTime:   durationpb.New(q.AnswerTime),
+		HostComment:  q.HostComment,
+		SecretTopic:  q.SecretTopic,
+		SecretCost:   q.SecretCost,
+		TransferType: pb.TransferType(q.TransferType),
+		IsKeepable:   q.Keepable,
+	}
+
+	if !q.SecretCostRange.Empty() {
+		rq.SecretCostRange = &pb.SecretCostRange{
+			Min:  q.SecretCostRange.Min,
+			Max:  q.SecretCostRange.Max,
+			Step: q.SecretCostRange.Step,
+		}
+	}
+
+	if !q.AnswerHidden() {
+		rq.Answer = &pb.RoundQuestion_Answer{
+			Id:        q.AnswerID,
+			Text:      q.Answer,
+			MediaUrl:  q.AnswerMediaURL,
+			MediaClip: newPBMediaClip(q.AnswerMediaClip),
+		}
+	}
+
+	return rq
 }
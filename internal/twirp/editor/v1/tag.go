@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/twitchtv/twirp"
@@ -17,6 +18,7 @@ import (
 	"github.com/ysomad/answersuck/internal/twirp/common"
 	"github.com/ysomad/answersuck/internal/twirp/hooks"
 	"github.com/ysomad/answersuck/internal/twirp/middleware"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,6 +30,11 @@ var (
 type TagUseCase interface {
 	Save(context.Context, entity.Tag) error
 	GetAll(context.Context, paging.Params, []sort.Sort) (paging.List[entity.Tag], error)
+	Search(ctx context.Context, query string, limit uint64) ([]entity.Tag, error)
+	Update(ctx context.Context, name, newName string) error
+	Delete(ctx context.Context, name string) error
+	Merge(ctx context.Context, src []string, dst string) error
+	Ban(ctx context.Context, name string) error
 }
 
 type TagHandler struct {
@@ -71,8 +78,11 @@ func (h *TagHandler) CreateTag(
 	}
 
 	if err := h.tag.Save(ctx, tag); err != nil {
-		if errors.Is(err, apperr.TagAlreadyExists) {
+		switch {
+		case errors.Is(err, apperr.TagAlreadyExists):
 			return nil, twirp.AlreadyExists.Error(apperr.MsgTagAlreadyExists)
+		case errors.Is(err, apperr.TagBanned):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgTagBanned)
 		}
 
 		return nil, twirp.InternalError(err.Error())
@@ -116,6 +126,7 @@ func (h *TagHandler) ListTags(
 		tags[i] = &pb.Tag{
 			Name:       t.Name,
 			Author:     t.Author,
+			UsageCount: t.UsageCount,
 			CreateTime: timestamppb.New(t.CreateTime),
 		}
 	}
@@ -125,3 +136,159 @@ func (h *TagHandler) ListTags(
 		NextPageToken: tagList.NextPageToken,
 	}, nil
 }
+
+func (h *TagHandler) SearchTags(
+	ctx context.Context, r *pb.SearchTagsRequest) (*pb.SearchTagsResponse, error) {
+	if r.Limit == 0 {
+		return nil, twirp.RequiredArgumentError("limit")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	tt, err := h.tag.Search(ctx, strings.TrimSpace(r.Query), uint64(r.Limit))
+	if err != nil {
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	tags := make([]*pb.Tag, len(tt))
+
+	for i, t := range tt {
+		tags[i] = &pb.Tag{
+			Name:       t.Name,
+			Author:     t.Author,
+			UsageCount: t.UsageCount,
+			CreateTime: timestamppb.New(t.CreateTime),
+		}
+	}
+
+	return &pb.SearchTagsResponse{Tags: tags}, nil
+}
+
+func (h *TagHandler) UpdateTag(
+	ctx context.Context,
+	r *pb.UpdateTagRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.TagName == "" {
+		return nil, twirp.RequiredArgumentError("tag_name")
+	}
+
+	if r.NewTagName == "" {
+		return nil, twirp.RequiredArgumentError("new_tag_name")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.tag.Update(ctx, r.TagName, r.NewTagName); err != nil {
+		switch {
+		case errors.Is(err, apperr.TagNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgTagNotFound)
+		case errors.Is(err, apperr.TagNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgTagNotAuthor)
+		case errors.Is(err, apperr.TagPublished):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgTagPublished)
+		case errors.Is(err, apperr.TagAlreadyExists):
+			return nil, twirp.AlreadyExists.Error(apperr.MsgTagAlreadyExists)
+		case errors.Is(err, apperr.TagBanned):
+			return nil, twirp.InvalidArgument.Error(apperr.MsgTagBanned)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *TagHandler) DeleteTag(
+	ctx context.Context,
+	r *pb.DeleteTagRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.TagName == "" {
+		return nil, twirp.RequiredArgumentError("tag_name")
+	}
+
+	if err := h.tag.Delete(ctx, r.TagName); err != nil {
+		switch {
+		case errors.Is(err, apperr.TagNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgTagNotFound)
+		case errors.Is(err, apperr.TagNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgTagNotAuthor)
+		case errors.Is(err, apperr.TagInUse):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgTagInUse)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *TagHandler) MergeTags(
+	ctx context.Context,
+	r *pb.MergeTagsRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(r.SrcTagNames) == 0 {
+		return nil, twirp.RequiredArgumentError("src_tag_names")
+	}
+
+	if r.DstTagName == "" {
+		return nil, twirp.RequiredArgumentError("dst_tag_name")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.tag.Merge(ctx, r.SrcTagNames, r.DstTagName); err != nil {
+		switch {
+		case errors.Is(err, apperr.PlayerNotAdmin):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPlayerNotAdmin)
+		case errors.Is(err, apperr.TagNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgTagNotFound)
+		case errors.Is(err, apperr.TagMergeInvalid):
+			return nil, twirp.InvalidArgumentError("src_tag_names", apperr.MsgTagMergeInvalid)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *TagHandler) BanTag(
+	ctx context.Context,
+	r *pb.BanTagRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.TagName == "" {
+		return nil, twirp.RequiredArgumentError("tag_name")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.tag.Ban(ctx, r.TagName); err != nil {
+		if errors.Is(err, apperr.PlayerNotAdmin) {
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPlayerNotAdmin)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
@@ -2,14 +2,17 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ysomad/answersuck/internal/entity"
 	pb "github.com/ysomad/answersuck/internal/gen/api/editor/v1"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
 	"github.com/ysomad/answersuck/internal/pkg/session"
 	apptwirp "github.com/ysomad/answersuck/internal/twirp"
 	"github.com/ysomad/answersuck/internal/twirp/common"
@@ -24,6 +27,8 @@ var (
 
 type TopicUseCase interface {
 	Save(context.Context, entity.Topic) (topicID int32, err error)
+	Update(ctx context.Context, topicID int32, title string) error
+	Delete(ctx context.Context, topicID int32) error
 }
 
 type TopicHandler struct {
@@ -63,6 +68,7 @@ func (h *TopicHandler) CreateTopic(
 	topic := entity.Topic{
 		Title:      r.TopicTitle,
 		Author:     session.User.ID,
+		Language:   newLanguage(r.Language),
 		CreateTime: time.Now(),
 	}
 
@@ -75,7 +81,70 @@ func (h *TopicHandler) CreateTopic(
 		Topic: &pb.Topic{
 			Id:         topic.ID,
 			Title:      topic.Title,
+			Language:   pb.Language(topic.Language),
 			CreateTime: timestamppb.New(topic.CreateTime),
 		},
 	}, nil
 }
+
+func (h *TopicHandler) UpdateTopic(
+	ctx context.Context,
+	r *pb.UpdateTopicRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.TopicId == 0 {
+		return nil, twirp.RequiredArgumentError("topic_id")
+	}
+
+	if r.TopicTitle == "" {
+		return nil, twirp.RequiredArgumentError("topic_title")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.topic.Update(ctx, r.TopicId, r.TopicTitle); err != nil {
+		switch {
+		case errors.Is(err, apperr.TopicNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgTopicNotFound)
+		case errors.Is(err, apperr.TopicNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgTopicNotAuthor)
+		case errors.Is(err, apperr.TopicPublished):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgTopicPublished)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *TopicHandler) DeleteTopic(
+	ctx context.Context,
+	r *pb.DeleteTopicRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.TopicId == 0 {
+		return nil, twirp.RequiredArgumentError("topic_id")
+	}
+
+	if err := h.topic.Delete(ctx, r.TopicId); err != nil {
+		switch {
+		case errors.Is(err, apperr.TopicNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgTopicNotFound)
+		case errors.Is(err, apperr.TopicNotAuthor):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgTopicNotAuthor)
+		case errors.Is(err, apperr.TopicInUse):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgTopicInUse)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
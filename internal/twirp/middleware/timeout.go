@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// WithTimeouts overrides read and write timeouts of the server for the route,
+// zero timeout disables it. Used by long-lived streams which can't fit in server timeouts.
+func WithTimeouts(read, write time.Duration) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rc := http.NewResponseController(w)
+
+			if err := rc.SetReadDeadline(deadline(read)); err != nil {
+				slog.Error("error setting read deadline", slog.String("error", err.Error()))
+			}
+
+			if err := rc.SetWriteDeadline(deadline(write)); err != nil {
+				slog.Error("error setting write deadline", slog.String("error", err.Error()))
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
+func deadline(timeout time.Duration) time.Time {
+	if timeout == 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(timeout)
+}
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+	"github.com/ysomad/answersuck/internal/entity"
+	pb "github.com/ysomad/answersuck/internal/gen/api/moderation/v1"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/common"
+	"github.com/ysomad/answersuck/internal/twirp/hooks"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	_ apptwirp.Handler     = &ModerationHandler{}
+	_ pb.ModerationService = &ModerationHandler{}
+)
+
+type ModerationUseCase interface {
+	ListReports(context.Context, entity.ReportStatus, paging.Params) (paging.List[entity.Report], error)
+	CloseReport(ctx context.Context, reportID int32, status entity.ReportStatus) (*entity.Report, error)
+	SetPackHidden(ctx context.Context, packID int32, hidden bool) error
+	BanAuthor(ctx context.Context, nickname string) error
+	UnbanAuthor(ctx context.Context, nickname string) error
+}
+
+type ModerationHandler struct {
+	moderation ModerationUseCase
+	session    *session.Manager
+}
+
+func NewModerationHandler(uc ModerationUseCase, sm *session.Manager) *ModerationHandler {
+	return &ModerationHandler{
+		moderation: uc,
+		session:    sm,
+	}
+}
+
+func (h *ModerationHandler) Handle(m *http.ServeMux) {
+	s := pb.NewModerationServiceServer(h,
+		twirp.WithServerHooks(hooks.WithSession(h.session)))
+	m.Handle(s.PathPrefix(), middleware.WithSessionID(s))
+}
+
+func (h *ModerationHandler) ListReports(
+	ctx context.Context,
+	r *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	reportList, err := h.moderation.ListReports(ctx, entity.ReportStatus(r.Status), paging.Params{
+		PageSize:  r.PageSize,
+		PageToken: r.PageToken,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.PlayerNotAdmin):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPlayerNotAdmin)
+		case errors.Is(err, paging.ErrInvalidToken):
+			return nil, twirp.InvalidArgumentError("page_token", err.Error())
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	reports := make([]*pb.Report, len(reportList.Items))
+
+	for i, rep := range reportList.Items {
+		reports[i] = newPBReport(rep)
+	}
+
+	return &pb.ListReportsResponse{
+		Reports:       reports,
+		NextPageToken: reportList.NextPageToken,
+	}, nil
+}
+
+func (h *ModerationHandler) CloseReport(
+	ctx context.Context,
+	r *pb.CloseReportRequest) (*pb.CloseReportResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.ReportId == 0 {
+		return nil, twirp.RequiredArgumentError("report_id")
+	}
+
+	if r.Status == pb.ReportStatus_REPORT_STATUS_UNSPECIFIED {
+		return nil, twirp.RequiredArgumentError("status")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	rep, err := h.moderation.CloseReport(ctx, r.ReportId, entity.ReportStatus(r.Status))
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.PlayerNotAdmin):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPlayerNotAdmin)
+		case errors.Is(err, apperr.ReportNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgReportNotFound)
+		case errors.Is(err, entity.ErrReportAlreadyClosed):
+			return nil, twirp.FailedPrecondition.Error(err.Error())
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &pb.CloseReportResponse{Report: newPBReport(*rep)}, nil
+}
+
+func (h *ModerationHandler) HidePack(
+	ctx context.Context,
+	r *pb.HidePackRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackId == 0 {
+		return nil, twirp.RequiredArgumentError("pack_id")
+	}
+
+	if err := h.setPackHidden(ctx, r.PackId, true); err != nil {
+		return nil, err
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *ModerationHandler) UnhidePack(
+	ctx context.Context,
+	r *pb.UnhidePackRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.PackId == 0 {
+		return nil, twirp.RequiredArgumentError("pack_id")
+	}
+
+	if err := h.setPackHidden(ctx, r.PackId, false); err != nil {
+		return nil, err
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *ModerationHandler) setPackHidden(ctx context.Context, packID int32, hidden bool) error {
+	if err := h.moderation.SetPackHidden(ctx, packID, hidden); err != nil {
+		switch {
+		case errors.Is(err, apperr.PlayerNotAdmin):
+			return twirp.PermissionDenied.Error(apperr.MsgPlayerNotAdmin)
+		case errors.Is(err, apperr.PackNotFound):
+			return twirp.NotFoundError(apperr.MsgPackNotFound)
+		}
+
+		return twirp.InternalError(err.Error())
+	}
+
+	return nil
+}
+
+func (h *ModerationHandler) BanAuthor(
+	ctx context.Context,
+	r *pb.BanAuthorRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.Nickname == "" {
+		return nil, twirp.RequiredArgumentError("nickname")
+	}
+
+	if err := h.moderation.BanAuthor(ctx, r.Nickname); err != nil {
+		switch {
+		case errors.Is(err, apperr.PlayerNotAdmin):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPlayerNotAdmin)
+		case errors.Is(err, apperr.PlayerNotFound):
+			return nil, twirp.NotFoundError(apperr.PlayerNotFound.Error())
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *ModerationHandler) UnbanAuthor(
+	ctx context.Context,
+	r *pb.UnbanAuthorRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.Nickname == "" {
+		return nil, twirp.RequiredArgumentError("nickname")
+	}
+
+	if err := h.moderation.UnbanAuthor(ctx, r.Nickname); err != nil {
+		if errors.Is(err, apperr.PlayerNotAdmin) {
+			return nil, twirp.PermissionDenied.Error(apperr.MsgPlayerNotAdmin)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return new(emptypb.Empty), nil
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+	"github.com/ysomad/answersuck/internal/entity"
+	pb "github.com/ysomad/answersuck/internal/gen/api/moderation/v1"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/common"
+	"github.com/ysomad/answersuck/internal/twirp/hooks"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	_ apptwirp.Handler = &ReportHandler{}
+	_ pb.ReportService = &ReportHandler{}
+)
+
+type ReportUseCase interface {
+	Report(ctx context.Context, r entity.Report, shareToken string) (int32, error)
+}
+
+type ReportHandler struct {
+	report  ReportUseCase
+	session *session.Manager
+}
+
+func NewReportHandler(uc ReportUseCase, sm *session.Manager) *ReportHandler {
+	return &ReportHandler{
+		report:  uc,
+		session: sm,
+	}
+}
+
+func (h *ReportHandler) Handle(m *http.ServeMux) {
+	s := pb.NewReportServiceServer(h,
+		twirp.WithServerHooks(hooks.WithSession(h.session)))
+	m.Handle(s.PathPrefix(), middleware.WithSessionID(s))
+}
+
+func (h *ReportHandler) CreateReport(
+	ctx context.Context,
+	r *pb.CreateReportRequest) (*pb.CreateReportResponse, error) {
+	if _, err := common.CheckPlayerVerification(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.Reason == "" {
+		return nil, twirp.RequiredArgumentError("reason")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	reportID, err := h.report.Report(ctx, entity.Report{
+		PackID:     r.PackId,
+		QuestionID: r.QuestionId,
+		Reason:     r.Reason,
+	}, r.ShareToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrReportTargetRequired):
+			return nil, twirp.InvalidArgument.Error(err.Error())
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.QuestionNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgQuestionNotFound)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &pb.CreateReportResponse{ReportId: reportID}, nil
+}
+
+func newPBReport(r entity.Report) *pb.Report {
+	res := &pb.Report{
+		Id:           r.ID,
+		PackId:       r.PackID,
+		QuestionId:   r.QuestionID,
+		PackName:     r.PackName,
+		QuestionText: r.QuestionText,
+		Reason:       r.Reason,
+		Reporter:     r.Reporter,
+		Status:       pb.ReportStatus(r.Status),
+		ResolvedBy:   r.ResolvedBy,
+		CreateTime:   timestamppb.New(r.CreateTime),
+	}
+
+	if !r.ResolveTime.IsZero() {
+		res.ResolveTime = timestamppb.New(r.ResolveTime)
+	}
+
+	return res
+}
@@ -8,8 +8,12 @@ import (
 	"github.com/twitchtv/twirp"
 	"github.com/ysomad/answersuck/internal/entity"
 	pb "github.com/ysomad/answersuck/internal/gen/api/player/v1"
+	"github.com/ysomad/answersuck/internal/pkg/appctx"
 	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/session"
 	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/hooks"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -22,21 +26,25 @@ var (
 type PlayerUseCase interface {
 	Create(ctx context.Context, nickname, email, password string) error
 	Get(ctx context.Context, login string) (*entity.Player, error)
+	GetProfile(ctx context.Context, nickname string) (*entity.AuthorProfile, error)
 }
 
 type PlayerHandler struct {
-	player PlayerUseCase
+	player  PlayerUseCase
+	session *session.Manager
 }
 
-func NewPlayerHandler(uc PlayerUseCase) *PlayerHandler {
+func NewPlayerHandler(uc PlayerUseCase, sm *session.Manager) *PlayerHandler {
 	return &PlayerHandler{
-		player: uc,
+		player:  uc,
+		session: sm,
 	}
 }
 
 func (h *PlayerHandler) Handle(m *http.ServeMux) {
-	s := pb.NewPlayerServiceServer(h)
-	m.Handle(s.PathPrefix(), s)
+	s := pb.NewPlayerServiceServer(h,
+		twirp.WithServerHooks(hooks.WithSession(h.session)))
+	m.Handle(s.PathPrefix(), middleware.WithSessionID(s))
 }
 
 func (h *PlayerHandler) GetPlayer(
@@ -54,6 +62,16 @@ func (h *PlayerHandler) GetPlayer(
 		return nil, twirp.InternalError(err.Error())
 	}
 
+	// email is visible only to the player itself, lookup by email of other players
+	// is treated as not found to not disclose whether email is registered
+	if nickname, _ := appctx.GetNickname(ctx); nickname != player.Nickname {
+		if entity.NewLoginType(r.Nickname) == entity.LoginTypeEmail {
+			return nil, twirp.NotFoundError(apperr.PlayerNotFound.Error())
+		}
+
+		player.Email = ""
+	}
+
 	return &pb.GetPlayerResponse{
 		Player: &pb.Player{
 			Nickname:      player.Nickname,
@@ -93,3 +111,48 @@ func (h *PlayerHandler) CreatePlayer(
 
 	return &emptypb.Empty{}, nil
 }
+
+func (h *PlayerHandler) GetAuthorProfile(
+	ctx context.Context, r *pb.GetAuthorProfileRequest) (*pb.GetAuthorProfileResponse, error) {
+	if r.Nickname == "" {
+		return nil, twirp.RequiredArgumentError("nickname")
+	}
+
+	p, err := h.player.GetProfile(ctx, r.Nickname)
+	if err != nil {
+		if errors.Is(err, apperr.PlayerNotFound) {
+			return nil, twirp.NotFoundError(apperr.PlayerNotFound.Error())
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	packs := make([]*pb.AuthorPack, len(p.Packs))
+
+	for i, pack := range p.Packs {
+		packs[i] = &pb.AuthorPack{
+			Id:            pack.ID,
+			Name:          pack.Name,
+			CoverUrl:      pack.CoverURL,
+			RoundCount:    int32(pack.Stats.RoundCount),
+			TopicCount:    int32(pack.Stats.TopicCount),
+			QuestionCount: int32(pack.Stats.QuestionCount),
+			VideoCount:    int32(pack.Stats.VideoCount),
+			AudioCount:    int32(pack.Stats.AudioCount),
+			ImageCount:    int32(pack.Stats.ImageCount),
+			PlayCount:     pack.Stats.PlayCount,
+			CreateTime:    timestamppb.New(pack.CreateTime),
+		}
+	}
+
+	return &pb.GetAuthorProfileResponse{
+		Profile: &pb.AuthorProfile{
+			Nickname:      p.Nickname,
+			DisplayName:   p.DisplayName,
+			Packs:         packs,
+			PlayCount:     p.PlayCount,
+			AverageRating: p.AverageRating,
+			JoinTime:      timestamppb.New(p.JoinTime),
+		},
+	}, nil
+}
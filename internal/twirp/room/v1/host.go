@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/twitchtv/twirp"
+	"github.com/ysomad/answersuck/internal/game"
+	pb "github.com/ysomad/answersuck/internal/gen/api/room/v1"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/common"
+	"github.com/ysomad/answersuck/internal/twirp/hooks"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	_ apptwirp.Handler = &HostHandler{}
+	_ pb.HostService   = &HostHandler{}
+)
+
+type HostUseCase interface {
+	StartGame(ctx context.Context, roomID, firstPlayer, reason string) error
+	Judge(ctx context.Context, roomID string, correct bool, reason string) error
+	SetScore(ctx context.Context, roomID, player string, score int32, reason string) error
+	Pause(ctx context.Context, roomID string, paused bool, reason string) error
+	Skip(ctx context.Context, roomID, reason string) error
+}
+
+type HostHandler struct {
+	host    HostUseCase
+	session *session.Manager
+}
+
+func NewHostHandler(uc HostUseCase, sm *session.Manager) *HostHandler {
+	return &HostHandler{
+		host:    uc,
+		session: sm,
+	}
+}
+
+func (h *HostHandler) Handle(m *http.ServeMux) {
+	s := pb.NewHostServiceServer(h,
+		twirp.WithServerHooks(hooks.WithSession(h.session)))
+	m.Handle(s.PathPrefix(), middleware.WithSessionID(s))
+}
+
+func (h *HostHandler) StartGame(
+	ctx context.Context,
+	r *pb.StartGameRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.host.StartGame(ctx, r.RoomId, r.FirstPlayer, r.Reason); err != nil {
+		switch {
+		case errors.Is(err, game.ErrUnknownPlayer):
+			return nil, twirp.InvalidArgumentError("first_player", err.Error())
+		case errors.Is(err, apperr.RoomGameStarted):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgRoomGameStarted)
+		case errors.Is(err, apperr.RoomNotEnoughPlayers):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgRoomNotEnoughPlayers)
+		case errors.Is(err, game.ErrEmptyPack):
+			return nil, twirp.FailedPrecondition.Error(err.Error())
+		}
+
+		return nil, hostError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *HostHandler) JudgeAnswer(
+	ctx context.Context,
+	r *pb.JudgeAnswerRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.host.Judge(ctx, r.RoomId, r.Correct, r.Reason); err != nil {
+		return nil, hostError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *HostHandler) SetScore(
+	ctx context.Context,
+	r *pb.SetScoreRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if r.Player == "" {
+		return nil, twirp.RequiredArgumentError("player")
+	}
+
+	if r.Reason == "" {
+		return nil, twirp.RequiredArgumentError("reason")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.host.SetScore(ctx, r.RoomId, r.Player, r.Score, r.Reason); err != nil {
+		if errors.Is(err, game.ErrUnknownPlayer) {
+			return nil, twirp.NotFoundError(err.Error())
+		}
+
+		return nil, hostError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *HostHandler) PauseGame(
+	ctx context.Context,
+	r *pb.PauseGameRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.host.Pause(ctx, r.RoomId, r.Paused, r.Reason); err != nil {
+		return nil, hostError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *HostHandler) SkipQuestion(
+	ctx context.Context,
+	r *pb.SkipQuestionRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.host.Skip(ctx, r.RoomId, r.Reason); err != nil {
+		return nil, hostError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+// hostError converts errors common for all host actions to twirp errors.
+func hostError(err error) error {
+	switch {
+	case errors.Is(err, apperr.RoomNotFound):
+		return twirp.NotFoundError(apperr.MsgRoomNotFound)
+	case errors.Is(err, apperr.RoomNotHost):
+		return twirp.PermissionDenied.Error(apperr.MsgRoomNotHost)
+	case errors.Is(err, apperr.RoomNotOwner):
+		return twirp.PermissionDenied.Error(apperr.MsgRoomNotOwner)
+	case errors.Is(err, apperr.RoomNoHost):
+		return twirp.FailedPrecondition.Error(apperr.MsgRoomNoHost)
+	case errors.Is(err, apperr.RoomGameNotStarted):
+		return twirp.FailedPrecondition.Error(apperr.MsgRoomGameNotStarted)
+	case errors.Is(err, game.ErrGameFinished),
+		errors.Is(err, game.ErrUnexpectedCommand),
+		errors.Is(err, game.ErrAlreadyPaused),
+		errors.Is(err, game.ErrNotPaused),
+		errors.Is(err, game.ErrGamePaused):
+		return twirp.FailedPrecondition.Error(errors.Unwrap(err).Error())
+	}
+
+	return twirp.InternalError(err.Error())
+}
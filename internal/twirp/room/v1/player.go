@@ -0,0 +1,245 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"github.com/ysomad/answersuck/internal/game"
+	pb "github.com/ysomad/answersuck/internal/gen/api/room/v1"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/common"
+	"github.com/ysomad/answersuck/internal/twirp/hooks"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	_ apptwirp.Handler = &PlayerHandler{}
+	_ pb.PlayerService = &PlayerHandler{}
+)
+
+type PlayerUseCase interface {
+	SelectQuestion(ctx context.Context, roomID string, roundQuestionID int32) error
+	Buzz(ctx context.Context, roomID string, rtt time.Duration) error
+	Answer(ctx context.Context, roomID, text string) error
+	Pass(ctx context.Context, roomID string) error
+	Bid(ctx context.Context, roomID string, amount int32, allIn bool) error
+	Transfer(ctx context.Context, roomID, to string) error
+	ChooseCost(ctx context.Context, roomID string, cost int32) error
+}
+
+type PlayerHandler struct {
+	play    PlayerUseCase
+	session *session.Manager
+}
+
+func NewPlayerHandler(uc PlayerUseCase, sm *session.Manager) *PlayerHandler {
+	return &PlayerHandler{
+		play:    uc,
+		session: sm,
+	}
+}
+
+func (h *PlayerHandler) Handle(m *http.ServeMux) {
+	s := pb.NewPlayerServiceServer(h,
+		twirp.WithServerHooks(hooks.WithSession(h.session)))
+	m.Handle(s.PathPrefix(), middleware.WithSessionID(s))
+}
+
+func (h *PlayerHandler) SelectQuestion(
+	ctx context.Context,
+	r *pb.SelectQuestionRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if r.RoundQuestionId == 0 {
+		return nil, twirp.RequiredArgumentError("round_question_id")
+	}
+
+	if err := h.play.SelectQuestion(ctx, r.RoomId, r.RoundQuestionId); err != nil {
+		if errors.Is(err, game.ErrQuestionNotFound) {
+			return nil, twirp.NotFoundError(errors.Unwrap(err).Error())
+		}
+
+		return nil, playerError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PlayerHandler) Buzz(
+	ctx context.Context,
+	r *pb.BuzzRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.play.Buzz(ctx, r.RoomId, r.Rtt.AsDuration()); err != nil {
+		return nil, playerError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PlayerHandler) Answer(
+	ctx context.Context,
+	r *pb.AnswerRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.play.Answer(ctx, r.RoomId, r.Text); err != nil {
+		return nil, playerError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PlayerHandler) Pass(
+	ctx context.Context,
+	r *pb.PassRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := h.play.Pass(ctx, r.RoomId); err != nil {
+		return nil, playerError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PlayerHandler) Bid(
+	ctx context.Context,
+	r *pb.BidRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.play.Bid(ctx, r.RoomId, r.Amount, r.AllIn); err != nil {
+		switch {
+		case errors.Is(err, game.ErrInvalidBid),
+			errors.Is(err, game.ErrBidTooHigh),
+			errors.Is(err, game.ErrAllInRequired):
+			return nil, twirp.InvalidArgumentError("amount", errors.Unwrap(err).Error())
+		}
+
+		return nil, playerError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PlayerHandler) TransferQuestion(
+	ctx context.Context,
+	r *pb.TransferQuestionRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if r.To == "" {
+		return nil, twirp.RequiredArgumentError("to")
+	}
+
+	if err := h.play.Transfer(ctx, r.RoomId, r.To); err != nil {
+		if errors.Is(err, game.ErrInvalidTransfer) {
+			return nil, twirp.InvalidArgumentError("to", errors.Unwrap(err).Error())
+		}
+
+		return nil, playerError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+func (h *PlayerHandler) ChooseCost(
+	ctx context.Context,
+	r *pb.ChooseCostRequest) (*emptypb.Empty, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	if err := h.play.ChooseCost(ctx, r.RoomId, r.Cost); err != nil {
+		if errors.Is(err, game.ErrInvalidCost) {
+			return nil, twirp.InvalidArgumentError("cost", errors.Unwrap(err).Error())
+		}
+
+		return nil, playerError(err)
+	}
+
+	return new(emptypb.Empty), nil
+}
+
+// playerError converts errors common for all player commands to twirp errors.
+func playerError(err error) error {
+	switch {
+	case errors.Is(err, apperr.Unauthorized):
+		return twirp.Unauthenticated.Error(apperr.MsgUnauthorized)
+	case errors.Is(err, apperr.RoomGameNotStarted):
+		return twirp.FailedPrecondition.Error(apperr.MsgRoomGameNotStarted)
+	case errors.Is(err, game.ErrUnknownPlayer):
+		return twirp.PermissionDenied.Error(errors.Unwrap(err).Error())
+	case errors.Is(err, game.ErrGameFinished),
+		errors.Is(err, game.ErrGamePaused),
+		errors.Is(err, game.ErrUnexpectedCommand),
+		errors.Is(err, game.ErrNotYourTurn),
+		errors.Is(err, game.ErrQuestionPlayed),
+		errors.Is(err, game.ErrAlreadyAnswered),
+		errors.Is(err, game.ErrBuzzTooEarly),
+		errors.Is(err, game.ErrBuzzLockedOut),
+		errors.Is(err, game.ErrAlreadyBuzzed):
+		return twirp.FailedPrecondition.Error(errors.Unwrap(err).Error())
+	}
+
+	return twirp.InternalError(err.Error())
+}
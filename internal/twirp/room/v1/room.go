@@ -0,0 +1,262 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+	"github.com/ysomad/answersuck/internal/entity"
+	pb "github.com/ysomad/answersuck/internal/gen/api/room/v1"
+	"github.com/ysomad/answersuck/internal/pkg/apperr"
+	"github.com/ysomad/answersuck/internal/pkg/paging"
+	"github.com/ysomad/answersuck/internal/pkg/session"
+	apptwirp "github.com/ysomad/answersuck/internal/twirp"
+	"github.com/ysomad/answersuck/internal/twirp/common"
+	"github.com/ysomad/answersuck/internal/twirp/hooks"
+	"github.com/ysomad/answersuck/internal/twirp/middleware"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	_ apptwirp.Handler = &RoomHandler{}
+	_ pb.RoomService   = &RoomHandler{}
+)
+
+type RoomUseCase interface {
+	Create(ctx context.Context, r *entity.Room, password string, role entity.RoomRole, shareToken string) (*entity.Room, error)
+	List(ctx context.Context, query string, p paging.Params) (paging.List[entity.Room], error)
+	Join(ctx context.Context, roomID, password string, role entity.RoomRole) (*entity.Room, error)
+}
+
+type RoomHandler struct {
+	room    RoomUseCase
+	session *session.Manager
+}
+
+func NewRoomHandler(uc RoomUseCase, sm *session.Manager) *RoomHandler {
+	return &RoomHandler{
+		room:    uc,
+		session: sm,
+	}
+}
+
+func (h *RoomHandler) Handle(m *http.ServeMux) {
+	s := pb.NewRoomServiceServer(h,
+		twirp.WithServerHooks(hooks.WithSession(h.session)))
+	m.Handle(s.PathPrefix(), middleware.WithSessionID(s))
+}
+
+func (h *RoomHandler) CreateRoom(
+	ctx context.Context,
+	r *pb.CreateRoomRequest) (*pb.CreateRoomResponse, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.Name == "" {
+		return nil, twirp.RequiredArgumentError("name")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	room, err := h.room.Create(ctx, &entity.Room{
+		Name:     r.Name,
+		PackID:   r.PackId,
+		Settings: newRoomSettings(r.Settings),
+	}, r.Password, newRoomRole(r.Role), r.ShareToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrRoomMaxPlayers),
+			errors.Is(err, entity.ErrRoomChoiceTimeout),
+			errors.Is(err, entity.ErrRoomTransferTimeout),
+			errors.Is(err, entity.ErrRoomHostTimeout):
+			return nil, twirp.InvalidArgumentError("settings", err.Error())
+		case errors.Is(err, apperr.PackNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgPackNotFound)
+		case errors.Is(err, apperr.RoomPackNotPlayable):
+			return nil, twirp.FailedPrecondition.Error(apperr.MsgRoomPackNotPlayable)
+		case errors.Is(err, apperr.RoomNoPlayablePacks):
+			return nil, twirp.NotFoundError(apperr.MsgRoomNoPlayablePacks)
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &pb.CreateRoomResponse{Room: newPBRoom(room)}, nil
+}
+
+func (h *RoomHandler) ListRooms(
+	ctx context.Context,
+	r *pb.ListRoomsRequest) (*pb.ListRoomsResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	rooms, nextPageToken, err := h.list(ctx, "", r.PageSize, r.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListRoomsResponse{
+		Rooms:         rooms,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *RoomHandler) SearchRooms(
+	ctx context.Context,
+	r *pb.SearchRoomsRequest) (*pb.SearchRoomsResponse, error) {
+	r.Query = strings.TrimSpace(r.Query)
+
+	if r.Query == "" {
+		return nil, twirp.RequiredArgumentError("query")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	rooms, nextPageToken, err := h.list(ctx, r.Query, r.PageSize, r.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchRoomsResponse{
+		Rooms:         rooms,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *RoomHandler) list(
+	ctx context.Context, query string, pageSize int32, pageToken string) ([]*pb.Room, string, error) {
+	roomList, err := h.room.List(ctx, query, paging.Params{
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		if errors.Is(err, paging.ErrInvalidToken) {
+			return nil, "", twirp.InvalidArgumentError("page_token", err.Error())
+		}
+
+		return nil, "", twirp.InternalError(err.Error())
+	}
+
+	rooms := make([]*pb.Room, len(roomList.Items))
+
+	for i := range roomList.Items {
+		rooms[i] = newPBRoom(&roomList.Items[i])
+	}
+
+	return rooms, roomList.NextPageToken, nil
+}
+
+func (h *RoomHandler) JoinRoom(
+	ctx context.Context,
+	r *pb.JoinRoomRequest) (*pb.JoinRoomResponse, error) {
+	if _, err := common.CheckPlayerAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	if r.RoomId == "" {
+		return nil, twirp.RequiredArgumentError("room_id")
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, twirp.InvalidArgument.Error(err.Error())
+	}
+
+	room, err := h.room.Join(ctx, r.RoomId, r.Password, newRoomRole(r.Role))
+	if err != nil {
+		switch {
+		case errors.Is(err, apperr.RoomNotFound):
+			return nil, twirp.NotFoundError(apperr.MsgRoomNotFound)
+		case errors.Is(err, apperr.RoomWrongPassword):
+			return nil, twirp.PermissionDenied.Error(apperr.MsgRoomWrongPassword)
+		case errors.Is(err, entity.ErrRoomFull),
+			errors.Is(err, entity.ErrRoomHostTaken),
+			errors.Is(err, entity.ErrRoomStarted):
+			return nil, twirp.FailedPrecondition.Error(err.Error())
+		}
+
+		return nil, twirp.InternalError(err.Error())
+	}
+
+	return &pb.JoinRoomResponse{Room: newPBRoom(room)}, nil
+}
+
+// newRoomRole returns entity.RoomRolePlayer if role is not specified.
+func newRoomRole(r pb.RoomRole) entity.RoomRole {
+	if r == pb.RoomRole_ROOM_ROLE_UNSPECIFIED {
+		return entity.RoomRolePlayer
+	}
+
+	return entity.RoomRole(r)
+}
+
+// newRoomSettings returns room settings with defaults for not specified fields.
+func newRoomSettings(s *pb.RoomSettings) entity.RoomSettings {
+	res := entity.DefaultRoomSettings()
+
+	if s == nil {
+		return res
+	}
+
+	if s.MaxPlayers != 0 {
+		res.MaxPlayers = int8(s.MaxPlayers)
+	}
+
+	if s.FalseStarts != nil {
+		res.FalseStarts = *s.FalseStarts
+	}
+
+	if s.ChoiceTimeout != nil {
+		res.ChoiceTimeout = s.ChoiceTimeout.AsDuration()
+	}
+
+	if s.TransferTimeout != nil {
+		res.TransferTimeout = s.TransferTimeout.AsDuration()
+	}
+
+	if s.HostTimeout != nil {
+		res.HostTimeout = s.HostTimeout.AsDuration()
+	}
+
+	return res
+}
+
+func newPBRoom(r *entity.Room) *pb.Room {
+	members := make([]*pb.RoomMember, len(r.Members))
+
+	for i, m := range r.Members {
+		members[i] = &pb.RoomMember{
+			Nickname: m.Nickname,
+			Role:     pb.RoomRole(m.Role),
+			JoinTime: timestamppb.New(m.JoinTime),
+		}
+	}
+
+	falseStarts := r.Settings.FalseStarts
+
+	return &pb.Room{
+		Id:          r.ID,
+		Name:        r.Name,
+		HasPassword: r.HasPassword(),
+		PackId:      r.PackID,
+		Owner:       r.Owner,
+		Settings: &pb.RoomSettings{
+			MaxPlayers:      int32(r.Settings.MaxPlayers),
+			FalseStarts:     &falseStarts,
+			ChoiceTimeout:   durationpb.New(r.Settings.ChoiceTimeout),
+			TransferTimeout: durationpb.New(r.Settings.TransferTimeout),
+			HostTimeout:     durationpb.New(r.Settings.HostTimeout),
+		},
+		Members:    members,
+		IsStarted:  r.Started,
+		CreateTime: timestamppb.New(r.CreateTime),
+	}
+}
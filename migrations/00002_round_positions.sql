@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE round_topics ADD COLUMN IF NOT EXISTS position smallint;
+
+UPDATE round_topics rt
+SET position = p.position
+FROM (SELECT id, row_number() OVER (PARTITION BY round_id ORDER BY id) AS position FROM round_topics) p
+WHERE rt.id = p.id;
+
+ALTER TABLE round_topics
+    ALTER COLUMN position SET NOT NULL,
+    ADD CONSTRAINT round_topics_round_id_topic_id_key UNIQUE (round_id, topic_id),
+    ADD CONSTRAINT round_topics_round_id_position_key UNIQUE (round_id, position) DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE round_questions ADD COLUMN IF NOT EXISTS position smallint;
+
+UPDATE round_questions rq
+SET position = p.position
+FROM (SELECT id, row_number() OVER (PARTITION BY round_topic_id ORDER BY id) AS position FROM round_questions) p
+WHERE rq.id = p.id;
+
+ALTER TABLE round_questions
+    ALTER COLUMN position SET NOT NULL,
+    ADD CONSTRAINT round_questions_round_topic_id_position_key UNIQUE (round_topic_id, position) DEFERRABLE INITIALLY DEFERRED;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE round_questions DROP COLUMN IF EXISTS position;
+
+ALTER TABLE round_topics
+    DROP CONSTRAINT IF EXISTS round_topics_round_id_topic_id_key,
+    DROP COLUMN IF EXISTS position;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE answers
+    ADD COLUMN IF NOT EXISTS alternatives varchar(100)[] DEFAULT '{}' NOT NULL,
+    ADD COLUMN IF NOT EXISTS wrong_variants varchar(100)[] DEFAULT '{}' NOT NULL;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE answers
+    DROP COLUMN IF EXISTS wrong_variants,
+    DROP COLUMN IF EXISTS alternatives;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS questions_text_trgm_idx ON questions USING gin (text gin_trgm_ops);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS questions_text_trgm_idx;
+
+DROP EXTENSION IF EXISTS pg_trgm;
+-- +goose StatementEnd
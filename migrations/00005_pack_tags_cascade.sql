@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pack_tags
+    DROP CONSTRAINT IF EXISTS pack_tags_tag_fkey,
+    ADD CONSTRAINT pack_tags_tag_fkey FOREIGN KEY (tag) REFERENCES tags (name) ON UPDATE CASCADE;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pack_tags
+    DROP CONSTRAINT IF EXISTS pack_tags_tag_fkey,
+    ADD CONSTRAINT pack_tags_tag_fkey FOREIGN KEY (tag) REFERENCES tags (name);
+-- +goose StatementEnd
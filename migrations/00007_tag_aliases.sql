@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE players ADD COLUMN IF NOT EXISTS is_admin boolean DEFAULT FALSE NOT NULL;
+
+CREATE TABLE IF NOT EXISTS tag_aliases (
+    alias varchar(16) NOT NULL PRIMARY KEY,
+    tag varchar(16) NOT NULL REFERENCES tags (name) ON UPDATE CASCADE ON DELETE CASCADE,
+    create_time timestamptz NOT NULL
+);
+
+-- tags which differ only in case are merged into the oldest one before they're made unique
+CREATE TEMPORARY TABLE tag_merges AS
+SELECT t.name AS src, dst.name AS dst
+FROM tags t
+INNER JOIN LATERAL (
+    SELECT name FROM tags
+    WHERE lower(name) = lower(t.name)
+    ORDER BY create_time, name
+    LIMIT 1
+) dst ON dst.name <> t.name;
+
+INSERT INTO pack_tags (pack_id, tag)
+SELECT pt.pack_id, m.dst FROM pack_tags pt
+INNER JOIN tag_merges m ON m.src = pt.tag
+ON CONFLICT DO NOTHING;
+
+DELETE FROM pack_tags pt USING tag_merges m WHERE pt.tag = m.src;
+
+INSERT INTO tag_aliases (alias, tag, create_time)
+SELECT lower(src), dst, now() FROM tag_merges
+ON CONFLICT DO NOTHING;
+
+DELETE FROM tags t USING tag_merges m WHERE t.name = m.src;
+
+DROP TABLE tag_merges;
+
+CREATE UNIQUE INDEX IF NOT EXISTS tags_name_lower_key ON tags (lower(name));
+
+CREATE TABLE IF NOT EXISTS banned_tags (
+    name varchar(16) NOT NULL PRIMARY KEY,
+    banned_by varchar(25) NOT NULL REFERENCES players (nickname),
+    create_time timestamptz NOT NULL
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS banned_tags CASCADE;
+
+DROP TABLE IF EXISTS tag_aliases CASCADE;
+
+DROP INDEX IF EXISTS tags_name_lower_key;
+
+ALTER TABLE players DROP COLUMN IF EXISTS is_admin;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS question_parts (
+    question_id int NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    position smallint NOT NULL,
+    type smallint NOT NULL,
+    text varchar(200),
+    media_url varchar(2048) REFERENCES media (url),
+    duration bigint NOT NULL,
+    PRIMARY KEY (question_id, position)
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS question_parts CASCADE;
+-- +goose StatementEnd
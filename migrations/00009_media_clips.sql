@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE media ADD COLUMN IF NOT EXISTS duration bigint DEFAULT 0 NOT NULL;
+
+ALTER TABLE answers
+    ADD COLUMN IF NOT EXISTS media_start bigint DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS media_end bigint DEFAULT 0 NOT NULL;
+
+ALTER TABLE questions
+    ADD COLUMN IF NOT EXISTS media_start bigint DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS media_end bigint DEFAULT 0 NOT NULL;
+
+ALTER TABLE question_parts
+    ADD COLUMN IF NOT EXISTS media_start bigint DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS media_end bigint DEFAULT 0 NOT NULL;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE question_parts
+    DROP COLUMN IF EXISTS media_end,
+    DROP COLUMN IF EXISTS media_start;
+
+ALTER TABLE questions
+    DROP COLUMN IF EXISTS media_end,
+    DROP COLUMN IF EXISTS media_start;
+
+ALTER TABLE answers
+    DROP COLUMN IF EXISTS media_end,
+    DROP COLUMN IF EXISTS media_start;
+
+ALTER TABLE media DROP COLUMN IF EXISTS duration;
+-- +goose StatementEnd
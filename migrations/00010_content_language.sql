@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE packs ADD COLUMN IF NOT EXISTS language smallint DEFAULT 1 NOT NULL;
+
+ALTER TABLE packs ADD COLUMN IF NOT EXISTS ts tsvector GENERATED ALWAYS AS (to_tsvector(CASE language WHEN 2 THEN 'english'::regconfig ELSE 'russian'::regconfig END, name)) STORED;
+
+CREATE INDEX IF NOT EXISTS packs_ts_idx ON packs USING gin (ts);
+
+ALTER TABLE topics ADD COLUMN IF NOT EXISTS language smallint DEFAULT 1 NOT NULL;
+
+ALTER TABLE topics ADD COLUMN IF NOT EXISTS ts tsvector GENERATED ALWAYS AS (to_tsvector(CASE language WHEN 2 THEN 'english'::regconfig ELSE 'russian'::regconfig END, title)) STORED;
+
+CREATE INDEX IF NOT EXISTS topics_ts_idx ON topics USING gin (ts);
+
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS language smallint DEFAULT 1 NOT NULL;
+
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS ts tsvector GENERATED ALWAYS AS (to_tsvector(CASE language WHEN 2 THEN 'english'::regconfig ELSE 'russian'::regconfig END, text)) STORED;
+
+CREATE INDEX IF NOT EXISTS questions_ts_idx ON questions USING gin (ts);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE questions
+    DROP COLUMN IF EXISTS ts,
+    DROP COLUMN IF EXISTS language;
+
+ALTER TABLE topics
+    DROP COLUMN IF EXISTS ts,
+    DROP COLUMN IF EXISTS language;
+
+ALTER TABLE packs
+    DROP COLUMN IF EXISTS ts,
+    DROP COLUMN IF EXISTS language;
+-- +goose StatementEnd
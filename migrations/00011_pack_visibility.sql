@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE packs ADD COLUMN IF NOT EXISTS visibility smallint DEFAULT 1 NOT NULL;
+
+CREATE TABLE IF NOT EXISTS pack_share_tokens (
+    token varchar(64) NOT NULL PRIMARY KEY,
+    pack_id int NOT NULL REFERENCES packs (id) ON DELETE CASCADE,
+    create_time timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS pack_share_tokens_pack_id_idx ON pack_share_tokens (pack_id);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pack_share_tokens CASCADE;
+
+ALTER TABLE packs DROP COLUMN IF EXISTS visibility;
+-- +goose StatementEnd
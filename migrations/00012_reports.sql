@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE packs ADD COLUMN IF NOT EXISTS is_hidden boolean DEFAULT FALSE NOT NULL;
+
+CREATE TABLE IF NOT EXISTS banned_authors (
+    nickname varchar(25) NOT NULL PRIMARY KEY REFERENCES players (nickname),
+    banned_by varchar(25) NOT NULL REFERENCES players (nickname),
+    create_time timestamptz NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS reports (
+    id serial NOT NULL PRIMARY KEY,
+    pack_id int REFERENCES packs (id) ON DELETE SET NULL,
+    question_id int REFERENCES questions (id) ON DELETE SET NULL,
+    pack_name varchar(64),
+    question_text varchar(200),
+    reason varchar(500) NOT NULL,
+    reporter varchar(25) NOT NULL REFERENCES players (nickname),
+    status smallint DEFAULT 1 NOT NULL,
+    resolved_by varchar(25) REFERENCES players (nickname),
+    create_time timestamptz NOT NULL,
+    resolve_time timestamptz,
+    CHECK (pack_id IS NULL OR question_id IS NULL),
+    CHECK ((pack_name IS NULL) <> (question_text IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS reports_status_idx ON reports (status, create_time);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reports CASCADE;
+
+DROP TABLE IF EXISTS banned_authors CASCADE;
+
+ALTER TABLE packs DROP COLUMN IF EXISTS is_hidden;
+-- +goose StatementEnd
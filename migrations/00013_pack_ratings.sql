@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE packs ADD COLUMN IF NOT EXISTS play_count int DEFAULT 0 NOT NULL;
+
+CREATE TABLE IF NOT EXISTS pack_ratings (
+    pack_id int NOT NULL REFERENCES packs (id) ON DELETE CASCADE,
+    player varchar(25) NOT NULL REFERENCES players (nickname),
+    rating smallint NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    create_time timestamptz NOT NULL,
+    PRIMARY KEY (pack_id, player)
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pack_ratings CASCADE;
+
+ALTER TABLE packs DROP COLUMN IF EXISTS play_count;
+-- +goose StatementEnd
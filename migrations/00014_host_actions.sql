@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS host_actions (
+    id bigserial NOT NULL PRIMARY KEY,
+    room_id varchar(16) NOT NULL,
+    host varchar(25) NOT NULL REFERENCES players (nickname),
+    action_type smallint NOT NULL,
+    player varchar(25) REFERENCES players (nickname),
+    correct boolean,
+    score int,
+    reason varchar(500),
+    create_time timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS host_actions_room_id_idx ON host_actions (room_id, create_time);
+
+-- host actions are append-only
+CREATE OR REPLACE RULE host_actions_no_update AS ON UPDATE TO host_actions DO INSTEAD NOTHING;
+
+CREATE OR REPLACE RULE host_actions_no_delete AS ON DELETE TO host_actions DO INSTEAD NOTHING;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS host_actions CASCADE;
+-- +goose StatementEnd
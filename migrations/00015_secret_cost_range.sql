@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- range [secret_cost_min;secret_cost_max] with step secret_cost_step player chooses cost of secret question from
+ALTER TABLE round_questions
+    ADD COLUMN IF NOT EXISTS secret_cost_min smallint,
+    ADD COLUMN IF NOT EXISTS secret_cost_max smallint,
+    ADD COLUMN IF NOT EXISTS secret_cost_step smallint;
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE round_questions
+    DROP COLUMN IF EXISTS secret_cost_min,
+    DROP COLUMN IF EXISTS secret_cost_max,
+    DROP COLUMN IF EXISTS secret_cost_step;
+-- +goose StatementEnd
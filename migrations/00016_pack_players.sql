@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- players and hosts who finished a game with the pack, only they can rate it
+CREATE TABLE IF NOT EXISTS pack_players (
+    pack_id int NOT NULL REFERENCES packs (id) ON DELETE CASCADE,
+    player varchar(25) NOT NULL REFERENCES players (nickname),
+    play_time timestamptz NOT NULL,
+    PRIMARY KEY (pack_id, player)
+);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pack_players CASCADE;
+-- +goose StatementEnd
@@ -6,6 +6,7 @@ INSERT INTO
         email,
         display_name,
         email_verified,
+        is_admin,
         password,
         create_time
     )
@@ -15,6 +16,7 @@ VALUES
         'test@test.com',
         'test player',
         true,
+        true,
         '$argon2id$v=19$m=65536,t=1,p=2$wvbSeXYgL6cAzha1qGu16w$aMD4W28gyZ51CI52WgbWxWCiHfYuRwv8nXL8m2eJ7CA',
         '2023-08-03 22:25:08.947 +0700'
     );
@@ -86,6 +88,12 @@ VALUES
     (1337, 'мемы'),
     (1337, 'фильмы');
 
+INSERT INTO
+    tag_aliases(alias, tag, create_time)
+VALUES
+    ('игры', 'видеоигры', '2023-08-03 22:25:08.947 +0700'),
+    ('кино', 'фильмы', '2023-08-03 22:25:08.947 +0700');
+
 INSERT INTO
     rounds(id, name, position, pack_id)
 VALUES
@@ -176,21 +184,21 @@ VALUES
     );
 
 INSERT INTO
-    round_topics(id, round_id, topic_id)
+    round_topics(id, round_id, topic_id, position)
 VALUES
-    (1, 1, 10),
-    (2, 1, 20),
-    (3, 1, 30),
-    (4, 1, 40),
-    (5, 1, 50),
-    (6, 2, 60),
-    (7, 2, 70),
-    (8, 2, 80),
-    (9, 2, 90),
-    (10, 3, 100),
-    (11, 3, 110),
-    (12, 3, 120),
-    (13, 3, 130);
+    (1, 1, 10, 1),
+    (2, 1, 20, 2),
+    (3, 1, 30, 3),
+    (4, 1, 40, 4),
+    (5, 1, 50, 5),
+    (6, 2, 60, 1),
+    (7, 2, 70, 2),
+    (8, 2, 80, 3),
+    (9, 2, 90, 4),
+    (10, 3, 100, 1),
+    (11, 3, 110, 2),
+    (12, 3, 120, 3),
+    (13, 3, 130, 4);
 
 INSERT INTO
     answers(id, text, media_url)
@@ -213,7 +221,207 @@ INSERT INTO
 VALUES
     (
         228,
-        'Тестовый вопрос',
+        'Тестовый вопрос 1',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        229,
+        'Тестовый вопрос 2',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        230,
+        'Тестовый вопрос 3',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        231,
+        'Тестовый вопрос 4',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        232,
+        'Тестовый вопрос 5',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        233,
+        'Тестовый вопрос 6',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        234,
+        'Тестовый вопрос 7',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        235,
+        'Тестовый вопрос 8',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        236,
+        'Тестовый вопрос 9',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        237,
+        'Тестовый вопрос 10',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        238,
+        'Тестовый вопрос 11',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        239,
+        'Тестовый вопрос 12',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        240,
+        'Тестовый вопрос 13',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        241,
+        'Тестовый вопрос 14',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        242,
+        'Тестовый вопрос 15',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        243,
+        'Тестовый вопрос 16',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        244,
+        'Тестовый вопрос 17',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        245,
+        'Тестовый вопрос 18',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        246,
+        'Тестовый вопрос 19',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        247,
+        'Тестовый вопрос 20',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        248,
+        'Тестовый вопрос 21',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        249,
+        'Тестовый вопрос 22',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        250,
+        'Тестовый вопрос 23',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        251,
+        'Тестовый вопрос 24',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        252,
+        'Тестовый вопрос 25',
+        1337,
+        'test',
+        'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
+        '2023-08-03 22:25:08.947 +0700'
+    ),
+    (
+        253,
+        'Тестовый вопрос 26',
         1337,
         'test',
         'https://media.tenor.com/eUJ9ASaUTXQAAAAC/boomer-meme.gif',
@@ -225,6 +433,7 @@ INSERT INTO
         id,
         round_topic_id,
         question_id,
+        position,
         question_type,
         cost,
         answer_time,
@@ -240,6 +449,7 @@ VALUES
         1,
         228,
         1,
+        1,
         100,
         15000000000,
         NULL,
@@ -251,7 +461,8 @@ VALUES
     (
         222,
         1,
-        228,
+        229,
+        2,
         2,
         300,
         10000000000,
@@ -264,7 +475,8 @@ VALUES
     (
         333,
         2,
-        228,
+        230,
+        1,
         2,
         50,
         5000000000,
@@ -277,7 +489,8 @@ VALUES
     (
         444,
         2,
-        228,
+        231,
+        2,
         3,
         300,
         15000000000,
@@ -290,7 +503,8 @@ VALUES
     (
         555,
         3,
-        228,
+        232,
+        1,
         3,
         500,
         15000000000,
@@ -303,7 +517,8 @@ VALUES
     (
         666,
         3,
-        228,
+        233,
+        2,
         1,
         100,
         15000000000,
@@ -316,7 +531,8 @@ VALUES
     (
         777,
         4,
-        228,
+        234,
+        1,
         1,
         100,
         15000000000,
@@ -329,7 +545,8 @@ VALUES
     (
         888,
         4,
-        228,
+        235,
+        2,
         2,
         300,
         10000000000,
@@ -342,7 +559,8 @@ VALUES
     (
         999,
         5,
-        228,
+        236,
+        1,
         2,
         50,
         5000000000,
@@ -355,7 +573,8 @@ VALUES
     (
         1111,
         5,
-        228,
+        237,
+        2,
         3,
         300,
         15000000000,
@@ -368,7 +587,8 @@ VALUES
     (
         1222,
         6,
-        228,
+        238,
+        1,
         3,
         500,
         15000000000,
@@ -381,7 +601,8 @@ VALUES
     (
         1333,
         6,
-        228,
+        239,
+        2,
         1,
         100,
         15000000000,
@@ -394,7 +615,8 @@ VALUES
     (
         1444,
         7,
-        228,
+        240,
+        1,
         1,
         100,
         15000000000,
@@ -407,7 +629,8 @@ VALUES
     (
         1555,
         7,
-        228,
+        241,
+        2,
         2,
         300,
         10000000000,
@@ -420,7 +643,8 @@ VALUES
     (
         1666,
         8,
-        228,
+        242,
+        1,
         2,
         50,
         5000000000,
@@ -433,7 +657,8 @@ VALUES
     (
         1777,
         8,
-        228,
+        243,
+        2,
         3,
         300,
         15000000000,
@@ -446,7 +671,8 @@ VALUES
     (
         1888,
         9,
-        228,
+        244,
+        1,
         3,
         500,
         15000000000,
@@ -459,7 +685,8 @@ VALUES
     (
         1999,
         9,
-        228,
+        245,
+        2,
         1,
         100,
         15000000000,
@@ -472,7 +699,8 @@ VALUES
     (
         2111,
         10,
-        228,
+        246,
+        1,
         1,
         100,
         15000000000,
@@ -485,7 +713,8 @@ VALUES
     (
         2222,
         10,
-        228,
+        247,
+        2,
         2,
         300,
         10000000000,
@@ -498,7 +727,8 @@ VALUES
     (
         3333,
         11,
-        228,
+        248,
+        1,
         2,
         50,
         5000000000,
@@ -511,7 +741,8 @@ VALUES
     (
         4444,
         11,
-        228,
+        249,
+        2,
         3,
         300,
         15000000000,
@@ -524,7 +755,8 @@ VALUES
     (
         5555,
         12,
-        228,
+        250,
+        1,
         3,
         500,
         15000000000,
@@ -537,7 +769,8 @@ VALUES
     (
         6666,
         12,
-        228,
+        251,
+        2,
         1,
         100,
         15000000000,
@@ -550,7 +783,8 @@ VALUES
     (
         7777,
         13,
-        228,
+        252,
+        1,
         3,
         500,
         15000000000,
@@ -563,7 +797,8 @@ VALUES
     (
         8888,
         13,
-        228,
+        253,
+        2,
         1,
         100,
         15000000000,